@@ -0,0 +1,194 @@
+package api
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/kustomize/kyaml/utils"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+const (
+	// fieldRequirementSeparator separates the requirements of a field selector.
+	fieldRequirementSeparator = ","
+)
+
+// fieldOperator is the operator of a field selector requirement.
+type fieldOperator string
+
+const (
+	fieldOperatorExists       fieldOperator = ""
+	fieldOperatorDoesNotExist fieldOperator = "!"
+	fieldOperatorEquals       fieldOperator = "="
+	fieldOperatorDoubleEquals fieldOperator = "=="
+	fieldOperatorNotEquals    fieldOperator = "!="
+	fieldOperatorGreaterThan  fieldOperator = ">"
+	fieldOperatorGreaterEqual fieldOperator = ">="
+	fieldOperatorLessThan     fieldOperator = "<"
+	fieldOperatorLessEqual    fieldOperator = "<="
+)
+
+// fieldOperators are the binary operators of field selector requirements, the longest first,
+// so that the operators prefixing others (e.g. "=" and "==") are looked for last.
+var fieldOperators = []fieldOperator{
+	fieldOperatorDoubleEquals,
+	fieldOperatorNotEquals,
+	fieldOperatorGreaterEqual,
+	fieldOperatorLessEqual,
+	fieldOperatorEquals,
+	fieldOperatorGreaterThan,
+	fieldOperatorLessThan,
+}
+
+// fieldRequirement is a requirement of a field selector on the value of the field at a path.
+type fieldRequirement struct {
+	raw      string
+	path     []string
+	operator fieldOperator
+	value    string
+	number   float64
+}
+
+// FieldSelector selects items by the values of their fields.
+// It is parsed from comma-separated requirements, all of which an item must satisfy:
+//
+//   - "spec.type=LoadBalancer" (or "==") and "spec.type!=LoadBalancer" compare the scalar value of the field;
+//   - "spec.replicas>1", ">=", "<" and "<=" compare the numeric value of the field, not matching non-numeric ones;
+//   - "spec.paused" and "!spec.paused" check that the field exists, or does not.
+//
+// Field paths are dot-separated, and elements containing dots can be wrapped in brackets,
+// e.g. "metadata.annotations.[example.com/owner]=platform".
+type FieldSelector []fieldRequirement
+
+// ParseFieldSelector parses the field selector.
+func ParseFieldSelector(selector string) (FieldSelector, error) {
+	var requirements FieldSelector
+	for raw := range strings.SplitSeq(selector, fieldRequirementSeparator) {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			return nil, fmt.Errorf("field selector '%s' has empty requirements", selector)
+		}
+		requirement, err := parseFieldRequirement(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid field selector requirement '%s': %w", raw, err)
+		}
+		requirements = append(requirements, requirement)
+	}
+	return requirements, nil
+}
+
+// parseFieldRequirement parses a requirement of a field selector.
+func parseFieldRequirement(raw string) (fieldRequirement, error) {
+	requirement := fieldRequirement{raw: raw}
+
+	path := raw
+	if i, operator := indexFieldOperator(raw); i >= 0 {
+		path = strings.TrimSpace(raw[:i])
+		requirement.operator = operator
+		requirement.value = strings.TrimSpace(raw[i+len(operator):])
+	} else if unary, ok := strings.CutPrefix(raw, string(fieldOperatorDoesNotExist)); ok {
+		path = strings.TrimSpace(unary)
+		requirement.operator = fieldOperatorDoesNotExist
+	}
+
+	requirement.path = utils.SmarterPathSplitter(path, fieldPathDelimiter)
+	if path == "" || slices.Contains(requirement.path, "") {
+		return requirement, fmt.Errorf("field path '%s' has empty elements", path)
+	}
+
+	switch requirement.operator {
+	case fieldOperatorGreaterThan, fieldOperatorGreaterEqual, fieldOperatorLessThan, fieldOperatorLessEqual:
+		number, err := strconv.ParseFloat(requirement.value, 64)
+		if err != nil {
+			return requirement, fmt.Errorf("operator '%s' requires a number, got '%s'", requirement.operator, requirement.value)
+		}
+		requirement.number = number
+	}
+	return requirement, nil
+}
+
+// indexFieldOperator returns the position of the first binary operator of the requirement out of brackets,
+// and the operator, or -1 if there is none.
+func indexFieldOperator(raw string) (int, fieldOperator) {
+	depth := 0
+	for i := 0; i < len(raw); i++ {
+		switch raw[i] {
+		case '[':
+			depth++
+			continue
+		case ']':
+			depth--
+			continue
+		}
+		if depth > 0 {
+			continue
+		}
+		for _, operator := range fieldOperators {
+			if strings.HasPrefix(raw[i:], string(operator)) {
+				return i, operator
+			}
+		}
+	}
+	return -1, ""
+}
+
+// String returns the field selector, as parsed.
+func (s FieldSelector) String() string {
+	raw := make([]string, 0, len(s))
+	for _, requirement := range s {
+		raw = append(raw, requirement.raw)
+	}
+	return strings.Join(raw, fieldRequirementSeparator)
+}
+
+// Matches checks if the item satisfies all the requirements of the field selector.
+func (s FieldSelector) Matches(item *kyaml.RNode) bool {
+	for i := range s {
+		if !s[i].matches(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// matches checks if the item satisfies the requirement.
+func (r *fieldRequirement) matches(item *kyaml.RNode) bool {
+	field, err := item.Pipe(kyaml.Lookup(r.path...))
+	exists := err == nil && field != nil && field.YNode().Tag != kyaml.NodeTagNull
+
+	switch r.operator {
+	case fieldOperatorExists:
+		return exists
+	case fieldOperatorDoesNotExist:
+		return !exists
+	}
+	if !exists || field.YNode().Kind != kyaml.ScalarNode {
+		// a missing or non-scalar field is only different from any value
+		return r.operator == fieldOperatorNotEquals
+	}
+
+	value := field.YNode().Value
+	switch r.operator {
+	case fieldOperatorEquals, fieldOperatorDoubleEquals:
+		return value == r.value
+	case fieldOperatorNotEquals:
+		return value != r.value
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return false
+	}
+	switch r.operator {
+	case fieldOperatorGreaterThan:
+		return number > r.number
+	case fieldOperatorGreaterEqual:
+		return number >= r.number
+	case fieldOperatorLessThan:
+		return number < r.number
+	default:
+		return number <= r.number
+	}
+}
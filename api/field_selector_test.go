@@ -0,0 +1,99 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+func TestParseFieldSelector(t *testing.T) {
+	tests := []struct {
+		name           string
+		selector       string
+		errorSubstring string
+	}{
+		{name: "equality", selector: "spec.type=LoadBalancer"},
+		{name: "all operators", selector: "a==b, c!=d, e>1, f>=1.5, g<-1, h<=0, i, !j"},
+		{name: "bracketed path", selector: "metadata.annotations.[example.com/owner]=platform"},
+		{name: "list element path", selector: "spec.template.spec.containers.[name=app].image=app:1.0"},
+		{name: "empty requirement", selector: "spec.type=LoadBalancer,", errorSubstring: "has empty requirements"},
+		{name: "empty path", selector: "=LoadBalancer", errorSubstring: "field path '' has empty elements"},
+		{name: "empty path element", selector: "spec..type=LoadBalancer", errorSubstring: "field path 'spec..type' has empty elements"},
+		{name: "non-numeric comparison", selector: "spec.replicas>many", errorSubstring: "operator '>' requires a number, got 'many'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := ParseFieldSelector(tt.selector)
+			if tt.errorSubstring != "" {
+				require.ErrorContains(t, err, tt.errorSubstring)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, selector)
+		})
+	}
+}
+
+func TestFieldSelector_Matches(t *testing.T) {
+	item, err := kyaml.Parse(`apiVersion: v1
+kind: Service
+metadata:
+  name: frontend
+  namespace: default
+  annotations:
+    example.com/owner: platform
+spec:
+  type: LoadBalancer
+  replicas: 3
+  paused: null
+  ports:
+  - name: http
+    port: 80
+`)
+	require.NoError(t, err)
+
+	tests := []struct {
+		selector string
+		expected bool
+	}{
+		{selector: "spec.type=LoadBalancer", expected: true},
+		{selector: "spec.type==LoadBalancer", expected: true},
+		{selector: "spec.type=ClusterIP", expected: false},
+		{selector: "spec.type!=ClusterIP", expected: true},
+		{selector: "spec.type!=LoadBalancer", expected: false},
+		{selector: "spec.missing!=value", expected: true},
+		{selector: "spec.missing=value", expected: false},
+		{selector: "spec.ports!=value", expected: true},
+		{selector: "spec.ports=value", expected: false},
+		{selector: "spec.replicas>1", expected: true},
+		{selector: "spec.replicas>3", expected: false},
+		{selector: "spec.replicas>=3", expected: true},
+		{selector: "spec.replicas<3", expected: false},
+		{selector: "spec.replicas<=3", expected: true},
+		{selector: "spec.replicas<3.5", expected: true},
+		{selector: "spec.type>1", expected: false},
+		{selector: "spec.missing<1", expected: false},
+		{selector: "spec.type", expected: true},
+		{selector: "spec.missing", expected: false},
+		{selector: "!spec.missing", expected: true},
+		{selector: "!spec.type", expected: false},
+		{selector: "spec.paused", expected: false},
+		{selector: "!spec.paused", expected: true},
+		{selector: "metadata.annotations.[example.com/owner]=platform", expected: true},
+		{selector: "spec.ports.[name=http].port=80", expected: true},
+		{selector: "spec.ports.[name=http].port>80", expected: false},
+		{selector: "spec.type=LoadBalancer,spec.replicas>1", expected: true},
+		{selector: "spec.type=LoadBalancer,spec.replicas>3", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			selector, err := ParseFieldSelector(tt.selector)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, selector.Matches(item))
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package api
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"cuelang.org/go/cue"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// Group is the API group of the function config.
+	Group = "cuestomize.dev"
+	// V1Alpha1 is the API version of the v1alpha1 function config, whose remote module can be set
+	// with the deprecated registry, repo and tag fields. Both API versions are unmarshalled into a KRMInput,
+	// and function configs of any other API version than V1Beta1 are handled as v1alpha1 ones.
+	V1Alpha1 = Group + "/v1alpha1"
+	// V1Beta1 is the API version of the v1beta1 function config, whose remote module can only be set with a ref.
+	V1Beta1 = Group + "/v1beta1"
+
+	// authSecretKind is the kind of the resource holding the credentials of the remote module registry.
+	authSecretKind = "Secret"
+)
+
+// functionConfigSchema is the OpenAPI schema of the function config, in YAML.
+//
+//go:embed function_config_schema.yaml
+var functionConfigSchema []byte
+
+// Schema returns the OpenAPI schema the function config is validated against before being unmarshalled,
+// which rejects unknown fields and values of the wrong type, naming them.
+func (i *KRMInput) Schema() (*spec.Schema, error) {
+	data, err := yaml.YAMLToJSON(functionConfigSchema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert function config schema to JSON: %w", err)
+	}
+	schema := &spec.Schema{}
+	if err := schema.UnmarshalJSON(data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal function config schema: %w", err)
+	}
+	return schema, nil
+}
+
+// Default converts the v1alpha1 function config into the v1beta1 one, replacing the deprecated registry, repo and tag
+// fields of the remote module with the equivalent ref, and defaults the kind of the remote module auth selector.
+// The API version is left untouched, as it is filled into the CUE model.
+func (i *KRMInput) Default() error {
+	if i.RemoteModule == nil {
+		return nil
+	}
+	if i.APIVersion != V1Beta1 {
+		i.RemoteModule.convertDeprecatedFields()
+	}
+	if i.RemoteModule.Auth != nil && i.RemoteModule.Auth.Kind == "" {
+		i.RemoteModule.Auth.Kind = authSecretKind
+	}
+	return nil
+}
+
+// Validate checks the function config beyond its schema, returning an error naming each offending field.
+func (i *KRMInput) Validate() error {
+	var errs []error
+
+	invalid := map[int]bool{}
+	for _, err := range validateIncludeSelectors(i.Includes) {
+		if err.field != "" {
+			errs = append(errs, fmt.Errorf("includes[%d].%s: %w", err.index, err.field, err.err))
+			continue
+		}
+		errs = append(errs, fmt.Errorf("includes[%d]: %w", err.index, err.err))
+		invalid[err.index] = true
+	}
+	for idx := range i.Includes {
+		if invalid[idx] {
+			continue
+		}
+		if _, err := i.Includes[idx].compile(); err != nil {
+			errs = append(errs, fmt.Errorf("includes[%d]: %w", idx, err))
+		}
+	}
+	for idx := range i.Excludes {
+		if _, err := CompileSelector(&i.Excludes[idx]); err != nil {
+			errs = append(errs, fmt.Errorf("excludes[%d]: %w", idx, err))
+		}
+	}
+	if i.ForEach != nil {
+		if _, err := CompileSelector(i.ForEach); err != nil {
+			errs = append(errs, fmt.Errorf("forEach: %w", err))
+		}
+	}
+	if i.RemoteModule != nil {
+		errs = append(errs, i.RemoteModule.validate(i.APIVersion)...)
+	}
+	for idx, path := range i.OutputPaths {
+		if err := cue.ParsePath(path).Err(); err != nil {
+			errs = append(errs, fmt.Errorf("outputPaths[%d]: invalid CUE path '%s': %w", idx, path, err))
+		}
+	}
+	inputPaths := map[string]bool{}
+	for idx := range i.ValueFrom {
+		ref := &i.ValueFrom[idx]
+		if err := ref.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("valueFrom[%d].%w", idx, err))
+			continue
+		}
+		if inputPaths[ref.InputPath] {
+			errs = append(errs, fmt.Errorf("valueFrom[%d].inputPath: duplicate input path '%s'", idx, ref.InputPath))
+		}
+		inputPaths[ref.InputPath] = true
+	}
+	for idx, tag := range i.Tags {
+		if tag == "" {
+			errs = append(errs, fmt.Errorf("tags[%d]: must not be empty", idx))
+		}
+	}
+	return errors.Join(errs...)
+}
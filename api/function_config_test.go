@@ -0,0 +1,288 @@
+package api
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Workday/cuestomize/internal/pkg/processor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/kustomize/api/types"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+// loadFunctionConfig loads the function config the way the function does, with schema validation,
+// defaulting and validation.
+func loadFunctionConfig(t *testing.T, config string) (*KRMInput, error) {
+	t.Helper()
+	node, err := kyaml.Parse(config)
+	require.NoError(t, err)
+	krmInput := &KRMInput{}
+	return krmInput, processor.LoadFunctionConfig(node, krmInput, true)
+}
+
+func TestKRMInput_Schema_repositoryConfigs(t *testing.T) {
+	var paths []string
+	for _, pattern := range []string{
+		"../testdata/function/kustomize-inputs/*/krm-func.yaml",
+		"../examples/*/kustomize/*.yaml",
+		"../e2e/testdata/*/krm-func.yaml",
+	} {
+		matches, err := filepath.Glob(pattern)
+		require.NoError(t, err)
+		paths = append(paths, matches...)
+	}
+	require.NotEmpty(t, paths)
+
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		node, err := kyaml.Parse(string(content))
+		require.NoError(t, err)
+		if !strings.HasPrefix(node.GetApiVersion(), Group+"/") {
+			continue
+		}
+
+		t.Run(path, func(t *testing.T) {
+			_, err := loadFunctionConfig(t, string(content))
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestKRMInput_LoadFunctionConfig(t *testing.T) {
+	tests := []struct {
+		name            string
+		config          string
+		errorSubstrings []string
+		validate        func(t *testing.T, krmInput *KRMInput)
+	}{
+		{
+			name: "v1beta1 config",
+			config: `apiVersion: cuestomize.dev/v1beta1
+kind: Cuestomization
+metadata:
+  name: example
+input:
+  replicas: 3
+inputCUE: "replicas: >=3"
+includes:
+- version: v1
+  kind: Service
+  fieldSelector: spec.type=LoadBalancer
+remoteModule:
+  ref: ghcr.io/workday/module:v1.0.0
+  auth:
+    name: credentials
+`,
+			validate: func(t *testing.T, krmInput *KRMInput) {
+				assert.Equal(t, V1Beta1, krmInput.APIVersion)
+				assert.Equal(t, "Secret", krmInput.RemoteModule.Auth.Kind, "the auth kind should be defaulted")
+				assert.Equal(t, "replicas: >=3", krmInput.InputCUE)
+			},
+		},
+		{
+			name: "v1alpha1 config with deprecated remote module fields",
+			config: `apiVersion: cuestomize.dev/v1alpha1
+kind: Cuestomization
+metadata:
+  name: example
+remoteModule:
+  registry: ghcr.io
+  repo: workday/module
+  tag: v1.0.0
+`,
+			validate: func(t *testing.T, krmInput *KRMInput) {
+				assert.Equal(t, V1Alpha1, krmInput.APIVersion, "the API version should be left untouched")
+				assert.Equal(t, &RemoteModule{Ref: "ghcr.io/workday/module:v1.0.0"}, krmInput.RemoteModule)
+			},
+		},
+		{
+			name: "v1alpha1 config with both ref and deprecated remote module fields",
+			config: `apiVersion: cuestomize.dev/v1alpha1
+kind: Cuestomization
+metadata:
+  name: example
+remoteModule:
+  ref: ghcr.io/workday/module:v1.0.0
+  registry: old-registry.io
+  repo: old-repo
+`,
+			validate: func(t *testing.T, krmInput *KRMInput) {
+				assert.Equal(t, &RemoteModule{Ref: "ghcr.io/workday/module:v1.0.0"}, krmInput.RemoteModule)
+			},
+		},
+		{
+			name: "v1beta1 config with deprecated remote module fields",
+			config: `apiVersion: cuestomize.dev/v1beta1
+kind: Cuestomization
+metadata:
+  name: example
+remoteModule:
+  registry: ghcr.io
+  repo: workday/module
+`,
+			errorSubstrings: []string{
+				"remoteModule.registry: not supported in cuestomize.dev/v1beta1, use remoteModule.ref instead",
+				"remoteModule.repo: not supported in cuestomize.dev/v1beta1, use remoteModule.ref instead",
+			},
+		},
+		{
+			name: "unknown field",
+			config: `apiVersion: cuestomize.dev/v1beta1
+kind: Cuestomization
+metadata:
+  name: example
+remoteModul:
+  ref: ghcr.io/workday/module:v1.0.0
+`,
+			errorSubstrings: []string{"remoteModul in body is a forbidden property"},
+		},
+		{
+			name: "unknown nested field",
+			config: `apiVersion: cuestomize.dev/v1beta1
+kind: Cuestomization
+metadata:
+  name: example
+includes:
+- version: v1
+  kind: Service
+  lableSelector: app=example
+`,
+			errorSubstrings: []string{"includes[0].lableSelector in body is a forbidden property"},
+		},
+		{
+			name: "wrong type",
+			config: `apiVersion: cuestomize.dev/v1beta1
+kind: Cuestomization
+metadata:
+  name: example
+includes:
+- version: v1
+  min: one
+`,
+			errorSubstrings: []string{"includes[0].min in body must be of type integer"},
+		},
+		{
+			name: "invalid values",
+			config: `apiVersion: cuestomize.dev/v1beta1
+kind: Cuestomization
+metadata:
+  name: example
+includes:
+- version: v1
+  alias: services
+  fieldSelector: spec.replicas>many
+- version: v1
+  alias: services
+  name: "app["
+excludes:
+- labelSelector: "team in (platform"
+forEach:
+  kind: Deployment
+remoteModule:
+  ref: "ghcr.io/Workday/module:v1"
+  auth:
+    kind: ConfigMap
+outputPaths:
+- "outputs["
+tags:
+- ""
+`,
+			errorSubstrings: []string{
+				"includes[0]: invalid field selector requirement 'spec.replicas>many'",
+				"includes[1]: failed to create selector regex",
+				"includes[1].alias: duplicate include alias 'services'",
+				"excludes[0]: failed to parse label selector",
+				"remoteModule.ref: invalid reference",
+				`remoteModule.auth.kind: must be Secret, got "ConfigMap"`,
+				"outputPaths[0]: invalid CUE path 'outputs['",
+				"tags[0]: must not be empty",
+			},
+		},
+		{
+			name: "remote module without reference",
+			config: `apiVersion: cuestomize.dev/v1beta1
+kind: Cuestomization
+metadata:
+  name: example
+remoteModule:
+  plainHTTP: true
+`,
+			errorSubstrings: []string{"remoteModule.ref: must be set"},
+		},
+		{
+			name: "value reference without field path",
+			config: `apiVersion: cuestomize.dev/v1beta1
+kind: Cuestomization
+metadata:
+  name: example
+valueFrom:
+- inputPath: image
+  selector:
+    kind: Deployment
+`,
+			errorSubstrings: []string{"valueFrom[0].fieldPath in body is required"},
+		},
+		{
+			name: "invalid value reference fields",
+			config: `apiVersion: cuestomize.dev/v1beta1
+kind: Cuestomization
+metadata:
+  name: example
+valueFrom:
+- inputPath: namespace
+  selector:
+    kind: Namespace
+  fieldPath: metadata.name
+- inputPath: namespace
+  selector:
+    kind: Namespace
+  fieldPath: metadata.uid
+- inputPath: service..port
+  selector:
+    kind: Service
+  fieldPath: spec.ports
+- inputPath: replicas
+  selector:
+    labelSelector: "app in (example"
+  fieldPath: spec.replicas
+`,
+			errorSubstrings: []string{
+				"valueFrom[1].inputPath: duplicate input path 'namespace'",
+				"valueFrom[2].inputPath: field path 'service..port' has empty elements",
+				"valueFrom[3].selector: failed to parse label selector",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			krmInput, err := loadFunctionConfig(t, tt.config)
+			if len(tt.errorSubstrings) > 0 {
+				require.Error(t, err)
+				for _, substring := range tt.errorSubstrings {
+					assert.ErrorContains(t, err, substring)
+				}
+				return
+			}
+			require.NoError(t, err)
+			if tt.validate != nil {
+				tt.validate(t, krmInput)
+			}
+		})
+	}
+}
+
+func TestKRMInput_Default(t *testing.T) {
+	krmInput := &KRMInput{RemoteModule: &RemoteModule{Registry: "ghcr.io", Repo: "workday/module", Auth: &types.Selector{}}}
+	require.NoError(t, krmInput.Default())
+	assert.Equal(t, "ghcr.io/workday/module", krmInput.RemoteModule.Ref)
+	assert.Empty(t, krmInput.RemoteModule.Registry)
+	assert.Empty(t, krmInput.RemoteModule.Repo)
+	assert.Equal(t, "Secret", krmInput.RemoteModule.Auth.Kind)
+
+	require.NoError(t, (&KRMInput{}).Default(), "configs without remote module should be left untouched")
+}
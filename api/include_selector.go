@@ -0,0 +1,204 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/kustomize/api/types"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+// IncludeSelector selects the items forwarded to the CUE model as includes.
+// Besides the selector, it can name the matched items, and constrain how many of them there are.
+type IncludeSelector struct {
+	types.Selector `json:",inline" yaml:",inline"`
+
+	// FieldSelector, if set, only selects the items whose fields satisfy it, e.g. "spec.type=LoadBalancer,spec.replicas>1".
+	// See FieldSelector for its syntax.
+	FieldSelector string `yaml:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+	// Alias, if set, exposes the items matched by the selector under includes.named.<alias>, as a list.
+	Alias string `yaml:"alias,omitempty" json:"alias,omitempty"`
+	// Required makes the function fail if the selector matches no item. It is a shorthand for a Min of 1.
+	Required bool `yaml:"required,omitempty" json:"required,omitempty"`
+	// Min, if set, is the minimum number of items the selector must match.
+	Min *int `yaml:"min,omitempty" json:"min,omitempty"`
+	// Max, if set, is the maximum number of items the selector can match.
+	Max *int `yaml:"max,omitempty" json:"max,omitempty"`
+	// Keep, if set, are the paths of the fields of the matched items forwarded to the CUE model, e.g. "spec.replicas".
+	// The API version, kind, name and namespace are always forwarded.
+	Keep []string `yaml:"keep,omitempty" json:"keep,omitempty"`
+	// Drop are the paths of the fields of the matched items not forwarded to the CUE model.
+	Drop []string `yaml:"drop,omitempty" json:"drop,omitempty"`
+	// IncludeSecretData forwards the data and stringData of the matched Secrets, which are dropped otherwise.
+	IncludeSecretData bool `yaml:"includeSecretData,omitempty" json:"includeSecretData,omitempty"`
+}
+
+// NamedIncludes holds the items matched by the aliased include selectors, indexed by alias.
+type NamedIncludes map[string][]*kyaml.RNode
+
+// String returns the alias of the selector, if any, or the selector itself.
+func (s *IncludeSelector) String() string {
+	if s.Alias != "" {
+		return fmt.Sprintf("'%s'", s.Alias)
+	}
+	return fmt.Sprintf("[%s]", s.Selector.String())
+}
+
+// bounds returns the minimum and maximum number of items the selector must match, -1 for no maximum.
+func (s *IncludeSelector) bounds() (int, int) {
+	minimum, maximum := 0, -1
+	if s.Required {
+		minimum = 1
+	}
+	if s.Min != nil && *s.Min > minimum {
+		minimum = *s.Min
+	}
+	if s.Max != nil {
+		maximum = *s.Max
+	}
+	return minimum, maximum
+}
+
+// validate checks that the cardinality of the selector is consistent, and that its field selector and paths are valid.
+func (s *IncludeSelector) validate() error {
+	if s.FieldSelector != "" {
+		if _, err := ParseFieldSelector(s.FieldSelector); err != nil {
+			return err
+		}
+	}
+	if err := validateFieldPaths(s.Keep, s.Drop); err != nil {
+		return err
+	}
+	if s.Min != nil && *s.Min < 0 {
+		return fmt.Errorf("min must not be negative, got %d", *s.Min)
+	}
+	if s.Max != nil && *s.Max < 0 {
+		return fmt.Errorf("max must not be negative, got %d", *s.Max)
+	}
+	if minimum, maximum := s.bounds(); maximum >= 0 && minimum > maximum {
+		return fmt.Errorf("min (%d) must not be greater than max (%d)", minimum, maximum)
+	}
+	return nil
+}
+
+// checkCardinality returns an error describing the violation if the number of matched items
+// is out of the bounds of the selector.
+func (s *IncludeSelector) checkCardinality(matched []*kyaml.RNode) error {
+	minimum, maximum := s.bounds()
+	if len(matched) >= minimum && (maximum < 0 || len(matched) <= maximum) {
+		return nil
+	}
+
+	var expected string
+	switch {
+	case minimum == maximum:
+		expected = fmt.Sprintf("exactly %d", minimum)
+	case maximum < 0:
+		expected = fmt.Sprintf("at least %d", minimum)
+	case minimum == 0:
+		expected = fmt.Sprintf("at most %d", maximum)
+	default:
+		expected = fmt.Sprintf("between %d and %d", minimum, maximum)
+	}
+	msg := fmt.Sprintf("include %s matched %d items, expected %s", s.String(), len(matched), expected)
+	if len(matched) > 0 {
+		names := make([]string, 0, len(matched))
+		for _, item := range matched {
+			names = append(names, strings.Join([]string{item.GetApiVersion(), item.GetKind(), item.GetNamespace(), item.GetName()}, "/"))
+		}
+		msg += ": " + strings.Join(names, ", ")
+	}
+	return errors.New(msg)
+}
+
+// includeSelectorError is the error of the include selector at the index, about the field if set.
+type includeSelectorError struct {
+	index    int
+	field    string
+	selector string
+	err      error
+}
+
+func (e *includeSelectorError) Error() string {
+	if e.field != "" {
+		return e.err.Error()
+	}
+	return fmt.Sprintf("invalid include %s: %v", e.selector, e.err)
+}
+
+func (e *includeSelectorError) Unwrap() error {
+	return e.err
+}
+
+// validateIncludeSelectors checks that each selector is valid, and that aliases are unique,
+// returning an error for each offending selector.
+func validateIncludeSelectors(selectors []IncludeSelector) []*includeSelectorError {
+	var errs []*includeSelectorError
+	aliases := map[string]bool{}
+	for i := range selectors {
+		sel := &selectors[i]
+		if err := sel.validate(); err != nil {
+			errs = append(errs, &includeSelectorError{index: i, selector: sel.String(), err: err})
+		}
+		if sel.Alias == "" {
+			continue
+		}
+		if aliases[sel.Alias] {
+			errs = append(errs, &includeSelectorError{
+				index: i, field: "alias", selector: sel.String(), err: fmt.Errorf("duplicate include alias '%s'", sel.Alias),
+			})
+		}
+		aliases[sel.Alias] = true
+	}
+	return errs
+}
+
+// ExtractNamedIncludes returns the items matched by each aliased include selector of the KRMInput,
+// without the ones matching the excludes, projected by the selector. Aliases matching no item are mapped to an empty list.
+// It returns an error if the number of items matched by an include is out of its bounds.
+func ExtractNamedIncludes(ctx context.Context, krm *KRMInput, items []*kyaml.RNode) (NamedIncludes, error) {
+	_, named, err := ExtractAllIncludes(ctx, krm, items)
+	return named, err
+}
+
+// compile compiles the selector, along with its field selector.
+func (s *IncludeSelector) compile() (*CompiledSelector, error) {
+	compiled, err := CompileSelector(&s.Selector)
+	if err != nil {
+		return nil, err
+	}
+	if s.FieldSelector != "" {
+		compiled.fields, err = ParseFieldSelector(s.FieldSelector)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return compiled, nil
+}
+
+// compileIncludeSelectors compiles the given include selectors, in order.
+// Errors are prefixed with the selector that could not be compiled.
+func compileIncludeSelectors(selectors []IncludeSelector) ([]*CompiledSelector, error) {
+	compiled := make([]*CompiledSelector, 0, len(selectors))
+	for i := range selectors {
+		sel, err := selectors[i].compile()
+		if err != nil {
+			return nil, fmt.Errorf("[%v]: %w", selectors[i].Selector.String(), err)
+		}
+		compiled = append(compiled, sel)
+	}
+	return compiled, nil
+}
+
+// without returns the items not contained in excluded.
+func without(items []*kyaml.RNode, excluded map[*kyaml.RNode]struct{}) []*kyaml.RNode {
+	remaining := make([]*kyaml.RNode, 0, len(items))
+	for _, item := range items {
+		if _, ok := excluded[item]; !ok {
+			remaining = append(remaining, item)
+		}
+	}
+	return remaining
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/Workday/cuestomize/internal/pkg/testhelpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+func TestExtractNamedIncludes(t *testing.T) {
+	testdataDir := "../testdata/api/krm/ok-named-includes"
+	krmInput := testhelpers.LoadFromFile[KRMInput](t, testdataDir+"/"+TestKRMInputFileName)
+	items := testhelpers.LoadResourceList(t, testdataDir+"/"+TestKRMInputFileName, testdataDir+"/"+TestItemsFileName)
+
+	named, err := ExtractNamedIncludes(t.Context(), krmInput, items)
+	require.NoError(t, err)
+
+	names := func(items []*kyaml.RNode) []string {
+		result := []string{}
+		for _, item := range items {
+			result = append(result, item.GetName())
+		}
+		return result
+	}
+	assert.Len(t, named, 3)
+	assert.Equal(t, []string{"test-namespace"}, names(named["namespace"]))
+	assert.Equal(t, []string{"frontend", "backend"}, names(named["services"]), "excluded items should not be named")
+	assert.NotNil(t, named["configs"], "aliases matching no item should be mapped to an empty list")
+	assert.Empty(t, named["configs"])
+}
+
+func TestIncludeSelector_checkCardinality(t *testing.T) {
+	intPtr := func(i int) *int { return &i }
+	items := []*kyaml.RNode{
+		createTestNode(t, "v1", "Service", "default", "frontend"),
+		createTestNode(t, "v1", "Service", "default", "backend"),
+	}
+
+	tests := []struct {
+		name          string
+		selector      IncludeSelector
+		matched       []*kyaml.RNode
+		expectedError string
+	}{
+		{
+			name:     "no bounds",
+			selector: IncludeSelector{},
+			matched:  nil,
+		},
+		{
+			name:          "required without match",
+			selector:      IncludeSelector{Alias: "services", Required: true},
+			matched:       nil,
+			expectedError: "include 'services' matched 0 items, expected at least 1",
+		},
+		{
+			name:          "above max",
+			selector:      IncludeSelector{Alias: "services", Max: intPtr(1)},
+			matched:       items,
+			expectedError: "include 'services' matched 2 items, expected at most 1: v1/Service/default/frontend, v1/Service/default/backend",
+		},
+		{
+			name:          "below min",
+			selector:      IncludeSelector{Alias: "services", Min: intPtr(3), Max: intPtr(5)},
+			matched:       items,
+			expectedError: "include 'services' matched 2 items, expected between 3 and 5",
+		},
+		{
+			name:          "required and max of one",
+			selector:      IncludeSelector{Alias: "service", Required: true, Max: intPtr(1)},
+			matched:       items,
+			expectedError: "include 'service' matched 2 items, expected exactly 1",
+		},
+		{
+			name:     "within bounds",
+			selector: IncludeSelector{Alias: "services", Required: true, Max: intPtr(2)},
+			matched:  items,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.selector.checkCardinality(tt.matched)
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
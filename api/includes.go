@@ -1,6 +1,9 @@
 package api
 
 import (
+	"maps"
+	"slices"
+
 	"cuelang.org/go/cue"
 	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
 )
@@ -20,6 +23,23 @@ func (i Includes) Add(include *kyaml.RNode) {
 	i[include.GetApiVersion()][include.GetKind()][include.GetNamespace()][include.GetName()] = any(include)
 }
 
+// Items returns the included items, sorted by API version, kind, namespace and name.
+func (i Includes) Items() []*kyaml.RNode {
+	var items []*kyaml.RNode
+	for _, apiVersion := range slices.Sorted(maps.Keys(i)) {
+		for _, kind := range slices.Sorted(maps.Keys(i[apiVersion])) {
+			for _, namespace := range slices.Sorted(maps.Keys(i[apiVersion][kind])) {
+				for _, name := range slices.Sorted(maps.Keys(i[apiVersion][kind][namespace])) {
+					if item, ok := i[apiVersion][kind][namespace][name].(*kyaml.RNode); ok {
+						items = append(items, item)
+					}
+				}
+			}
+		}
+	}
+	return items
+}
+
 func (i Includes) initialiseMap(include *kyaml.RNode) {
 	apiVersion := include.GetApiVersion()
 	kind := include.GetKind()
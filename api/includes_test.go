@@ -140,6 +140,21 @@ func TestIncludes_Add(t *testing.T) {
 	}
 }
 
+func TestIncludes_Items(t *testing.T) {
+	includes := make(Includes)
+	includes.Add(createTestNode(t, "v1", "Secret", "default", "secret1"))
+	includes.Add(createTestNode(t, "apps/v1", "Deployment", "app", "deploy1"))
+	includes.Add(createTestNode(t, "v1", "ConfigMap", "default", "config2"))
+	includes.Add(createTestNode(t, "v1", "ConfigMap", "default", "config1"))
+	includes.Add(createTestNode(t, "v1", "ConfigMap", "", "config3"))
+
+	var names []string
+	for _, item := range includes.Items() {
+		names = append(names, item.GetName())
+	}
+	assert.Equal(t, []string{"deploy1", "config3", "config1", "config2", "secret1"}, names)
+}
+
 func createTestNode(t *testing.T, apiVersion, kind, namespace, name string) *kyaml.RNode {
 	t.Helper()
 
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"cuelang.org/go/cue"
@@ -12,7 +13,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"oras.land/oras-go/v2/registry/remote/auth"
 	"sigs.k8s.io/kustomize/api/types"
-	"sigs.k8s.io/kustomize/kyaml/resid"
 	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
 )
 
@@ -22,37 +22,121 @@ type KRMInput struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
 	// Input contains the KRM input specification.
-	Input        map[string]interface{} `yaml:"input" json:"input"`
-	Includes     []types.Selector       `yaml:"includes,omitempty" json:"includes,omitempty"`
-	RemoteModule *RemoteModule          `yaml:"remoteModule,omitempty" json:"remoteModule,omitempty"`
+	Input map[string]interface{} `yaml:"input" json:"input"`
+	// InputCUE holds inline CUE expressions unified with the input, e.g. to constrain its values or to reuse them.
+	InputCUE string `yaml:"inputCUE,omitempty" json:"inputCUE,omitempty"`
+	// Includes selects the items forwarded to the CUE model.
+	Includes []IncludeSelector `yaml:"includes,omitempty" json:"includes,omitempty"`
+	// Excludes removes the items matching any of its selectors from the ones matched by Includes.
+	Excludes     []types.Selector `yaml:"excludes,omitempty" json:"excludes,omitempty"`
+	RemoteModule *RemoteModule    `yaml:"remoteModule,omitempty" json:"remoteModule,omitempty"`
+	// ForEach, if set, makes the CUE model be evaluated once for each item matching the selector.
+	ForEach *types.Selector `yaml:"forEach,omitempty" json:"forEach,omitempty"`
+	// OutputPaths, if set, are the CUE paths of the outputs to add to the stream, in place of the default one.
+	OutputPaths []string `yaml:"outputPaths,omitempty" json:"outputPaths,omitempty"`
+	// Tags are the build tags passed to the CUE loader, e.g. "env=prod" for a field with a @tag(env) attribute,
+	// or "debug" for a file with a @if(debug) build constraint.
+	Tags []string `yaml:"tags,omitempty" json:"tags,omitempty"`
+	// TagVars are the variables injected in the fields with a @tag(name, var=variable) attribute, as strings.
+	TagVars map[string]string `yaml:"tagVars,omitempty" json:"tagVars,omitempty"`
+	// ValueFrom are references to the values of fields of resources of the stream, set in the input.
+	ValueFrom []ValueFrom `yaml:"valueFrom,omitempty" json:"valueFrom,omitempty"`
 }
 
 // ExtractIncludes populates the includes structure from the provided KRMInput and items.
-// It searches items for matches against the includes defined in the KRMInput's spec
-// and returns the includes map.
+// It searches items for matches against the includes defined in the KRMInput's spec,
+// subtracts the ones matching the excludes, and returns the includes map.
+// Items are projected by the first include they match, which drops the data of Secrets by default.
+// It returns an error if the number of items matched by an include is out of its bounds.
 func ExtractIncludes(ctx context.Context, krm *KRMInput, items []*kyaml.RNode) (Includes, error) {
+	includes, _, err := ExtractAllIncludes(ctx, krm, items)
+	return includes, err
+}
+
+// ExtractAllIncludes returns both the includes, as returned by ExtractIncludes, and the named includes,
+// as returned by ExtractNamedIncludes, matching each selector against the items only once.
+func ExtractAllIncludes(ctx context.Context, krm *KRMInput, items []*kyaml.RNode) (Includes, NamedIncludes, error) {
 	log := logr.FromContextOrDiscard(ctx)
 
-	includes := make(Includes)
+	if errs := validateIncludeSelectors(krm.Includes); len(errs) > 0 {
+		return nil, nil, errs[0]
+	}
 
-	for _, sel := range krm.Includes {
-		includesCount := 0
-		for _, item := range items {
-			itemMatches, err := ItemMatchReference(item, &sel)
-			if err != nil {
-				return nil, fmt.Errorf("failed to match item against selector [%v]: %w", sel.String(), err)
+	compiledIncludes, err := compileIncludeSelectors(krm.Includes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid include selector: %w", err)
+	}
+	compiledExcludes, err := compileSelectors(krm.Excludes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid exclude selector: %w", err)
+	}
+
+	var matched []*kyaml.RNode
+	firstSelector := map[*kyaml.RNode]*IncludeSelector{}
+	matchedBySelector := NewItemIndex(items).MatchAll(compiledIncludes)
+	for i, selMatched := range matchedBySelector {
+		sel := &krm.Includes[i]
+		if len(selMatched) == 0 {
+			log.V(-1).Info("no items matched for include selector", "selector", sel.Selector.String())
+		}
+		for _, item := range selMatched {
+			if _, ok := firstSelector[item]; !ok {
+				matched = append(matched, item)
+				firstSelector[item] = sel
 			}
-			if itemMatches {
-				includesCount++
-				includes.Add(item)
+		}
+	}
+
+	excluded := map[*kyaml.RNode]struct{}{}
+	for _, sel := range compiledExcludes {
+		remaining := make([]*kyaml.RNode, 0, len(matched))
+		for _, item := range matched {
+			if sel.Matches(item) {
+				excluded[item] = struct{}{}
+			} else {
+				remaining = append(remaining, item)
 			}
 		}
-		if includesCount == 0 {
-			log.V(-1).Info("no items matched for include selector", "selector", sel.String())
+		if count := len(matched) - len(remaining); count > 0 {
+			log.V(4).Info("excluded items from includes", "selector", sel.String(), "count", count)
 		}
+		matched = remaining
+	}
+
+	var errs []error
+	for i := range krm.Includes {
+		if err := krm.Includes[i].checkCardinality(without(matchedBySelector[i], excluded)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, nil, err
 	}
 
-	return includes, nil
+	includes := make(Includes)
+	for _, item := range matched {
+		projected, err := firstSelector[item].project(item)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to project item of include %s: %w", firstSelector[item].String(), err)
+		}
+		includes.Add(projected)
+	}
+
+	named := make(NamedIncludes)
+	for i := range krm.Includes {
+		sel := &krm.Includes[i]
+		if sel.Alias == "" {
+			continue
+		}
+		remaining := without(matchedBySelector[i], excluded)
+		for j, item := range remaining {
+			if remaining[j], err = sel.project(item); err != nil {
+				return nil, nil, fmt.Errorf("failed to project item of include %s: %w", sel.String(), err)
+			}
+		}
+		named[sel.Alias] = remaining
+	}
+	return includes, named, nil
 }
 
 // IntoCueValue tries to convert the KRMInput into a CUE value.
@@ -84,28 +168,13 @@ func (i *KRMInput) GetRemoteClient(items []*kyaml.RNode) (*auth.Client, error) {
 }
 
 // ItemMatchReference checks if the given item matches the provided selector.
+// The selector is compiled at each call: use CompileSelector to match it against many items.
 func ItemMatchReference(item *kyaml.RNode, sel *types.Selector) (bool, error) {
-	matchesLabel, err := item.MatchesLabelSelector(sel.LabelSelector)
-	if err != nil {
-		return false, fmt.Errorf("failed to match label selector: %w", err)
-	}
-
-	matchesAnnotation, err := item.MatchesAnnotationSelector(sel.AnnotationSelector)
-	if err != nil {
-		return false, fmt.Errorf("failed to match annotation selector: %w", err)
-	}
-
-	if !matchesLabel || !matchesAnnotation {
-		return false, nil
-	}
-
-	selRe, err := types.NewSelectorRegex(sel)
+	compiled, err := CompileSelector(sel)
 	if err != nil {
-		return false, fmt.Errorf("failed to create selector regex: %w", err)
+		return false, err
 	}
-	return selRe.MatchGvk(resid.GvkFromNode(item)) &&
-		selRe.MatchName(item.GetName()) &&
-		selRe.MatchNamespace(item.GetNamespace()), nil
+	return compiled.Matches(item), nil
 }
 
 // findAuthSecret searches items for a Secret that matches the provided selector.
@@ -1,6 +1,8 @@
 package api
 
 import (
+	"maps"
+	"slices"
 	"testing"
 
 	"github.com/Workday/cuestomize/internal/pkg/testhelpers"
@@ -22,6 +24,7 @@ func TestKRMInput_ExtractIncludes(t *testing.T) {
 		testdataDir    string
 		expectedError  bool
 		errorSubstring string
+		validate       func(t *testing.T, includes Includes)
 	}{
 		{
 			name:          "all includes found",
@@ -38,11 +41,65 @@ func TestKRMInput_ExtractIncludes(t *testing.T) {
 			testdataDir:   "../testdata/api/krm/ok-no-includes",
 			expectedError: false,
 		},
+		{
+			name:          "excluded items are subtracted from includes",
+			testdataDir:   "../testdata/api/krm/ok-excludes",
+			expectedError: false,
+			validate: func(t *testing.T, includes Includes) {
+				assert.Len(t, includes, 1)
+				assert.Len(t, includes["apps/v1"], 2)
+				assert.Equal(t, []string{"app"}, slices.Collect(maps.Keys(includes["apps/v1"]["Deployment"]["test-namespace"])))
+				assert.Equal(t, []string{"database"}, slices.Collect(maps.Keys(includes["apps/v1"]["StatefulSet"]["test-namespace"])))
+				assert.NotContains(t, includes["apps/v1"]["StatefulSet"], "legacy-namespace")
+			},
+		},
+		{
+			name:          "named includes within their bounds",
+			testdataDir:   "../testdata/api/krm/ok-named-includes",
+			expectedError: false,
+			validate: func(t *testing.T, includes Includes) {
+				assert.Len(t, includes["v1"]["Namespace"][""], 1)
+				assert.Len(t, includes["v1"]["Service"]["test-namespace"], 2)
+			},
+		},
+		{
+			name:           "includes out of their bounds",
+			testdataDir:    "../testdata/api/krm/nok-cardinality",
+			expectedError:  true,
+			errorSubstring: "include 'namespace' matched 2 items, expected exactly 1: v1/Namespace//test-namespace, v1/Namespace//other-namespace",
+		},
+		{
+			name:           "include with min greater than max",
+			testdataDir:    "../testdata/api/krm/nok-invalid-cardinality",
+			expectedError:  true,
+			errorSubstring: "invalid include 'services': min (2) must not be greater than max (1)",
+		},
+		{
+			name:           "duplicate include alias",
+			testdataDir:    "../testdata/api/krm/nok-duplicate-alias",
+			expectedError:  true,
+			errorSubstring: "duplicate include alias 'resources'",
+		},
+		{
+			name:          "field selectors",
+			testdataDir:   "../testdata/api/krm/ok-field-selector",
+			expectedError: false,
+			validate: func(t *testing.T, includes Includes) {
+				assert.Equal(t, []string{"frontend"}, slices.Collect(maps.Keys(includes["v1"]["Service"]["test-namespace"])))
+				assert.Equal(t, []string{"frontend"}, slices.Collect(maps.Keys(includes["apps/v1"]["Deployment"]["test-namespace"])))
+			},
+		},
+		{
+			name:           "malformed field selector",
+			testdataDir:    "../testdata/api/krm/nok-field-selector",
+			expectedError:  true,
+			errorSubstring: "invalid include 'deployments': invalid field selector requirement 'spec.replicas>many': operator '>' requires a number, got 'many'",
+		},
 		{
 			name:           "malformed selector",
 			testdataDir:    "../testdata/api/krm/nok-malformed-selector",
 			expectedError:  true,
-			errorSubstring: "failed to match item against selector",
+			errorSubstring: "invalid include selector: [",
 		},
 	}
 
@@ -61,6 +118,9 @@ func TestKRMInput_ExtractIncludes(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				require.NotNil(t, includes, "includes should not be nil")
+				if tt.validate != nil {
+					tt.validate(t, includes)
+				}
 
 				// TODO: verify the contents of the KRMInput.Spec after conversion
 			}
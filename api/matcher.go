@@ -0,0 +1,214 @@
+package api
+
+import (
+	"fmt"
+	"regexp"
+	"runtime"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+const (
+	// matchChunkSize is the number of candidate items matched against a selector by each goroutine.
+	matchChunkSize = 512
+)
+
+// CompiledSelector is a selector whose regular expressions and label and annotation selectors are compiled once,
+// so that it can be matched against many items cheaply.
+type CompiledSelector struct {
+	selector           types.Selector
+	regex              *types.SelectorRegex
+	labelSelector      labels.Selector
+	annotationSelector labels.Selector
+	// fields is the field selector of the include selector compiled, if any.
+	fields FieldSelector
+	// kind and namespace are the kind and namespace the selector matches, if literal, empty otherwise.
+	kind      string
+	namespace string
+}
+
+// CompileSelector compiles the given selector.
+func CompileSelector(sel *types.Selector) (*CompiledSelector, error) {
+	compiled := &CompiledSelector{selector: *sel}
+
+	var err error
+	compiled.labelSelector, err = labels.Parse(sel.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse label selector: %w", err)
+	}
+	compiled.annotationSelector, err = labels.Parse(sel.AnnotationSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse annotation selector: %w", err)
+	}
+	compiled.regex, err = types.NewSelectorRegex(&compiled.selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create selector regex: %w", err)
+	}
+
+	if isLiteral(sel.Kind) {
+		compiled.kind = sel.Kind
+	}
+	if isLiteral(sel.Namespace) {
+		compiled.namespace = sel.Namespace
+	}
+	return compiled, nil
+}
+
+// String returns the source selector, and its field selector, if any.
+func (s *CompiledSelector) String() string {
+	if len(s.fields) > 0 {
+		return s.selector.String() + " fieldSelector=" + s.fields.String()
+	}
+	return s.selector.String()
+}
+
+// Matches checks if the given item matches the selector.
+func (s *CompiledSelector) Matches(item *kyaml.RNode) bool {
+	if !s.labelSelector.Matches(labels.Set(item.GetLabels())) {
+		return false
+	}
+	if !s.annotationSelector.Matches(labels.Set(item.GetAnnotations())) {
+		return false
+	}
+	return s.regex.MatchGvk(resid.GvkFromNode(item)) &&
+		s.regex.MatchName(item.GetName()) &&
+		s.regex.MatchNamespace(item.GetNamespace()) &&
+		s.fields.Matches(item)
+}
+
+// compileSelectors compiles the given selectors, in order.
+// Errors are prefixed with the selector that could not be compiled.
+func compileSelectors(selectors []types.Selector) ([]*CompiledSelector, error) {
+	compiled := make([]*CompiledSelector, 0, len(selectors))
+	for i := range selectors {
+		sel, err := CompileSelector(&selectors[i])
+		if err != nil {
+			return nil, fmt.Errorf("[%v]: %w", selectors[i].String(), err)
+		}
+		compiled = append(compiled, sel)
+	}
+	return compiled, nil
+}
+
+// isLiteral checks if the selector pattern matches only itself.
+func isLiteral(pattern string) bool {
+	return pattern != "" && regexp.QuoteMeta(pattern) == pattern
+}
+
+// ItemIndex indexes items by kind and namespace, so that selectors with a literal kind or namespace
+// are only matched against the items that can match them.
+type ItemIndex struct {
+	items           []*kyaml.RNode
+	all             []int
+	byKind          map[string][]int
+	byNamespace     map[string][]int
+	byKindNamespace map[[2]string][]int
+}
+
+// NewItemIndex indexes the given items.
+func NewItemIndex(items []*kyaml.RNode) *ItemIndex {
+	idx := &ItemIndex{
+		items:           items,
+		all:             make([]int, len(items)),
+		byKind:          map[string][]int{},
+		byNamespace:     map[string][]int{},
+		byKindNamespace: map[[2]string][]int{},
+	}
+	for i, item := range items {
+		kind, namespace := item.GetKind(), item.GetNamespace()
+		idx.all[i] = i
+		idx.byKind[kind] = append(idx.byKind[kind], i)
+		idx.byNamespace[namespace] = append(idx.byNamespace[namespace], i)
+		idx.byKindNamespace[[2]string{kind, namespace}] = append(idx.byKindNamespace[[2]string{kind, namespace}], i)
+	}
+	return idx
+}
+
+// candidates returns the positions of the items that can match the selector, in order.
+func (idx *ItemIndex) candidates(sel *CompiledSelector) []int {
+	switch {
+	case sel.kind != "" && sel.namespace != "":
+		return idx.byKindNamespace[[2]string{sel.kind, sel.namespace}]
+	case sel.kind != "":
+		return idx.byKind[sel.kind]
+	case sel.namespace != "":
+		return idx.byNamespace[sel.namespace]
+	default:
+		return idx.all
+	}
+}
+
+// Match returns the items matching the selector, in the order they were indexed.
+func (idx *ItemIndex) Match(sel *CompiledSelector) []*kyaml.RNode {
+	return idx.MatchAll([]*CompiledSelector{sel})[0]
+}
+
+// MatchID returns the items with the given id, in the order they were indexed.
+// As for resid.ResId.Equals, the empty and the default namespaces are the same.
+func (idx *ItemIndex) MatchID(id resid.ResId) []*kyaml.RNode {
+	var matched []*kyaml.RNode
+	for _, i := range idx.byKind[id.Kind] {
+		if resid.FromRNode(idx.items[i]).Equals(id) {
+			matched = append(matched, idx.items[i])
+		}
+	}
+	return matched
+}
+
+// MatchAll returns, for each selector, the items matching it, in the order they were indexed.
+// Large sets of candidate items are split in chunks, matched in parallel.
+func (idx *ItemIndex) MatchAll(selectors []*CompiledSelector) [][]*kyaml.RNode {
+	type chunk struct {
+		selector int
+		start    int
+		end      int
+	}
+
+	candidates := make([][]int, len(selectors))
+	matches := make([][]bool, len(selectors))
+	var chunks []chunk
+	for i, sel := range selectors {
+		candidates[i] = idx.candidates(sel)
+		matches[i] = make([]bool, len(candidates[i]))
+		for start := 0; start < len(candidates[i]); start += matchChunkSize {
+			chunks = append(chunks, chunk{selector: i, start: start, end: min(start+matchChunkSize, len(candidates[i]))})
+		}
+	}
+
+	matchChunk := func(c chunk) {
+		for j := c.start; j < c.end; j++ {
+			matches[c.selector][j] = selectors[c.selector].Matches(idx.items[candidates[c.selector][j]])
+		}
+	}
+	if len(chunks) <= 1 {
+		for _, c := range chunks {
+			matchChunk(c)
+		}
+	} else {
+		sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+		var wg sync.WaitGroup
+		for _, c := range chunks {
+			wg.Go(func() {
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				matchChunk(c)
+			})
+		}
+		wg.Wait()
+	}
+
+	matched := make([][]*kyaml.RNode, len(selectors))
+	for i := range selectors {
+		for j, ok := range matches[i] {
+			if ok {
+				matched[i] = append(matched[i], idx.items[candidates[i][j]])
+			}
+		}
+	}
+	return matched
+}
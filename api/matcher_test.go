@@ -0,0 +1,248 @@
+package api
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+var (
+	syntheticKinds = []resid.Gvk{
+		{Group: "apps", Version: "v1", Kind: "Deployment"},
+		{Group: "apps", Version: "v1", Kind: "StatefulSet"},
+		{Version: "v1", Kind: "Service"},
+		{Version: "v1", Kind: "ConfigMap"},
+		{Version: "v1", Kind: "Secret"},
+		{Group: "networking.k8s.io", Version: "v1", Kind: "Ingress"},
+		{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "Role"},
+		{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"},
+	}
+	syntheticTeams = []string{"platform", "payments", "search", "identity"}
+)
+
+// syntheticItems returns n items of varied kinds, spread over namespaces, and labelled with a team.
+func syntheticItems(tb testing.TB, n int) []*kyaml.RNode {
+	tb.Helper()
+	items := make([]*kyaml.RNode, 0, n)
+	for i := range n {
+		gvk := syntheticKinds[i%len(syntheticKinds)]
+		namespace := ""
+		if gvk.Kind != "ClusterRole" {
+			namespace = fmt.Sprintf("namespace-%d", i%50)
+		}
+		item, err := kyaml.Parse(fmt.Sprintf(`apiVersion: %s
+kind: %s
+metadata:
+  name: item-%d
+  namespace: %q
+  labels:
+    team: %s
+  annotations:
+    example.com/index: "%d"
+`, gvk.ApiVersion(), gvk.Kind, i, namespace, syntheticTeams[i%len(syntheticTeams)], i))
+		require.NoError(tb, err)
+		items = append(items, item)
+	}
+	return items
+}
+
+func TestCompileSelector(t *testing.T) {
+	tests := []struct {
+		name           string
+		selector       types.Selector
+		errorSubstring string
+	}{
+		{
+			name:     "empty selector",
+			selector: types.Selector{},
+		},
+		{
+			name: "full selector",
+			selector: types.Selector{
+				ResId:              resid.ResId{Gvk: resid.Gvk{Group: "apps", Kind: "Deploy.*"}, Name: "app", Namespace: "default"},
+				LabelSelector:      "team in (platform,search)",
+				AnnotationSelector: "example.com/owner",
+			},
+		},
+		{
+			name:           "malformed label selector",
+			selector:       types.Selector{LabelSelector: "team in (platform"},
+			errorSubstring: "failed to parse label selector",
+		},
+		{
+			name:           "malformed annotation selector",
+			selector:       types.Selector{AnnotationSelector: "=="},
+			errorSubstring: "failed to parse annotation selector",
+		},
+		{
+			name:           "malformed regex",
+			selector:       types.Selector{ResId: resid.ResId{Name: "app["}},
+			errorSubstring: "failed to create selector regex",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compiled, err := CompileSelector(&tt.selector)
+			if tt.errorSubstring != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorSubstring)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.selector.String(), compiled.String())
+		})
+	}
+}
+
+func TestItemIndex_MatchAll(t *testing.T) {
+	items := syntheticItems(t, 3000)
+	selectors := []types.Selector{
+		{},
+		{ResId: resid.ResId{Gvk: resid.Gvk{Kind: "Deployment"}}},
+		{ResId: resid.ResId{Gvk: resid.Gvk{Kind: "Deployment"}, Namespace: "namespace-8"}},
+		{ResId: resid.ResId{Namespace: "namespace-3"}},
+		{ResId: resid.ResId{Gvk: resid.Gvk{Kind: "ClusterRole"}}},
+		{ResId: resid.ResId{Gvk: resid.Gvk{Kind: "(Deployment|StatefulSet)"}, Namespace: "namespace-1.*"}},
+		{ResId: resid.ResId{Gvk: resid.Gvk{Group: "rbac.authorization.k8s.io"}}, LabelSelector: "team=payments"},
+		{ResId: resid.ResId{Name: "item-1.*"}, AnnotationSelector: "example.com/index"},
+		{ResId: resid.ResId{Gvk: resid.Gvk{Kind: "Unknown"}}},
+	}
+
+	compiled, err := compileSelectors(selectors)
+	require.NoError(t, err)
+	matched := NewItemIndex(items).MatchAll(compiled)
+	require.Len(t, matched, len(selectors))
+
+	for i := range selectors {
+		// the index must match exactly the items, in order, that matching each item against the selector does
+		var expected []*kyaml.RNode
+		for _, item := range items {
+			matches, err := ItemMatchReference(item, &selectors[i])
+			require.NoError(t, err)
+			if matches {
+				expected = append(expected, item)
+			}
+		}
+		assert.Equal(t, expected, matched[i], "selector [%s]", selectors[i].String())
+	}
+}
+
+func TestItemIndex_candidates(t *testing.T) {
+	items := syntheticItems(t, 16)
+	idx := NewItemIndex(items)
+
+	tests := []struct {
+		name     string
+		selector types.Selector
+		expected int
+	}{
+		{name: "no kind nor namespace", selector: types.Selector{}, expected: 16},
+		{name: "literal kind", selector: types.Selector{ResId: resid.ResId{Gvk: resid.Gvk{Kind: "Service"}}}, expected: 2},
+		{name: "literal namespace", selector: types.Selector{ResId: resid.ResId{Namespace: "namespace-2"}}, expected: 1},
+		{name: "literal kind and namespace", selector: types.Selector{ResId: resid.ResId{Gvk: resid.Gvk{Kind: "Service"}, Namespace: "namespace-2"}}, expected: 1},
+		{name: "regex kind", selector: types.Selector{ResId: resid.ResId{Gvk: resid.Gvk{Kind: "Serv.*"}}}, expected: 16},
+		{name: "regex namespace", selector: types.Selector{ResId: resid.ResId{Namespace: "namespace-.*"}}, expected: 16},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compiled, err := CompileSelector(&tt.selector)
+			require.NoError(t, err)
+			assert.Len(t, idx.candidates(compiled), tt.expected)
+		})
+	}
+}
+
+func TestItemIndex_MatchID(t *testing.T) {
+	items := syntheticItems(t, 16)
+	defaultNamespaced, err := kyaml.Parse(`apiVersion: v1
+kind: Service
+metadata:
+  name: item-default
+`)
+	require.NoError(t, err)
+	items = append(items, defaultNamespaced)
+	idx := NewItemIndex(items)
+
+	service := resid.Gvk{Version: "v1", Kind: "Service"}
+	assert.Equal(t, []*kyaml.RNode{items[2]}, idx.MatchID(resid.ResId{Gvk: service, Name: "item-2", Namespace: "namespace-2"}))
+	assert.Equal(t, []*kyaml.RNode{defaultNamespaced}, idx.MatchID(resid.ResId{Gvk: service, Name: "item-default", Namespace: "default"}),
+		"the empty and the default namespaces should be the same")
+	assert.Empty(t, idx.MatchID(resid.ResId{Gvk: service, Name: "item-2", Namespace: "namespace-3"}))
+	assert.Empty(t, idx.MatchID(resid.ResId{Gvk: resid.Gvk{Version: "v1", Kind: "ConfigMap"}, Name: "item-2", Namespace: "namespace-2"}))
+}
+
+// benchmarkKRMInput returns a KRMInput with selectors typical of a large build:
+// literal kinds and namespaces, regexes, label selectors and excludes.
+func benchmarkKRMInput() *KRMInput {
+	return &KRMInput{
+		Includes: []IncludeSelector{
+			{Selector: types.Selector{ResId: resid.ResId{Gvk: resid.Gvk{Kind: "Deployment"}}}},
+			{Selector: types.Selector{ResId: resid.ResId{Gvk: resid.Gvk{Kind: "Service"}, Namespace: "namespace-7"}}},
+			{Selector: types.Selector{ResId: resid.ResId{Namespace: "namespace-1"}, LabelSelector: "team=search"}},
+			{Selector: types.Selector{ResId: resid.ResId{Gvk: resid.Gvk{Kind: "(ConfigMap|Secret)"}}, LabelSelector: "team in (platform,identity)"}},
+			{Selector: types.Selector{ResId: resid.ResId{Gvk: resid.Gvk{Group: "rbac.authorization.k8s.io"}}}},
+		},
+		Excludes: []types.Selector{
+			{ResId: resid.ResId{Namespace: "namespace-2.*"}},
+		},
+	}
+}
+
+var benchmarkSizes = []int{1000, 8000, 32000}
+
+func BenchmarkExtractIncludes(b *testing.B) {
+	krm := benchmarkKRMInput()
+	for _, size := range benchmarkSizes {
+		items := syntheticItems(b, size)
+		b.Run(fmt.Sprintf("items=%d", size), func(b *testing.B) {
+			for b.Loop() {
+				if _, err := ExtractIncludes(b.Context(), krm, items); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkItemIndex_MatchAll(b *testing.B) {
+	compiled, err := compileIncludeSelectors(benchmarkKRMInput().Includes)
+	require.NoError(b, err)
+	for _, size := range benchmarkSizes {
+		items := syntheticItems(b, size)
+		b.Run(fmt.Sprintf("items=%d", size), func(b *testing.B) {
+			for b.Loop() {
+				NewItemIndex(items).MatchAll(compiled)
+			}
+		})
+	}
+}
+
+// BenchmarkItemMatchReference is the baseline the index is compared to:
+// each item is matched against each selector, compiling the selector every time.
+func BenchmarkItemMatchReference(b *testing.B) {
+	var selectors []types.Selector
+	for _, include := range benchmarkKRMInput().Includes {
+		selectors = append(selectors, include.Selector)
+	}
+	for _, size := range benchmarkSizes {
+		items := syntheticItems(b, size)
+		b.Run(fmt.Sprintf("items=%d", size), func(b *testing.B) {
+			for b.Loop() {
+				for i := range selectors {
+					for _, item := range items {
+						if _, err := ItemMatchReference(item, &selectors[i]); err != nil {
+							b.Fatal(err)
+						}
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package api
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/kustomize/kyaml/kio/kioutil"
+	"sigs.k8s.io/kustomize/kyaml/utils"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+const (
+	// fieldPathDelimiter separates the elements of the keep and drop field paths.
+	// Elements containing it can be wrapped in brackets, e.g. "metadata.annotations.[example.com/key]".
+	fieldPathDelimiter = "."
+)
+
+var (
+	// identityFieldPaths are the field paths includes are indexed by: they are always kept, and cannot be dropped.
+	identityFieldPaths = [][]string{
+		{kyaml.APIVersionField},
+		{kyaml.KindField},
+		{kyaml.MetadataField, kyaml.NameField},
+		{kyaml.MetadataField, kyaml.NamespaceField},
+	}
+	// fileAnnotationFieldPaths are the field paths of the annotations recording the file an item was read from,
+	// always kept so that errors can be attributed to it.
+	fileAnnotationFieldPaths = [][]string{
+		{kyaml.MetadataField, kyaml.AnnotationsField, string(kioutil.PathAnnotation)},
+		{kyaml.MetadataField, kyaml.AnnotationsField, string(kioutil.IndexAnnotation)},
+		{kyaml.MetadataField, kyaml.AnnotationsField, string(kioutil.LegacyPathAnnotation)},
+		{kyaml.MetadataField, kyaml.AnnotationsField, string(kioutil.LegacyIndexAnnotation)},
+	}
+	// secretDataFieldPaths are the field paths of Secrets dropped unless IncludeSecretData is set.
+	secretDataFieldPaths = [][]string{
+		{"data"},
+		{"stringData"},
+	}
+)
+
+// project returns the item as forwarded to the CUE model by the selector: if the selector keeps or drops fields,
+// or if the item is a Secret whose data is not to be included, a copy of the item with only the kept fields,
+// and without the dropped ones. Otherwise, the item itself is returned.
+func (s *IncludeSelector) project(item *kyaml.RNode) (*kyaml.RNode, error) {
+	drop := splitFieldPaths(s.Drop)
+	if isSecret(item) && !s.IncludeSecretData {
+		drop = append(drop, secretDataFieldPaths...)
+	}
+	if len(s.Keep) == 0 && len(drop) == 0 {
+		return item, nil
+	}
+
+	projected := item.Copy()
+	if len(s.Keep) > 0 {
+		if err := keepFields(projected, slices.Concat(splitFieldPaths(s.Keep), identityFieldPaths, fileAnnotationFieldPaths)); err != nil {
+			return nil, fmt.Errorf("failed to keep fields %v: %w", s.Keep, err)
+		}
+	}
+	for _, path := range drop {
+		if err := dropField(projected, path); err != nil {
+			return nil, fmt.Errorf("failed to drop field '%s': %w", strings.Join(path, fieldPathDelimiter), err)
+		}
+	}
+	return projected, nil
+}
+
+// RedactSecretData returns the item without the data and stringData of Secrets: a copy if the item is a Secret,
+// the item itself otherwise.
+func RedactSecretData(item *kyaml.RNode) (*kyaml.RNode, error) {
+	if !isSecret(item) {
+		return item, nil
+	}
+	redacted := item.Copy()
+	for _, path := range secretDataFieldPaths {
+		if err := dropField(redacted, path); err != nil {
+			return nil, fmt.Errorf("failed to drop field '%s': %w", strings.Join(path, fieldPathDelimiter), err)
+		}
+	}
+	return redacted, nil
+}
+
+// keepFields removes from the node the fields not on any of the paths.
+// Paths go through sequences, applying to each of their elements.
+func keepFields(node *kyaml.RNode, paths [][]string) error {
+	switch node.YNode().Kind {
+	case kyaml.SequenceNode:
+		for _, elem := range node.Content() {
+			if err := keepFields(kyaml.NewRNode(elem), paths); err != nil {
+				return err
+			}
+		}
+	case kyaml.MappingNode:
+		fields, err := node.Fields()
+		if err != nil {
+			return err
+		}
+		for _, field := range fields {
+			var tails [][]string
+			keepAll := false
+			for _, path := range paths {
+				if path[0] != field {
+					continue
+				}
+				if len(path) == 1 {
+					keepAll = true
+					break
+				}
+				tails = append(tails, path[1:])
+			}
+			switch {
+			case keepAll:
+			case len(tails) > 0:
+				if err := keepFields(node.Field(field).Value, tails); err != nil {
+					return err
+				}
+			default:
+				if _, err := node.Pipe(kyaml.Clear(field)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// dropField removes the field at the path from the node, if present.
+// The path goes through sequences, applying to each of their elements.
+func dropField(node *kyaml.RNode, path []string) error {
+	switch node.YNode().Kind {
+	case kyaml.SequenceNode:
+		for _, elem := range node.Content() {
+			if err := dropField(kyaml.NewRNode(elem), path); err != nil {
+				return err
+			}
+		}
+	case kyaml.MappingNode:
+		if len(path) == 1 {
+			_, err := node.Pipe(kyaml.Clear(path[0]))
+			return err
+		}
+		if field := node.Field(path[0]); field != nil {
+			return dropField(field.Value, path[1:])
+		}
+	}
+	return nil
+}
+
+// splitFieldPaths splits the field paths into their elements.
+func splitFieldPaths(paths []string) [][]string {
+	split := make([][]string, 0, len(paths))
+	for _, path := range paths {
+		split = append(split, utils.SmarterPathSplitter(path, fieldPathDelimiter))
+	}
+	return split
+}
+
+// validateFieldPaths checks that the keep and drop field paths are not empty,
+// and that the drop ones do not remove the fields includes are indexed by.
+func validateFieldPaths(keep, drop []string) error {
+	for _, path := range slices.Concat(keep, drop) {
+		if slices.Contains(utils.SmarterPathSplitter(path, fieldPathDelimiter), "") {
+			return fmt.Errorf("field path '%s' has empty elements", path)
+		}
+	}
+	for i, path := range splitFieldPaths(drop) {
+		for _, identity := range identityFieldPaths {
+			if len(path) <= len(identity) && slices.Equal(path, identity[:len(path)]) {
+				return fmt.Errorf("field path '%s' cannot be dropped, includes are indexed by it", drop[i])
+			}
+		}
+	}
+	return nil
+}
+
+// isSecret checks if the item is a core Secret.
+func isSecret(item *kyaml.RNode) bool {
+	return item.GetApiVersion() == "v1" && item.GetKind() == "Secret"
+}
@@ -0,0 +1,219 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+func TestIncludeSelector_project(t *testing.T) {
+	deployment := `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+  namespace: default
+  labels:
+    team: platform
+  annotations:
+    example.com/owner: platform
+    internal.config.kubernetes.io/path: deployment.yaml
+spec:
+  replicas: 3
+  template:
+    spec:
+      containers:
+      - name: app
+        image: app:1.0
+        env:
+        - name: DEBUG
+          value: "true"
+      - name: sidecar
+        image: sidecar:1.0
+`
+	secret := `apiVersion: v1
+kind: Secret
+metadata:
+  name: credentials
+  namespace: default
+type: Opaque
+data:
+  password: c2VjcmV0
+stringData:
+  username: admin
+`
+
+	tests := []struct {
+		name     string
+		item     string
+		selector IncludeSelector
+		expected string
+		same     bool
+	}{
+		{
+			name:     "no projection",
+			item:     deployment,
+			selector: IncludeSelector{},
+			same:     true,
+		},
+		{
+			name:     "keep fields, through lists",
+			item:     deployment,
+			selector: IncludeSelector{Keep: []string{"spec.replicas", "spec.template.spec.containers.image", "metadata.annotations.[example.com/owner]"}},
+			expected: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+  namespace: default
+  annotations:
+    example.com/owner: platform
+    internal.config.kubernetes.io/path: deployment.yaml
+spec:
+  replicas: 3
+  template:
+    spec:
+      containers:
+      - image: app:1.0
+      - image: sidecar:1.0
+`,
+		},
+		{
+			name:     "drop fields, through lists",
+			item:     deployment,
+			selector: IncludeSelector{Drop: []string{"metadata.labels", "spec.template.spec.containers.env", "status"}},
+			expected: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+  namespace: default
+  annotations:
+    example.com/owner: platform
+    internal.config.kubernetes.io/path: deployment.yaml
+spec:
+  replicas: 3
+  template:
+    spec:
+      containers:
+      - name: app
+        image: app:1.0
+      - name: sidecar
+        image: sidecar:1.0
+`,
+		},
+		{
+			name:     "secret data dropped by default",
+			item:     secret,
+			selector: IncludeSelector{},
+			expected: `apiVersion: v1
+kind: Secret
+metadata:
+  name: credentials
+  namespace: default
+type: Opaque
+`,
+		},
+		{
+			name:     "secret data explicitly included",
+			item:     secret,
+			selector: IncludeSelector{IncludeSecretData: true},
+			same:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item, err := kyaml.Parse(tt.item)
+			require.NoError(t, err)
+
+			projected, err := tt.selector.project(item)
+			require.NoError(t, err)
+
+			if tt.same {
+				assert.Same(t, item, projected)
+				return
+			}
+			assert.Equal(t, tt.expected, projected.MustString())
+			assert.Equal(t, tt.item, item.MustString(), "the original item should not be modified")
+		})
+	}
+}
+
+func TestRedactSecretData(t *testing.T) {
+	secret := `apiVersion: v1
+kind: Secret
+metadata:
+  name: credentials
+  namespace: default
+type: Opaque
+data:
+  password: c2VjcmV0
+stringData:
+  username: admin
+`
+	item, err := kyaml.Parse(secret)
+	require.NoError(t, err)
+	redacted, err := RedactSecretData(item)
+	require.NoError(t, err)
+	assert.Equal(t, `apiVersion: v1
+kind: Secret
+metadata:
+  name: credentials
+  namespace: default
+type: Opaque
+`, redacted.MustString())
+	assert.Equal(t, secret, item.MustString(), "the original item should not be modified")
+
+	configMap, err := kyaml.Parse("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\ndata:\n  key: value\n")
+	require.NoError(t, err)
+	redacted, err = RedactSecretData(configMap)
+	require.NoError(t, err)
+	assert.Same(t, configMap, redacted, "items other than Secrets should be returned as is")
+}
+
+func TestValidateFieldPaths(t *testing.T) {
+	tests := []struct {
+		name          string
+		keep          []string
+		drop          []string
+		expectedError string
+	}{
+		{
+			name: "valid paths",
+			keep: []string{"spec.replicas", "metadata.annotations.[example.com/owner]"},
+			drop: []string{"metadata.labels"},
+		},
+		{
+			name:          "empty path",
+			keep:          []string{""},
+			expectedError: "field path '' has empty elements",
+		},
+		{
+			name:          "empty element",
+			drop:          []string{"spec..replicas"},
+			expectedError: "field path 'spec..replicas' has empty elements",
+		},
+		{
+			name:          "dropping an identity field",
+			drop:          []string{"metadata.name"},
+			expectedError: "field path 'metadata.name' cannot be dropped, includes are indexed by it",
+		},
+		{
+			name:          "dropping a parent of an identity field",
+			drop:          []string{"metadata"},
+			expectedError: "field path 'metadata' cannot be dropped, includes are indexed by it",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateFieldPaths(tt.keep, tt.drop)
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
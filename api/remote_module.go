@@ -35,9 +35,52 @@ func (r *RemoteModule) ParseReference() (registry.Reference, error) {
 	if r.Ref != "" {
 		return registry.ParseReference(r.Ref)
 	}
+	return registry.ParseReference(r.deprecatedReference())
+}
+
+// deprecatedReference returns the reference built from the deprecated registry, repo and tag fields.
+func (r *RemoteModule) deprecatedReference() string {
 	referenceStr := fmt.Sprintf("%s/%s", r.Registry, r.Repo)
 	if r.Tag != "" {
 		referenceStr = fmt.Sprintf("%s:%s", referenceStr, r.Tag)
 	}
-	return registry.ParseReference(referenceStr)
+	return referenceStr
+}
+
+// convertDeprecatedFields replaces the deprecated registry, repo and tag fields with the equivalent ref,
+// unless the ref is already set, in which case they are ignored.
+func (r *RemoteModule) convertDeprecatedFields() {
+	if r.Ref == "" && (r.Registry != "" || r.Repo != "") {
+		r.Ref = r.deprecatedReference()
+	}
+	r.Registry, r.Repo, r.Tag = "", "", ""
+}
+
+// validate checks the remote module of a function config of the API version, returning an error for each
+// offending field. The deprecated fields are rejected in v1beta1 function configs.
+func (r *RemoteModule) validate(apiVersion string) []error {
+	var errs []error
+	if apiVersion == V1Beta1 {
+		for _, deprecated := range []struct{ field, value string }{{"registry", r.Registry}, {"repo", r.Repo}, {"tag", r.Tag}} {
+			if deprecated.value != "" {
+				errs = append(errs, fmt.Errorf("remoteModule.%s: not supported in %s, use remoteModule.ref instead", deprecated.field, V1Beta1))
+			}
+		}
+	}
+
+	if r.Ref == "" && r.Registry == "" && r.Repo == "" {
+		errs = append(errs, fmt.Errorf("remoteModule.ref: must be set"))
+	} else if _, err := r.ParseReference(); err != nil {
+		errs = append(errs, fmt.Errorf("remoteModule.ref: %w", err))
+	}
+
+	if r.Auth != nil {
+		if r.Auth.Kind != "" && r.Auth.Kind != authSecretKind {
+			errs = append(errs, fmt.Errorf(`remoteModule.auth.kind: must be %s, got "%s"`, authSecretKind, r.Auth.Kind))
+		}
+		if _, err := CompileSelector(r.Auth); err != nil {
+			errs = append(errs, fmt.Errorf("remoteModule.auth: %w", err))
+		}
+	}
+	return errs
 }
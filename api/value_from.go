@@ -0,0 +1,161 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/utils"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+// ValueFrom references the value of a field of a resource of the stream, to be set in the input of the CUE model.
+type ValueFrom struct {
+	// InputPath is the path, within the input, the value is set at, e.g. "service.port".
+	InputPath string `yaml:"inputPath" json:"inputPath"`
+	// Selector selects the resource the value is read from. It must match exactly one resource.
+	Selector types.Selector `yaml:"selector" json:"selector"`
+	// FieldPath is the path of the field of the resource holding the value, e.g. "spec.ports.[name=http].port".
+	FieldPath string `yaml:"fieldPath" json:"fieldPath"`
+}
+
+// validate checks that the paths of the reference are set and valid, and that its selector compiles.
+func (v *ValueFrom) validate() error {
+	if v.InputPath == "" {
+		return fmt.Errorf("inputPath: must be set")
+	}
+	if slices.Contains(utils.SmarterPathSplitter(v.InputPath, fieldPathDelimiter), "") {
+		return fmt.Errorf("inputPath: field path '%s' has empty elements", v.InputPath)
+	}
+	if v.FieldPath == "" {
+		return fmt.Errorf("fieldPath: must be set")
+	}
+	if slices.Contains(utils.SmarterPathSplitter(v.FieldPath, fieldPathDelimiter), "") {
+		return fmt.Errorf("fieldPath: field path '%s' has empty elements", v.FieldPath)
+	}
+	if _, err := CompileSelector(&v.Selector); err != nil {
+		return fmt.Errorf("selector: %w", err)
+	}
+	return nil
+}
+
+// resolve returns the value of the field of the only item matching the selector.
+func (v *ValueFrom) resolve(index *ItemIndex) (any, error) {
+	selector, err := CompileSelector(&v.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector [%v]: %w", v.Selector.String(), err)
+	}
+	matched := index.Match(selector)
+	switch len(matched) {
+	case 0:
+		return nil, fmt.Errorf("selector [%v] matched no resource", v.Selector.String())
+	case 1:
+	default:
+		names := make([]string, 0, len(matched))
+		for _, item := range matched {
+			names = append(names, strings.Join([]string{item.GetApiVersion(), item.GetKind(), item.GetNamespace(), item.GetName()}, "/"))
+		}
+		return nil, fmt.Errorf("selector [%v] matched %d resources, expected exactly 1: %s", v.Selector.String(), len(matched), strings.Join(names, ", "))
+	}
+
+	item := matched[0]
+	field, err := item.Pipe(kyaml.Lookup(utils.SmarterPathSplitter(v.FieldPath, fieldPathDelimiter)...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up field '%s': %w", v.FieldPath, err)
+	}
+	if field == nil || field.YNode().Tag == kyaml.NodeTagNull {
+		return nil, fmt.Errorf("field '%s' not found in %s/%s/%s/%s", v.FieldPath,
+			item.GetApiVersion(), item.GetKind(), item.GetNamespace(), item.GetName())
+	}
+
+	var value any
+	if err := field.YNode().Decode(&value); err != nil {
+		return nil, fmt.Errorf("failed to decode field '%s': %w", v.FieldPath, err)
+	}
+	return value, nil
+}
+
+// ResolveInput returns the KRMInput with the values referenced by its valueFrom entries set in its input.
+// If there are none, the KRMInput itself is returned; otherwise a copy, leaving the given one untouched.
+// It returns an error if a reference cannot be resolved, or if the input already has a value at its path.
+func ResolveInput(ctx context.Context, krm *KRMInput, items []*kyaml.RNode) (*KRMInput, error) {
+	if len(krm.ValueFrom) == 0 {
+		return krm, nil
+	}
+	log := logr.FromContextOrDiscard(ctx)
+
+	input := deepCopyValue(krm.Input).(map[string]any)
+
+	index := NewItemIndex(items)
+	var errs []error
+	for i := range krm.ValueFrom {
+		ref := &krm.ValueFrom[i]
+		value, err := ref.resolve(index)
+		if err == nil {
+			err = setInputValue(input, utils.SmarterPathSplitter(ref.InputPath, fieldPathDelimiter), value)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("valueFrom[%d] (input path '%s'): %w", i, ref.InputPath, err))
+			continue
+		}
+		log.V(4).Info("resolved input value from stream", "inputPath", ref.InputPath, "selector", ref.Selector.String(), "fieldPath", ref.FieldPath)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	resolved := *krm
+	resolved.Input = input
+	return &resolved, nil
+}
+
+// setInputValue sets the value at the path within the input, creating the intermediate objects.
+// It returns an error if the path is already set, or goes through a value that is not an object.
+func setInputValue(input map[string]any, path []string, value any) error {
+	current := input
+	for i, elem := range path[:len(path)-1] {
+		next, ok := current[elem]
+		if !ok || next == nil {
+			child := map[string]any{}
+			current[elem] = child
+			current = child
+			continue
+		}
+		child, ok := next.(map[string]any)
+		if !ok {
+			return fmt.Errorf("input value at '%s' is not an object", strings.Join(path[:i+1], fieldPathDelimiter))
+		}
+		current = child
+	}
+
+	last := path[len(path)-1]
+	if _, ok := current[last]; ok {
+		return fmt.Errorf("input value at '%s' is already set", strings.Join(path, fieldPathDelimiter))
+	}
+	current[last] = value
+	return nil
+}
+
+// deepCopyValue returns a copy of the value decoded from YAML or JSON, sharing no map or slice with it.
+func deepCopyValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		copied := make(map[string]any, len(v))
+		for key, elem := range v {
+			copied[key] = deepCopyValue(elem)
+		}
+		return copied
+	case []any:
+		copied := make([]any, len(v))
+		for i, elem := range v {
+			copied[i] = deepCopyValue(elem)
+		}
+		return copied
+	default:
+		return v
+	}
+}
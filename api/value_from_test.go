@@ -0,0 +1,129 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+func TestResolveInput(t *testing.T) {
+	var items []*kyaml.RNode
+	for _, item := range []string{`apiVersion: v1
+kind: Namespace
+metadata:
+  name: example-namespace
+`, `apiVersion: v1
+kind: Service
+metadata:
+  name: frontend
+  namespace: example-namespace
+spec:
+  ports:
+  - name: http
+    port: 8080
+  - name: metrics
+    port: 9090
+`, `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+  namespace: example-namespace
+data:
+  log.level: debug
+  empty: ""
+`, `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: other
+  namespace: example-namespace
+`} {
+		node, err := kyaml.Parse(item)
+		require.NoError(t, err)
+		items = append(items, node)
+	}
+
+	namespace := types.Selector{ResId: resid.ResId{Gvk: resid.Gvk{Kind: "Namespace"}}}
+	service := types.Selector{ResId: resid.ResId{Gvk: resid.Gvk{Kind: "Service"}, Name: "frontend"}}
+	settings := types.Selector{ResId: resid.ResId{Gvk: resid.Gvk{Kind: "ConfigMap"}, Name: "settings"}}
+
+	tests := []struct {
+		name            string
+		input           map[string]any
+		valueFrom       []ValueFrom
+		expected        map[string]any
+		errorSubstrings []string
+	}{
+		{
+			name:  "values set in input",
+			input: map[string]any{"replicas": int64(3), "service": map[string]any{"name": "frontend"}},
+			valueFrom: []ValueFrom{
+				{InputPath: "namespace", Selector: namespace, FieldPath: "metadata.name"},
+				{InputPath: "service.port", Selector: service, FieldPath: "spec.ports.[name=http].port"},
+				{InputPath: "logging.[log.level]", Selector: settings, FieldPath: "data.[log.level]"},
+				{InputPath: "ports", Selector: service, FieldPath: "spec.ports"},
+				{InputPath: "empty", Selector: settings, FieldPath: "data.empty"},
+			},
+			expected: map[string]any{
+				"replicas":  int64(3),
+				"namespace": "example-namespace",
+				"service":   map[string]any{"name": "frontend", "port": 8080},
+				"logging":   map[string]any{"log.level": "debug"},
+				"ports": []any{
+					map[string]any{"name": "http", "port": 8080},
+					map[string]any{"name": "metrics", "port": 9090},
+				},
+				"empty": "",
+			},
+		},
+		{
+			name:      "nil input",
+			valueFrom: []ValueFrom{{InputPath: "namespace", Selector: namespace, FieldPath: "metadata.name"}},
+			expected:  map[string]any{"namespace": "example-namespace"},
+		},
+		{
+			name:  "unresolvable references",
+			input: map[string]any{"namespace": "set", "service": "frontend"},
+			valueFrom: []ValueFrom{
+				{InputPath: "deployment", Selector: types.Selector{ResId: resid.ResId{Gvk: resid.Gvk{Kind: "Deployment"}}}, FieldPath: "metadata.name"},
+				{InputPath: "configMap", Selector: types.Selector{ResId: resid.ResId{Gvk: resid.Gvk{Kind: "ConfigMap"}}}, FieldPath: "metadata.name"},
+				{InputPath: "missing", Selector: settings, FieldPath: "data.missing"},
+				{InputPath: "namespace", Selector: namespace, FieldPath: "metadata.name"},
+				{InputPath: "service.port", Selector: service, FieldPath: "spec.ports.[name=http].port"},
+			},
+			errorSubstrings: []string{
+				"valueFrom[0] (input path 'deployment'): selector [Deployment.[noVer].[noGrp]/[noName].[noNs]:a=:l=] matched no resource",
+				"valueFrom[1] (input path 'configMap'): selector [ConfigMap.[noVer].[noGrp]/[noName].[noNs]:a=:l=] matched 2 resources, expected exactly 1: " +
+					"v1/ConfigMap/example-namespace/settings, v1/ConfigMap/example-namespace/other",
+				"valueFrom[2] (input path 'missing'): field 'data.missing' not found in v1/ConfigMap/example-namespace/settings",
+				"valueFrom[3] (input path 'namespace'): input value at 'namespace' is already set",
+				"valueFrom[4] (input path 'service.port'): input value at 'service' is not an object",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			krmInput := &KRMInput{Input: tt.input, ValueFrom: tt.valueFrom}
+			resolved, err := ResolveInput(t.Context(), krmInput, items)
+			if len(tt.errorSubstrings) > 0 {
+				require.Error(t, err)
+				for _, substring := range tt.errorSubstrings {
+					assert.ErrorContains(t, err, substring)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resolved.Input)
+			assert.Equal(t, tt.input, krmInput.Input, "the given KRMInput should be left untouched")
+		})
+	}
+
+	krmInput := &KRMInput{Input: map[string]any{"replicas": 3}}
+	resolved, err := ResolveInput(t.Context(), krmInput, items)
+	require.NoError(t, err)
+	assert.Same(t, krmInput, resolved, "KRMInputs without valueFrom should be returned as is")
+}
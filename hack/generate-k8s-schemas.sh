@@ -0,0 +1,78 @@
+#!/bin/bash
+
+set -euo pipefail
+
+fail() {
+  echo "error: $*" >&2
+  exit 1
+}
+
+if [ "$#" -lt 1 ] || [ "$#" -gt 2 ]; then
+  echo "Usage: $0 <path-to-schemas-directory> [<kubernetes-minor-version>]" >&2
+  echo "The Kubernetes minor version (e.g. 1.35) defaults to the one of the k8s.io/api version the module depends on." >&2
+  exit 1
+fi
+
+for tool in git go cue; do
+  command -v "$tool" >/dev/null || fail "$tool command not found in PATH."
+done
+
+# the Kubernetes API groups bundled in the schemas
+packages=(
+  k8s.io/api/core/v1
+  k8s.io/api/apps/v1
+  k8s.io/api/batch/v1
+  k8s.io/api/autoscaling/v2
+  k8s.io/api/networking/v1
+  k8s.io/api/policy/v1
+  k8s.io/api/rbac/v1
+  k8s.io/api/storage/v1
+)
+
+root=$(git rev-parse --show-toplevel)
+[ -d "$1" ] || fail "schemas directory '$1' does not exist."
+schemas=$(cd "$1" && pwd)
+
+# the cue command generates the schemas, which must be readable by the CUE version the module depends on
+cue_version=$(cue version | awk '/^cue version/ {print $3}')
+module_cue_version=$(cd "$root" && go list -m -f '{{.Version}}' cuelang.org/go)
+[ "$cue_version" = "$module_cue_version" ] ||
+  fail "cue command version '${cue_version}' does not match the cuelang.org/go version '${module_cue_version}' of the module."
+
+# the schemas are generated from a k8s.io/api version: v0.X.Y is Kubernetes 1.X
+if [ "$#" -eq 2 ]; then
+  kubernetes_version=${2#v}
+  [[ "$kubernetes_version" =~ ^1\.([0-9]+)$ ]] || fail "invalid Kubernetes minor version '$2', expected e.g. 1.35."
+  minor=${BASH_REMATCH[1]}
+  api_version=$(cd "$root" && go list -m -versions k8s.io/api | tr ' ' '\n' | grep -E "^v0\.${minor}\.[0-9]+$" | sort -V | tail -n 1 || true)
+  [ -n "$api_version" ] || fail "no k8s.io/api release found for Kubernetes ${kubernetes_version}."
+else
+  api_version=$(cd "$root" && go list -m -f '{{.Version}}' k8s.io/api)
+  [[ "$api_version" =~ ^v0\.([0-9]+)\.[0-9]+$ ]] || fail "unexpected k8s.io/api version '${api_version}', expected v0.X.Y."
+  kubernetes_version="1.${BASH_REMATCH[1]}"
+fi
+echo "generating Kubernetes ${kubernetes_version} schemas from k8s.io/api ${api_version}"
+
+workdir=$(mktemp -d)
+trap 'rm -fr "$workdir"' EXIT
+
+pushd "$workdir" >/dev/null
+
+go mod init schemas.cuestomize.dev
+go mod edit -require "k8s.io/api@${api_version}"
+cp "$root/go.sum" .
+printf 'package schemas\n\nimport _ "k8s.io/api/core/v1"\n' > tools.go
+go mod tidy
+
+cue mod init schemas.cuestomize.dev
+cue get go "${packages[@]}"
+
+popd >/dev/null
+
+for package in "${packages[@]}"; do
+  compgen -G "$workdir/cue.mod/gen/${package}/*.cue" >/dev/null || fail "no schemas generated for ${package}."
+done
+
+rm -fr "${schemas:?}/${kubernetes_version}"
+mkdir -p "${schemas}/${kubernetes_version}"
+cp -r "$workdir/cue.mod/gen/." "${schemas}/${kubernetes_version}/"
@@ -8,6 +8,7 @@ import (
 	"github.com/Workday/cuestomize/pkg/cuerrors"
 	"github.com/Workday/cuestomize/pkg/cuestomize"
 	"github.com/Workday/cuestomize/pkg/cuestomize/model"
+	"sigs.k8s.io/kustomize/kyaml/fn/framework"
 
 	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
 )
@@ -44,6 +45,15 @@ func newCuestomizeFunctionWithPath(ctx context.Context, config *api.KRMInput, re
 			return nil, err
 		}
 
-		return cuestomize.Cuestomize(ctx, items, config, cuestomize.WithModelProvider(provider))
+		var results framework.Results
+		items, err = cuestomize.Cuestomize(ctx, items, config, cuestomize.WithModelProvider(provider), cuestomize.WithResults(&results))
+		if err != nil {
+			return nil, err
+		}
+		if len(results) > 0 {
+			// non-fatal results are returned as error, as kustomize's framework expects
+			return items, results
+		}
+		return items, nil
 	}
 }
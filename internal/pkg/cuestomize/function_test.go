@@ -1,11 +1,17 @@
 package cuestomize
 
 import (
+	"context"
+	"errors"
+	"slices"
 	"testing"
 
 	"github.com/Workday/cuestomize/api"
 	"github.com/Workday/cuestomize/internal/pkg/testhelpers"
+	"github.com/Workday/cuestomize/pkg/cuestomize"
+	"sigs.k8s.io/kustomize/kyaml/fn/framework"
 	"sigs.k8s.io/kustomize/kyaml/resid"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
 
 	"github.com/stretchr/testify/require"
 )
@@ -24,6 +30,12 @@ func TestKRMFunction(t *testing.T) {
 		TestdataKustomizePath string
 		ShouldFail            bool
 		Expected              []resid.ResId
+		// Check, if set, runs additional assertions on the resulting items.
+		Check func(t *testing.T, items []*kyaml.RNode)
+		// CheckError, if set, runs additional assertions on the returned error.
+		CheckError func(t *testing.T, err error)
+		// CheckResults, if set, runs additional assertions on the non-fatal results returned with the items.
+		CheckResults func(t *testing.T, results framework.Results)
 	}{
 		// configmap-model tests
 		{
@@ -38,6 +50,18 @@ func TestKRMFunction(t *testing.T) {
 				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "example-configmap", "default"),
 			},
 		},
+		// closed-model tests
+		{
+			Name:                  "closed-model with closed-ok should only fill the fields declared by the closed model",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/closed-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/closed-ok",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "Closed"}, "closed", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "example-configmap", "default"),
+			},
+		},
 		// configmap-struct-model tests
 		{
 			Name:                  "configmap-struct-model with configmap-ok should succeed",
@@ -76,6 +100,57 @@ func TestKRMFunction(t *testing.T) {
 				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "nginx-deployment", "nginx"),
 			},
 		},
+		{
+			Name:                  "deployment-model with deployment-provenance should stamp outputs",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/deployment-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/deployment-provenance",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "NginxDeployment"}, "nginx-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Service"}, "example-service", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "nginx-deployment", "nginx"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				annotations := findItem(t, items, "Deployment", "nginx-deployment").GetAnnotations()
+				require.Equal(t, "../../../testdata/function/cue-modules/deployment-model", annotations["example.com/module-ref"])
+				require.Equal(t, "nginx-deployment", annotations["example.com/config-name"])
+				require.Equal(t, "example-namespace", annotations["example.com/config-namespace"])
+				require.Regexp(t, "^sha256:[0-9a-f]{64}$", annotations["example.com/input-hash"])
+				require.NotContains(t, annotations, "example.com/module-digest", "local modules have no digest")
+
+				require.Empty(t, findItem(t, items, "Deployment", "example-deployment").GetAnnotations()["example.com/input-hash"],
+					"input resources should not be stamped")
+			},
+		},
+		{
+			Name:                  "deployment-model with deployment-provenance-keys should stamp outputs with the custom keys",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/deployment-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/deployment-provenance-keys",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "NginxDeployment"}, "nginx-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Service"}, "example-service", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "nginx-deployment", "nginx"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				annotations := findItem(t, items, "Deployment", "nginx-deployment").GetAnnotations()
+				require.Regexp(t, "^sha256:[0-9a-f]{64}$", annotations["audit.example.com/inputs-sha256"])
+				require.NotContains(t, annotations, "example.com/input-hash", "the overridden key should replace the prefixed one")
+				require.NotContains(t, annotations, "example.com/config-namespace", "an empty key should omit the annotation")
+				require.Equal(t, "nginx-deployment", annotations["example.com/config-name"], "the other keys should keep the prefix")
+			},
+		},
+		{
+			Name:                  "deployment-model with deployment-provenance-unknown-key should fail",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/deployment-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/deployment-provenance-unknown-key",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "unknown provenance annotation 'module-version'")
+			},
+		},
 		{
 			Name:                  "deployment-model with deployment-unexpected-includes should fail",
 			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/deployment-model",
@@ -94,6 +169,993 @@ func TestKRMFunction(t *testing.T) {
 			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/deployment-wrong-kind",
 			ShouldFail:            true,
 		},
+		// patch-model tests
+		{
+			Name:                  "patch-model with patch-ok should succeed",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/patch-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/patch-ok",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "SidecarInjector"}, "sidecar-injector", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Service"}, "example-service", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				deployment := findItem(t, items, "Deployment", "example-deployment")
+				containers, err := deployment.Pipe(kyaml.Lookup("spec", "template", "spec", "containers"))
+				require.NoError(t, err)
+				require.Len(t, containers.Content(), 2, "sidecar container should be merged into the existing containers")
+				require.NotNil(t, containers.Element("name", "main"))
+				require.NotNil(t, containers.Element("name", "sidecar"))
+
+				service := findItem(t, items, "Service", "example-service")
+				require.Equal(t, map[string]string{"team": "example-team"}, service.GetLabels())
+			},
+		},
+		// patch-only-model tests
+		{
+			Name:                  "patch-only-model with patch-only-ok should succeed",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/patch-only-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/patch-only-ok",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "TierLabeler"}, "tier-labeler", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Service"}, "example-service", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				deployment := findItem(t, items, "Deployment", "example-deployment")
+				require.Equal(t, map[string]string{"app": "example-app", "tier": "backend"}, deployment.GetLabels())
+			},
+			CheckResults: func(t *testing.T, results framework.Results) {
+				require.Len(t, results, 1)
+				require.Equal(t, framework.Warning, results[0].Severity)
+				require.Contains(t, results[0].Message, "patch target [ConfigMap.v1.[noGrp]/missing-config.example-namespace] matched no resource")
+			},
+		},
+		{
+			Name:                  "patch-only-model with patch-only-strict-unmatched should fail",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/patch-only-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/patch-only-strict-unmatched",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "no items matched for patch target")
+			},
+		},
+		// conflict-model tests
+		{
+			Name:                  "conflict-model with conflict-error should fail",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/conflict-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/conflict-error",
+			ShouldFail:            true,
+		},
+		{
+			Name:                  "conflict-model with conflict-deep-merge should succeed",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/conflict-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/conflict-deep-merge",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "ServiceLabeler"}, "service-labeler", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Service"}, "example-service", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				require.Len(t, items, 3, "colliding output should not be appended")
+				service := findItem(t, items, "Service", "example-service")
+				require.Equal(t, map[string]string{"app": "example-app", "managed-by": "cuestomize"}, service.GetLabels())
+				ports, err := service.Pipe(kyaml.Lookup("spec", "ports"))
+				require.NoError(t, err)
+				require.NotNil(t, ports, "existing fields should be preserved by the merge")
+			},
+		},
+		{
+			Name:                  "conflict-model with conflict-keep-output should succeed",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/conflict-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/conflict-keep-output",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "ServiceLabeler"}, "service-labeler", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Service"}, "example-service", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				require.Len(t, items, 3, "colliding output should not be appended")
+				service := findItem(t, items, "Service", "example-service")
+				require.Equal(t, map[string]string{"app": "example-app"}, service.GetLabels(), "the output policy should take precedence")
+			},
+		},
+		// deletion-model tests
+		{
+			Name:                  "deletion-model with deletion-ok should succeed",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/deletion-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/deletion-ok",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "ServiceReplacer"}, "service-replacer", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Service"}, "example-service-v2", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				require.Len(t, items, 3, "the original Service should be deleted")
+			},
+		},
+		{
+			Name:                  "deletion-model with deletion-strict-unmatched should fail",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/deletion-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/deletion-strict-unmatched",
+			ShouldFail:            true,
+		},
+		// foreach-model tests
+		{
+			Name:                  "foreach-model with foreach-ok should succeed",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/foreach-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/foreach-ok",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "DisruptionBudgets"}, "disruption-budgets", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "other-deployment", "other-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Service"}, "example-service", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "policy", Version: "v1", Kind: "PodDisruptionBudget"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "policy", Version: "v1", Kind: "PodDisruptionBudget"}, "other-deployment", "other-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				require.Len(t, items, 6, "one PodDisruptionBudget should be generated for each Deployment")
+			},
+		},
+		{
+			Name:                  "foreach-model with foreach-invalid-item should fail",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/foreach-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/foreach-invalid-item",
+			ShouldFail:            true,
+		},
+		// validator-model tests
+		{
+			Name:                  "validator-model with validator-invalid should fail with results",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/validator-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/validator-invalid",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				var results framework.Results
+				require.ErrorAs(t, err, &results)
+				require.Len(t, results, 1)
+				require.Equal(t, framework.Error, results[0].Severity)
+				require.Equal(t, &kyaml.ResourceIdentifier{
+					TypeMeta: kyaml.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+					NameMeta: kyaml.NameMeta{Name: "example-deployment", Namespace: "example-namespace"},
+				}, results[0].ResourceRef)
+				require.Equal(t, "spec.replicas", results[0].Field.Path)
+				require.Contains(t, results[0].Tags[cuestomize.PositionsResultTag], "main.cue")
+			},
+		},
+		// multi-error-model tests
+		{
+			Name:                  "multi-error-model with multi-error-all should fail with all the errors",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/multi-error-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/multi-error-all",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				var errs cuestomize.ValidationErrors
+				require.ErrorAs(t, err, &errs)
+				require.Len(t, errs, 3, "both conflicts and incomplete values should be reported")
+				byResource := errs.ByResource()
+				require.Len(t, byResource, 2)
+				for _, resourceErrs := range byResource {
+					require.NotNil(t, resourceErrs[0].Resource)
+				}
+				require.Equal(t, errs[0].Resource, errs[1].Resource, "errors should be grouped by resource")
+
+				var results framework.Results
+				require.ErrorAs(t, err, &results)
+				require.Len(t, results, 3)
+			},
+		},
+		{
+			Name:                  "multi-error-model with multi-error-capped should fail with capped errors",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/multi-error-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/multi-error-capped",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				var errs cuestomize.ValidationErrors
+				require.ErrorAs(t, err, &errs)
+				require.Len(t, errs, 2)
+				require.ErrorContains(t, err, "showing the first 2 of 3 errors")
+			},
+		},
+		// hash-model tests
+		{
+			Name:                  "hash-model with hash-suffix-ok should append hash suffixes and rewrite references",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/hash-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/hash-suffix-ok",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "AppConfig"}, "app-config", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "other-deployment", "other-namespace"),
+				// same name kustomize's configMapGenerator gives to a ConfigMap with the same content
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "app-config-hf678c7m2b", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Secret"}, "app-secret", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "app", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				configMap := findItem(t, items, "ConfigMap", "app-config-hf678c7m2b")
+				require.Empty(t, configMap.GetAnnotations(), "marker and build annotations should be removed")
+
+				secret := findItem(t, items, "Secret", "app-secret")
+				require.Empty(t, secret.GetAnnotations(), "the output annotation should take precedence over the function config")
+
+				envFrom, err := findItem(t, items, "Deployment", "app").Pipe(kyaml.Lookup("spec", "template", "spec", "containers", "[name=main]", "envFrom"))
+				require.NoError(t, err)
+				require.Equal(t, configMap.GetName(), envFrom.Content()[0].Content[1].Content[1].Value, "output references should be rewritten")
+				require.Equal(t, "app-secret", envFrom.Content()[1].Content[1].Content[1].Value)
+
+				volumeRef := func(name string) string {
+					ref, err := findItem(t, items, "Deployment", name).Pipe(kyaml.Lookup("spec", "template", "spec", "volumes", "[name=config]", "configMap", "name"))
+					require.NoError(t, err)
+					return ref.YNode().Value
+				}
+				require.Equal(t, configMap.GetName(), volumeRef("example-deployment"), "stream references should be rewritten")
+				require.Equal(t, "app-config", volumeRef("other-deployment"), "references from other namespaces should not be rewritten")
+			},
+		},
+		{
+			Name:                  "hash-model with hash-suffix-annotations should keep the file and identity annotations of the input items",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/hash-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/hash-suffix-annotations",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "AppConfig"}, "app-config", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Service"}, "example-service", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "app-config-hf678c7m2b", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Secret"}, "app-secret", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "app", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				deployment := findItem(t, items, "Deployment", "example-deployment")
+				require.Subset(t, deployment.GetAnnotations(), map[string]string{
+					"config.kubernetes.io/path":           "deployments/example-deployment.yaml",
+					"config.kubernetes.io/index":          "0",
+					"internal.config.kubernetes.io/path":  "deployments/example-deployment.yaml",
+					"internal.config.kubernetes.io/index": "0",
+					"internal.config.kubernetes.io/id":    "1",
+				}, "the annotations of the input items should be kept")
+				ref, err := deployment.Pipe(kyaml.Lookup("spec", "template", "spec", "volumes", "[name=config]", "configMap", "name"))
+				require.NoError(t, err)
+				require.Equal(t, "app-config-hf678c7m2b", kyaml.GetValue(ref))
+
+				var ids []string
+				for _, item := range items {
+					if item.GetKind() == "Service" {
+						ids = append(ids, item.GetAnnotations()["internal.config.kubernetes.io/id"])
+					}
+				}
+				require.Equal(t, []string{"2", "3"}, ids, "input items with the same resource id should be kept")
+
+				require.Empty(t, findItem(t, items, "ConfigMap", "app-config-hf678c7m2b").GetAnnotations(), "marker and build annotations should be removed")
+			},
+		},
+		{
+			Name:                  "hash-model with hash-suffix-upstream-generated should only rename the outputs",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/hash-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/hash-suffix-upstream-generated",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "AppConfig"}, "app-config", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "generated", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "app-config-hf678c7m2b", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Secret"}, "app-secret", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "app", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				generated := findItem(t, items, "ConfigMap", "generated")
+				require.Equal(t, "enabled", generated.GetAnnotations()["internal.config.kubernetes.io/needsHashSuffix"],
+					"items marked by kustomize should be left to kustomize")
+				ref, err := findItem(t, items, "Deployment", "example-deployment").Pipe(kyaml.Lookup("spec", "template", "spec", "volumes", "[name=generated]", "configMap", "name"))
+				require.NoError(t, err)
+				require.Equal(t, "generated", kyaml.GetValue(ref))
+				require.Empty(t, findItem(t, items, "ConfigMap", "app-config-hf678c7m2b").GetAnnotations())
+			},
+		},
+		{
+			Name:                  "hash-model with hash-suffix-upstream-generated-no-opt-in should leave the stream untouched",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/hash-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/hash-suffix-upstream-generated-no-opt-in",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "AppConfig"}, "app-config", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "generated", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "app-config", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Secret"}, "app-secret", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "app", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				generated := findItem(t, items, "ConfigMap", "generated")
+				require.Equal(t, "enabled", generated.GetAnnotations()["internal.config.kubernetes.io/needsHashSuffix"],
+					"items marked by kustomize should be left to kustomize")
+				ref, err := findItem(t, items, "Deployment", "example-deployment").Pipe(kyaml.Lookup("spec", "template", "spec", "volumes", "[name=generated]", "configMap", "name"))
+				require.NoError(t, err)
+				require.Equal(t, "generated", kyaml.GetValue(ref))
+				require.Empty(t, findItem(t, items, "ConfigMap", "app-config").GetAnnotations())
+			},
+		},
+		// ordering-model tests
+		{
+			Name:                  "ordering-model with ordering-gvk should sort outputs by GVK",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/ordering-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/ordering-gvk",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "Bundle"}, "bundle", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Service"}, "example-service", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "admissionregistration.k8s.io", Version: "v1", Kind: "ValidatingWebhookConfiguration"}, "example-webhook", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "example.com", Version: "v1", Kind: "Widget"}, "example-widget", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}, "widgets.example.com", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "config-a", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "config-b", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Namespace"}, "example-namespace", ""),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				require.Equal(t, []string{
+					"ConfigMap/config-a",
+					"ConfigMap/config-b",
+					"Namespace/example-namespace",
+					"ValidatingWebhookConfiguration/example-webhook",
+					"CustomResourceDefinition/widgets.example.com",
+					"Deployment/example-deployment",
+					"Widget/example-widget",
+				}, outputNames(items, "Service", "Bundle"))
+			},
+		},
+		{
+			Name:                  "ordering-model with ordering-install should sort outputs in install order",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/ordering-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/ordering-install",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "Bundle"}, "bundle", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Service"}, "example-service", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "admissionregistration.k8s.io", Version: "v1", Kind: "ValidatingWebhookConfiguration"}, "example-webhook", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "example.com", Version: "v1", Kind: "Widget"}, "example-widget", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}, "widgets.example.com", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "config-a", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "config-b", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Namespace"}, "example-namespace", ""),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				require.Equal(t, []string{
+					"Namespace/example-namespace",
+					"CustomResourceDefinition/widgets.example.com",
+					"ConfigMap/config-a",
+					"ConfigMap/config-b",
+					"Deployment/example-deployment",
+					"Widget/example-widget",
+					"ValidatingWebhookConfiguration/example-webhook",
+				}, outputNames(items, "Service", "Bundle"))
+			},
+		},
+		// output-sets-model tests
+		{
+			Name:                  "output-sets-model with output-sets-crds should only output the CRDs",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/output-sets-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/output-sets-crds",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "Bundle"}, "bundle", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Namespace"}, "example-namespace", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}, "widgets.example.com", ""),
+			},
+		},
+		{
+			Name:                  "output-sets-model with output-sets-multi should flatten the nested outputs of all the paths",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/output-sets-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/output-sets-multi",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "Bundle"}, "bundle", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Namespace"}, "example-namespace", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Service"}, "example-service", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "config-a", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "config-b", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}, "widgets.example.com", ""),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				require.Equal(t, []string{
+					"Deployment/example-deployment",
+					"Service/example-service",
+					"ConfigMap/config-a",
+					"ConfigMap/config-b",
+					"CustomResourceDefinition/widgets.example.com",
+				}, outputNames(items, "Namespace", "Bundle"))
+			},
+		},
+		{
+			Name:                  "output-sets-model with output-sets-missing should fail",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/output-sets-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/output-sets-missing",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "'outputs.gateways' not found")
+			},
+		},
+		// tags-model tests
+		{
+			Name:                  "tags-model with tags-ok should inject the tags and tag variables",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/tags-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/tags-ok",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "Settings"}, "settings", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Namespace"}, "example-namespace", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "settings", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "debug-settings", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				settings := findItem(t, items, "ConfigMap", "settings")
+				require.Equal(t, map[string]string{"ENV": "prod", "REGION": "eu-west-1"}, settings.GetDataMap())
+			},
+		},
+		{
+			Name:                  "tags-model with tags-default should use the defaults and skip the constrained files",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/tags-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/tags-default",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "Settings"}, "settings", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Namespace"}, "example-namespace", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "settings", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				settings := findItem(t, items, "ConfigMap", "settings")
+				require.Equal(t, map[string]string{"ENV": "dev", "REGION": "local"}, settings.GetDataMap())
+			},
+		},
+		{
+			Name:                  "tags-model with tags-unknown should fail",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/tags-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/tags-unknown",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "no tag for \"flavour\"")
+			},
+		},
+		// paths-model tests
+		{
+			Name:                  "paths-model with paths-ok should fill and look up the configured paths",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/paths-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/paths-ok",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "Legacy"}, "example-legacy", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Namespace"}, "example-namespace", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-legacy", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				deployment := findItem(t, items, "Deployment", "example-legacy")
+				image, err := deployment.GetString("spec.template.spec.containers[0].image")
+				require.NoError(t, err)
+				require.Equal(t, "nginx:1.27", image)
+			},
+		},
+		{
+			Name:                  "paths-model with paths-invalid-include should attribute the errors to the included resource",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/paths-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/paths-invalid-include",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				var errs cuestomize.ValidationErrors
+				require.ErrorAs(t, err, &errs)
+				require.NotEmpty(t, errs)
+				require.NotNil(t, errs[0].Resource)
+				require.Equal(t, "example-namespace", errs[0].Resource.Name)
+				require.Equal(t, []string{"metadata", "labels", "team"}, errs[0].Path)
+			},
+		},
+		{
+			Name:                  "paths-model with paths-overlap should fail",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/paths-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/paths-overlap",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "includes path 'context.includes' and outputs path 'context' overlap")
+			},
+		},
+		// stream-list-model tests
+		{
+			Name:                  "stream-list-model with stream-list should inject the stream without the excluded items",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/stream-list-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/stream-list",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "Inventory"}, "inventory", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Namespace"}, "example-namespace", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "local-settings", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "inventory", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				inventory := findItem(t, items, "ConfigMap", "inventory")
+				require.Equal(t, map[string]string{
+					"0": "Namespace/example-namespace",
+					"1": "Deployment/example-deployment",
+				}, inventory.GetDataMap())
+			},
+		},
+		{
+			Name:                  "stream-list-model with stream-list-all should inject the whole stream",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/stream-list-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/stream-list-all",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "Inventory"}, "inventory", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Namespace"}, "example-namespace", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "local-settings", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "inventory", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				inventory := findItem(t, items, "ConfigMap", "inventory")
+				require.Equal(t, map[string]string{
+					"0": "Inventory/inventory",
+					"1": "Namespace/example-namespace",
+					"2": "Deployment/example-deployment",
+					"3": "ConfigMap/local-settings",
+				}, inventory.GetDataMap())
+			},
+		},
+		// stream-nested-model tests
+		{
+			Name:                  "stream-nested-model with stream-nested-ok should succeed",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/stream-nested-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/stream-nested-ok",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "Policy"}, "policy", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Namespace"}, "example-namespace", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "local-settings", "example-namespace"),
+			},
+		},
+		{
+			Name:                  "stream-nested-model with stream-nested-invalid should fail",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/stream-nested-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/stream-nested-invalid",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				var errs cuestomize.ValidationErrors
+				require.ErrorAs(t, err, &errs)
+				require.NotEmpty(t, errs)
+				for _, e := range errs {
+					require.ErrorContains(t, e, ".unlabelled-deployment.", "only the Deployment without label should be reported")
+				}
+			},
+		},
+		// includes-layout-model tests
+		{
+			Name:                  "includes-layout-model with includes-layout-nested should succeed",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/includes-layout-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/includes-layout-nested",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "TeamIndex"}, "team-index", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Namespace"}, "example-namespace", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "example-config", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "team-index", "example-namespace"),
+			},
+			Check: teamIndexCheck("nested"),
+		},
+		{
+			Name:                  "includes-layout-model with includes-layout-list should succeed",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/includes-layout-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/includes-layout-list",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "TeamIndex"}, "team-index", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Namespace"}, "example-namespace", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "example-config", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "team-index", "example-namespace"),
+			},
+			Check: teamIndexCheck("list"),
+		},
+		{
+			Name:                  "includes-layout-model with includes-layout-flat should succeed",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/includes-layout-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/includes-layout-flat",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "TeamIndex"}, "team-index", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Namespace"}, "example-namespace", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "example-config", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "team-index", "example-namespace"),
+			},
+			Check: teamIndexCheck("flat"),
+		},
+		{
+			Name:                  "includes-layout-model with includes-layout-by-kind should succeed",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/includes-layout-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/includes-layout-by-kind",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "TeamIndex"}, "team-index", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Namespace"}, "example-namespace", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "example-config", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "team-index", "example-namespace"),
+			},
+			Check: teamIndexCheck("by-kind"),
+		},
+		{
+			Name:                  "includes-layout-model with includes-layout-collision should fail on resources with the same flat key",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/includes-layout-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/includes-layout-collision",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "included resources [apps/v1/Deployment/example-namespace/example-deployment] and "+
+					"[example.com/v1/Deployment/example-namespace/example-deployment] have the same key 'Deployment/example-namespace/example-deployment' in the 'flat' includes layout")
+			},
+		},
+		{
+			Name:                  "includes-layout-model with includes-layout-invalid should attribute the errors to the included resource",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/includes-layout-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/includes-layout-invalid",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				var errs cuestomize.ValidationErrors
+				require.ErrorAs(t, err, &errs)
+				require.NotEmpty(t, errs)
+				for _, e := range errs {
+					require.NotNil(t, e.Resource)
+					require.Equal(t, "Deployment", e.Resource.Kind)
+					require.Equal(t, "example-deployment", e.Resource.Name)
+					require.Equal(t, []string{"metadata", "labels", "team"}, e.Path)
+				}
+			},
+		},
+		{
+			Name:                  "includes-layout-model with includes-layout-unknown should fail",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/includes-layout-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/includes-layout-unknown",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "unknown includes layout 'unknown'")
+			},
+		},
+		// named-includes-model tests
+		{
+			Name:                  "named-includes-model with named-includes-ok should succeed",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/named-includes-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/named-includes-ok",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "ServiceIndex"}, "service-index", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Namespace"}, "example-namespace", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Service"}, "frontend", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Service"}, "backend", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "service-index", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				index := findItem(t, items, "ConfigMap", "service-index")
+				require.Equal(t, map[string]string{
+					"team":     "platform",
+					"frontend": "LoadBalancer",
+					"backend":  "ClusterIP",
+				}, index.GetDataMap())
+			},
+		},
+		{
+			Name:                  "named-includes-model with named-includes-missing should fail before evaluation",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/named-includes-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/named-includes-missing",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "include 'namespace' matched 0 items, expected exactly 1")
+				var errs cuestomize.ValidationErrors
+				require.False(t, errors.As(err, &errs), "the model should not be evaluated")
+			},
+		},
+		{
+			Name:                  "named-includes-model with named-includes-invalid should attribute the errors to the named resource",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/named-includes-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/named-includes-invalid",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				var errs cuestomize.ValidationErrors
+				require.ErrorAs(t, err, &errs)
+				require.NotEmpty(t, errs)
+				require.NotNil(t, errs[0].Resource)
+				require.Equal(t, "Namespace", errs[0].Resource.Kind)
+				require.Equal(t, "example-namespace", errs[0].Resource.Name)
+				require.Equal(t, []string{"metadata", "labels", "team"}, errs[0].Path)
+			},
+		},
+		{
+			Name:                  "named-includes-model with named-includes-list-layout should fail",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/named-includes-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/named-includes-list-layout",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "named includes cannot be used with the 'list' includes layout")
+			},
+		},
+		// projection-model tests
+		{
+			Name:                  "projection-model with projection-ok should forward the projected includes without Secret data",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/projection-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/projection-ok",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "IncludesReport"}, "includes-report", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Secret"}, "credentials", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "includes-report", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				report := findItem(t, items, "ConfigMap", "includes-report")
+				require.Equal(t, map[string]string{
+					"secret.apiVersion":        "forwarded",
+					"secret.kind":              "forwarded",
+					"secret.metadata":          "forwarded",
+					"secret.type":              "forwarded",
+					"deployment.spec.replicas": "forwarded",
+				}, report.GetDataMap())
+				secret := findItem(t, items, "Secret", "credentials")
+				require.Equal(t, map[string]string{"password": "c2VjcmV0"}, secret.GetDataMap(), "items of the stream should not be projected")
+			},
+		},
+		{
+			Name:                  "projection-model with projection-secret-data should forward the Secret data when requested",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/projection-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/projection-secret-data",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "IncludesReport"}, "includes-report", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Secret"}, "credentials", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "includes-report", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				report := findItem(t, items, "ConfigMap", "includes-report")
+				require.Equal(t, map[string]string{
+					"secret.apiVersion":        "forwarded",
+					"secret.kind":              "forwarded",
+					"secret.metadata":          "forwarded",
+					"secret.type":              "forwarded",
+					"secret.data":              "forwarded",
+					"deployment.spec.replicas": "forwarded",
+					"deployment.spec.selector": "forwarded",
+				}, report.GetDataMap())
+				secret := findItem(t, items, "Secret", "credentials")
+				require.Equal(t, map[string]string{"password": "c2VjcmV0"}, secret.GetDataMap(), "items of the stream should not be projected")
+			},
+		},
+		// k8s-schemas-model tests
+		{
+			Name:                  "k8s-schemas-model with k8s-schemas-ok should import the bundled Kubernetes API schemas",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/k8s-schemas-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/k8s-schemas-ok",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "ReplicasReport"}, "replicas-report", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "replicas-report", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				report := findItem(t, items, "ConfigMap", "replicas-report")
+				require.Equal(t, map[string]string{"example-namespace.example-deployment": "3"}, report.GetDataMap())
+			},
+		},
+		{
+			Name:                  "k8s-schemas-model with k8s-schemas-version should import the bundled Kubernetes API schemas of the version",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/k8s-schemas-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/k8s-schemas-version",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "ReplicasReport"}, "replicas-report", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "replicas-report", "example-namespace"),
+			},
+		},
+		{
+			Name:                  "k8s-schemas-model with k8s-schemas-invalid should attribute the schema errors to the included resource",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/k8s-schemas-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/k8s-schemas-invalid",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				var errs cuestomize.ValidationErrors
+				require.ErrorAs(t, err, &errs)
+				require.NotEmpty(t, errs)
+				require.NotNil(t, errs[0].Resource)
+				require.Equal(t, "Deployment", errs[0].Resource.Kind)
+				require.Equal(t, "example-deployment", errs[0].Resource.Name)
+				require.Equal(t, []string{"spec", "replicas"}, errs[0].Path)
+			},
+		},
+		{
+			Name:                  "k8s-schemas-model with k8s-schemas-unknown-version should fail",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/k8s-schemas-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/k8s-schemas-unknown-version",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "no API schemas bundled for Kubernetes '1.10'")
+			},
+		},
+		{
+			Name:                  "k8s-schemas-model with k8s-schemas-none should fail to import the Kubernetes API schemas",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/k8s-schemas-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/k8s-schemas-none",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "k8s.io/api/apps/v1")
+			},
+		},
+		// value-from-model tests
+		{
+			Name:                  "value-from-model with value-from-ok should resolve the input values from the stream",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/value-from-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/value-from-ok",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1beta1", Kind: "Cuestomization"}, "example-cuestomization", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Namespace"}, "example-namespace", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Service"}, "example-service", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "example-configmap", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				serviceURL, err := findItem(t, items, "ConfigMap", "example-configmap").GetString("data.serviceURL")
+				require.NoError(t, err)
+				require.Equal(t, "http://example-service.example-namespace.svc:8080", serviceURL)
+			},
+		},
+		{
+			Name:                  "value-from-model with value-from-missing should fail naming the unresolved reference",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/value-from-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/value-from-missing",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "valueFrom[1] (input path 'service.port'): field 'spec.ports.[name=grpc].port' not found in v1/Service/example-namespace/example-service")
+			},
+		},
+		// input-cue-model tests
+		{
+			Name:                  "input-cue-model with input-cue-ok should unify the inline CUE input with the input",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/input-cue-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/input-cue-ok",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1beta1", Kind: "Cuestomization"}, "example-cuestomization", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Namespace"}, "example-namespace", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "example-app", "default"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				require.Equal(t, map[string]string{"app": "example-app", "app.kubernetes.io/instance": "example-app"}, findItem(t, items, "ConfigMap", "example-app").GetLabels())
+			},
+		},
+		{
+			Name:                  "input-cue-model with input-cue-conflict should fail on the constrained input value",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/input-cue-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/input-cue-conflict",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				var errs cuestomize.ValidationErrors
+				require.ErrorAs(t, err, &errs)
+				require.NotEmpty(t, errs)
+				require.Equal(t, []string{"input", "replicas"}, errs[0].Path)
+			},
+		},
+		{
+			Name:                  "input-cue-model with input-cue-invalid should report the compile errors against the function config file",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/input-cue-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/input-cue-invalid",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "failed to compile inputCUE of function config")
+				require.ErrorContains(t, err, `reference "name" not found`)
+				var results framework.Results
+				require.ErrorAs(t, err, &results)
+				require.Len(t, results, 1)
+				require.Equal(t, "example-cuestomization", results[0].ResourceRef.Name)
+				require.Equal(t, "inputCUE", results[0].Field.Path)
+				require.Equal(t, "krm-func.yaml", results[0].File.Path)
+				require.Contains(t, results[0].Tags[cuestomize.PositionsResultTag], "krm-func.yaml#inputCUE:2:")
+			},
+		},
+		// secret-data-model tests
+		{
+			Name:                  "secret-data-model with secret-data-redacted should redact the data of Secrets in the stream and forEach item",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/secret-data-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/secret-data-redacted",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "SecretData"}, "secret-data", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Secret"}, "credentials", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "credentials-secret-data", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				require.Equal(t, map[string]string{"itemData": "false", "streamData": "false"},
+					findItem(t, items, "ConfigMap", "credentials-secret-data").GetDataMap())
+				require.Len(t, findItem(t, items, "Secret", "credentials").GetDataMap(), 1, "the Secret of the stream should be left untouched")
+			},
+		},
+		{
+			Name:                  "secret-data-model with secret-data-included should forward the data of Secrets when the annotation includes it",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/secret-data-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/secret-data-included",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "SecretData"}, "secret-data", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Secret"}, "credentials", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "ConfigMap"}, "credentials-secret-data", "example-namespace"),
+			},
+			Check: func(t *testing.T, items []*kyaml.RNode) {
+				require.Equal(t, map[string]string{"itemData": "true", "streamData": "true"},
+					findItem(t, items, "ConfigMap", "credentials-secret-data").GetDataMap())
+				require.Len(t, findItem(t, items, "Secret", "credentials").GetDataMap(), 1, "the Secret of the stream should be left untouched")
+			},
+		},
+		// slow-model tests
+		{
+			Name:                  "slow-model with timeout-exceeded should fail naming the interrupted phase",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/slow-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/timeout-exceeded",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				var phaseErr *cuestomize.PhaseError
+				require.ErrorAs(t, err, &phaseErr)
+				require.ErrorContains(t, err, "during the "+string(phaseErr.Phase)+" phase")
+				require.ErrorIs(t, err, context.DeadlineExceeded)
+				require.ErrorContains(t, err, "evaluation timeout of 50ms exceeded")
+			},
+		},
+		{
+			Name:                  "slow-model with timeout-invalid should fail",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/slow-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/timeout-invalid",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "invalid value 'soon'")
+			},
+		},
+		// warnings-model tests
+		{
+			Name:                  "warnings-model with warnings-ok should succeed with warnings",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/warnings-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/warnings-ok",
+			ShouldFail:            false,
+			Expected: []resid.ResId{
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "cuestomize.dev", Version: "v1alpha1", Kind: "DeploymentLinter"}, "deployment-linter", ""),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "example-deployment", "example-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "other-deployment", "other-namespace"),
+				resid.NewResIdWithNamespace(resid.Gvk{Group: "", Version: "v1", Kind: "Service"}, "example-service", "example-namespace"),
+			},
+			CheckResults: func(t *testing.T, results framework.Results) {
+				require.Len(t, results, 2)
+				for _, result := range results {
+					require.Equal(t, framework.Warning, result.Severity)
+				}
+				require.Equal(t, "replicas.example-namespace/example-deployment", results[0].Field.Path)
+				require.Equal(t, "other-deployment", results[1].ResourceRef.Name)
+			},
+		},
+		{
+			Name:                  "warnings-model with warnings-as-errors should fail",
+			TestdataCUEModelPath:  "../../../testdata/function/cue-modules/warnings-model",
+			TestdataKustomizePath: "../../../testdata/function/kustomize-inputs/warnings-as-errors",
+			ShouldFail:            true,
+			CheckError: func(t *testing.T, err error) {
+				var results framework.Results
+				require.ErrorAs(t, err, &results)
+				require.Len(t, results, 2)
+				require.Equal(t, framework.Error, results[0].Severity)
+			},
+		},
 		// fuzzy-model tests
 		{
 			Name:                  "configmap-model with deployment-ok should fail",
@@ -124,16 +1186,69 @@ func TestKRMFunction(t *testing.T) {
 			require.NoError(t, err, "KRMFuncBuilder failed to build KRM function")
 
 			result, err := krmFunc(items)
+			// results with no error severity are non-fatal, and are returned together with the items
+			var results framework.Results
+			if errors.As(err, &results) && results.ExitCode() == 0 {
+				if tt.CheckResults != nil {
+					tt.CheckResults(t, results)
+				}
+				err = nil
+			}
 			if tt.ShouldFail {
 				require.Error(t, err)
+				if tt.CheckError != nil {
+					tt.CheckError(t, err)
+				}
 			} else {
 				require.NoError(t, err)
 				for _, res := range result {
 					resID := resid.FromRNode(res)
 					require.Contains(t, tt.Expected, resID, "Expected resource ID not found in result")
 				}
+				if tt.Check != nil {
+					tt.Check(t, result)
+				}
 			}
 
 		})
 	}
 }
+
+// findItem returns the item with the given kind and name, failing the test if none is found.
+func findItem(t *testing.T, items []*kyaml.RNode, kind, name string) *kyaml.RNode {
+	t.Helper()
+
+	for _, item := range items {
+		if item.GetKind() == kind && item.GetName() == name {
+			return item
+		}
+	}
+	require.Failf(t, "item not found", "no item of kind %s named %s", kind, name)
+	return nil
+}
+
+// teamIndexCheck returns a check asserting that the team index generated by the includes-layout-model
+// lists the team of every included resource, read with the given includes layout.
+func teamIndexCheck(layout string) func(t *testing.T, items []*kyaml.RNode) {
+	return func(t *testing.T, items []*kyaml.RNode) {
+		index := findItem(t, items, "ConfigMap", "team-index")
+		require.Equal(t, map[string]string{
+			"layout":                        layout,
+			"Namespace.example-namespace":   "platform",
+			"Deployment.example-deployment": "payments",
+			"ConfigMap.example-config":      "payments",
+		}, index.GetDataMap())
+	}
+}
+
+// outputNames returns the kind and name of the items, in order, skipping the ones of the given kinds.
+func outputNames(items []*kyaml.RNode, skipKinds ...string) []string {
+	var names []string
+	for _, item := range items {
+		if slices.Contains(skipKinds, item.GetKind()) {
+			continue
+		}
+		names = append(names, item.GetKind()+"/"+item.GetName())
+	}
+	return names
+}
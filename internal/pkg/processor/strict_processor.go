@@ -2,6 +2,8 @@
 package processor
 
 import (
+	goerrors "errors"
+
 	validationErrors "k8s.io/kube-openapi/pkg/validation/errors"
 	"k8s.io/kube-openapi/pkg/validation/strfmt"
 	"k8s.io/kube-openapi/pkg/validation/validate"
@@ -35,11 +37,29 @@ func NewSimpleProcessor(config interface{}, filter kio.Filter, strict bool) Simp
 // Process makes SimpleProcessor implement the ResourceListProcessor interface.
 // It loads the ResourceList.functionConfig into the provided Config type, applying
 // defaulting and validation if supported by Config. It then executes the processor's filter.
+//
+// Results carried by the filter error are stored in the ResourceList. Unlike kustomize's SimpleProcessor,
+// results with error severity still make the processing fail, and the items are left untouched.
 func (p SimpleProcessor) Process(rl *fw.ResourceList) error {
 	if err := LoadFunctionConfig(rl.FunctionConfig, p.Config, p.Strict); err != nil {
 		return errors.WrapPrefixf(err, "loading function config")
 	}
-	return errors.WrapPrefixf(rl.Filter(p.Filter), "processing filter")
+
+	items, err := p.Filter.Filter(rl.Items)
+	var results fw.Results
+	if goerrors.As(err, &results) {
+		rl.Results = append(rl.Results, results...)
+		if results.ExitCode() == 0 {
+			// the results carry no error, so the filter succeeded
+			err = nil
+		}
+	}
+	if err != nil {
+		return errors.WrapPrefixf(err, "processing filter")
+	}
+
+	rl.Items = items
+	return nil
 }
 
 // LoadFunctionConfig reads a configuration resource from YAML into the provided data structure
@@ -0,0 +1,105 @@
+package cuestomize
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Workday/cuestomize/api"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+	"sigs.k8s.io/kustomize/kyaml/yaml/merge2"
+)
+
+const (
+	// ConflictPolicyAnnotationKey is the annotation key that configures how outputs colliding with existing
+	// resources are handled. It can be set on the function configuration, and overridden on single outputs.
+	ConflictPolicyAnnotationKey = "config.cuestomize.io/conflict-policy"
+)
+
+// ConflictPolicy defines how an output colliding with an existing resource (same GVK, namespace and name) is handled.
+type ConflictPolicy string
+
+const (
+	// ConflictPolicyError makes the function fail when an output collides with an existing resource.
+	ConflictPolicyError ConflictPolicy = "error"
+	// ConflictPolicyReplace replaces the existing resource with the output.
+	ConflictPolicyReplace ConflictPolicy = "replace"
+	// ConflictPolicyDeepMerge merges the output into the existing resource, with the output values taking precedence.
+	ConflictPolicyDeepMerge ConflictPolicy = "deep-merge"
+	// ConflictPolicyKeep keeps the existing resource and discards the output.
+	ConflictPolicyKeep ConflictPolicy = "keep"
+
+	// DefaultConflictPolicy is the conflict policy used when none is configured.
+	DefaultConflictPolicy = ConflictPolicyError
+)
+
+// ParseConflictPolicy parses the given string into a ConflictPolicy.
+// An empty string results in the DefaultConflictPolicy.
+func ParseConflictPolicy(policy string) (ConflictPolicy, error) {
+	switch p := ConflictPolicy(policy); p {
+	case "":
+		return DefaultConflictPolicy, nil
+	case ConflictPolicyError, ConflictPolicyReplace, ConflictPolicyDeepMerge, ConflictPolicyKeep:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unknown conflict policy '%s', must be one of: %s, %s, %s, %s",
+			policy, ConflictPolicyError, ConflictPolicyReplace, ConflictPolicyDeepMerge, ConflictPolicyKeep)
+	}
+}
+
+// GetConflictPolicy returns the conflict policy configured in the KRMInput annotations.
+func GetConflictPolicy(config *api.KRMInput) (ConflictPolicy, error) {
+	return ParseConflictPolicy(config.Annotations[ConflictPolicyAnnotationKey])
+}
+
+// outputConflictPolicy returns the conflict policy configured on the output, falling back to the provided default.
+// The conflict policy annotation is removed from the output, as it is only meant for Cuestomize.
+func outputConflictPolicy(output *kyaml.RNode, defaultPolicy ConflictPolicy) (ConflictPolicy, error) {
+	value, ok := output.GetAnnotations()[ConflictPolicyAnnotationKey]
+	if !ok {
+		return defaultPolicy, nil
+	}
+
+	if err := output.PipeE(kyaml.ClearAnnotation(ConflictPolicyAnnotationKey)); err != nil {
+		return "", fmt.Errorf("failed to clear conflict policy annotation: %w", err)
+	}
+	if err := kyaml.ClearEmptyAnnotations(output); err != nil {
+		return "", fmt.Errorf("failed to clear empty annotations: %w", err)
+	}
+	return ParseConflictPolicy(value)
+}
+
+// addOutput adds the output to the items, resolving collisions with existing resources according to the given policy.
+func addOutput(ctx context.Context, items []*kyaml.RNode, output *kyaml.RNode, policy ConflictPolicy) ([]*kyaml.RNode, error) {
+	id := resid.FromRNode(output)
+	log := logr.FromContextOrDiscard(ctx).WithValues("resource", id.String())
+
+	idx := slices.IndexFunc(items, func(item *kyaml.RNode) bool {
+		return resid.FromRNode(item).Equals(id)
+	})
+	if idx < 0 {
+		log.V(4).Info("adding item to output resources")
+		return append(items, output), nil
+	}
+
+	switch policy {
+	case ConflictPolicyReplace:
+		log.Info("output collides with an existing resource, replacing it", "policy", policy)
+		items[idx] = output
+	case ConflictPolicyDeepMerge:
+		log.Info("output collides with an existing resource, merging it into the existing one", "policy", policy)
+		merged, err := merge2.Merge(output, items[idx], kyaml.MergeOptions{ListIncreaseDirection: kyaml.MergeOptionsListAppend})
+		if err != nil {
+			return nil, fmt.Errorf("failed to merge output into existing resource [%s]: %w", id.String(), err)
+		}
+		items[idx] = merged
+	case ConflictPolicyKeep:
+		log.Info("output collides with an existing resource, keeping the existing one", "policy", policy)
+	default:
+		return nil, fmt.Errorf("output [%s] collides with an existing resource, set the '%s' annotation to resolve the conflict",
+			id.String(), ConflictPolicyAnnotationKey)
+	}
+	return items, nil
+}
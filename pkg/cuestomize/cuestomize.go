@@ -5,17 +5,19 @@ import (
 	"fmt"
 
 	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/build"
 	"cuelang.org/go/cue/cuecontext"
+	cueerrors "cuelang.org/go/cue/errors"
 	"github.com/Workday/cuestomize/api"
 	"github.com/Workday/cuestomize/pkg/cuerrors"
 	"github.com/go-logr/logr"
+	"sigs.k8s.io/kustomize/kyaml/fn/framework"
 	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
 )
 
 // Cuestomize generates (or validates) resources from the provided CUE configuration and input resources.
 func Cuestomize(ctx context.Context, items []*kyaml.RNode, config *api.KRMInput, opts ...Option) ([]*kyaml.RNode, error) {
 	log := logr.FromContextOrDiscard(ctx)
-	detailer := cuerrors.FromContextOrEmpty(ctx)
 
 	var cuestomizeOpts options
 	for _, opt := range opts {
@@ -33,51 +35,231 @@ func Cuestomize(ctx context.Context, items []*kyaml.RNode, config *api.KRMInput,
 
 	resourcesPath := cuestomizeOpts.ModelProvider.Path()
 
-	cueCtx := cuecontext.New()
-
-	includes, err := api.ExtractIncludes(ctx, config, items)
+	timeout, err := GetEvaluationTimeout(config)
 	if err != nil {
-		return nil, fmt.Errorf("failed to compute includes from KRM function inputs: %w", err)
+		return nil, err
 	}
-	includesValue, err := includes.IntoCueValue(cueCtx)
+	ctx, cancel := withEvaluationTimeout(ctx, timeout)
+	defer cancel()
+
+	paths, err := GetPaths(config, cuestomizeOpts.Paths)
 	if err != nil {
-		return nil, detailer.ErrorWithDetails(err, "failed to convert includes into CUE value")
+		return nil, fmt.Errorf("invalid CUE paths: %w", err)
 	}
 
-	configValue, err := config.IntoCueValue(cueCtx)
+	includes, named, err := api.ExtractAllIncludes(ctx, config, items)
 	if err != nil {
-		return nil, detailer.ErrorWithDetails(err, "failed to convert config into CUE value")
+		return nil, fmt.Errorf("failed to compute includes from KRM function inputs: %w", err)
 	}
 
-	instances, err := LoadCUEModel(ctx, resourcesPath)
+	stream, err := ExtractStream(ctx, config, items)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load CUE model from '%s': %w", resourcesPath, err)
+		return nil, fmt.Errorf("failed to compute stream from KRM function inputs: %w", err)
 	}
 
-	schema, err := BuildCUEModelSchema(ctx, cueCtx, instances)
+	config, err = api.ResolveInput(ctx, config, items)
 	if err != nil {
-		return nil, fmt.Errorf("failed to build CUE model schema: %w", err)
+		return nil, fmt.Errorf("failed to resolve input values from KRM function inputs: %w", err)
 	}
 
-	unified, err := FillMetadata(ctx, *schema, config)
+	provenance, err := NewProvenance(config, cuestomizeOpts.ModelProvider)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fill metadata in CUE schema: %w", err)
+		return nil, err
 	}
-	unified = unified.FillPath(cue.ParsePath(InputFillPath), configValue)
-	unified = unified.FillPath(cue.ParsePath(IncludesFillPath), includesValue)
-	if unified.Err() != nil {
-		return nil, detailer.ErrorWithDetails(unified.Err(), "failed to unify CUE model with inputs from KRM function")
+
+	if config.ForEach != nil {
+		return cuestomizeForEach(ctx, items, config, paths, includes, named, stream, resourcesPath, provenance, cuestomizeOpts.Results)
 	}
 
-	// assert that the unified instance values are all concrete (no string, regexes, etc.)
-	// without this check, non-valorised fields can remain in output resources
-	if err := unified.Validate(cue.Final(), cue.Concrete(true)); err != nil {
-		return nil, detailer.ErrorWithDetails(err, "failed to validate unified CUE instance")
+	eval, err := evaluate(ctx, cuecontext.New(), resourcesPath, config, paths, includes, named, stream, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := reportWarnings(ctx, eval.warnings, config, cuestomizeOpts.Results); err != nil {
+		return nil, err
 	}
 
 	if ShouldActAsValidator(config) {
 		log.V(4).Info("cuestomize is acting in validator mode.")
 		return items, nil // if the function is a validator, return the original items without processing
 	}
-	return ProcessOutputs(ctx, unified, items)
+	provenance, err = provenance.withInputHash(config, includes, stream, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute provenance: %w", err)
+	}
+	processed, warnings, err := processEvaluation(ctx, eval.unified, items, config, paths, provenance)
+	if err != nil {
+		return nil, err
+	}
+	if err := reportWarnings(ctx, warnings, config, cuestomizeOpts.Results); err != nil {
+		return nil, err
+	}
+	if err := checkPhase(ctx, PhaseOutputProcessing); err != nil {
+		return nil, err
+	}
+	return ProcessHashSuffixes(ctx, processed)
+}
+
+// evaluation is the result of the evaluation of the CUE model.
+type evaluation struct {
+	// unified is the CUE model unified with the function inputs.
+	unified cue.Value
+	// warnings holds the non-fatal issues reported by the CUE model.
+	warnings framework.Results
+}
+
+// evaluate loads the CUE model from resourcesPath and unifies it with the KRMInput configuration and the includes,
+// in the layout configured in the KRMInput annotations, together with the named includes.
+// If stream or item are not nil, they are injected in the model as well.
+// The unified value is validated to be concrete: all of its errors are collected, and the ones of fields marked
+// with the warning severity attribute are returned as warnings, together with the ones found in the warnings path.
+func evaluate(ctx context.Context, cueCtx *cue.Context, resourcesPath string, config *api.KRMInput, paths Paths, includes api.Includes, named api.NamedIncludes, stream any, item *kyaml.RNode) (*evaluation, error) {
+	detailer := cuerrors.FromContextOrEmpty(ctx)
+
+	maxErrors, err := GetMaxValidationErrors(config)
+	if err != nil {
+		return nil, err
+	}
+
+	layout, err := GetIncludesLayout(config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid '%s' annotation: %w", IncludesLayoutAnnotationKey, err)
+	}
+
+	shaped, err := layout.shape(includes, named)
+	if err != nil {
+		return nil, err
+	}
+	includesValue, err := api.IntoCueValue(cueCtx, shaped)
+	if err != nil {
+		return nil, detailer.ErrorWithDetails(err, "failed to convert includes into CUE value")
+	}
+
+	configValue, err := CompileInput(ctx, cueCtx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	var instances []*build.Instance
+	if err := runPhase(ctx, PhaseLoading, func() (err error) {
+		instances, err = LoadCUEModelWithConfig(ctx, resourcesPath, config)
+		if err != nil {
+			return fmt.Errorf("failed to load CUE model from '%s': %w", resourcesPath, err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var schema *cue.Value
+	if err := runPhase(ctx, PhaseBuilding, func() (err error) {
+		schema, err = BuildCUEModelSchema(ctx, cueCtx, instances)
+		if err != nil {
+			return fmt.Errorf("failed to build CUE model schema: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var model, inputs, unified cue.Value
+	// errors of fields marked with the warning severity attribute can surface both when unifying
+	// and when validating the unified instance, so they are collected from both before being converted
+	var warningErrs cueerrors.Error
+	if err := runPhase(ctx, PhaseUnification, func() (err error) {
+		model, err = FillMetadataWithPaths(ctx, *schema, config, paths)
+		if err != nil {
+			return fmt.Errorf("failed to fill metadata in CUE schema: %w", err)
+		}
+		// inputs holds the inputs alone, without the constraints of the model, so that it can be walked
+		// to collect the errors of the unified instance even when the latter cannot be iterated
+		inputs = cueCtx.CompileString("{}")
+		inputs = inputs.FillPath(cue.ParsePath(paths.Input), configValue)
+		inputs = inputs.FillPath(cue.ParsePath(paths.Includes), includesValue)
+		if fillsIncludesLayout(config, *schema, paths) {
+			inputs = inputs.FillPath(cue.ParsePath(paths.IncludesLayout), string(layout))
+		}
+		if stream != nil {
+			streamValue, err := api.IntoCueValue(cueCtx, stream)
+			if err != nil {
+				return detailer.ErrorWithDetails(err, "failed to convert stream into CUE value")
+			}
+			inputs = inputs.FillPath(cue.ParsePath(paths.Stream), streamValue)
+		}
+		if item != nil {
+			itemValue, err := api.IntoCueValue(cueCtx, item)
+			if err != nil {
+				return detailer.ErrorWithDetails(err, "failed to convert item into CUE value")
+			}
+			inputs = inputs.FillPath(cue.ParsePath(paths.Item), itemValue)
+		}
+		unified = model.Unify(inputs)
+		if err := unified.Err(); err != nil {
+			warnings, errs := splitWarnings(unified, collectErrors(unified, err, model, inputs))
+			if errs != nil {
+				return validationFailure(ctx, errs, includes, named, layout, paths, maxErrors, "failed to unify CUE model with inputs from KRM function")
+			}
+			warningErrs = warnings
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var warnings framework.Results
+	if err := runPhase(ctx, PhaseValidation, func() error {
+		// assert that the unified instance values are all concrete (no string, regexes, etc.)
+		// without this check, non-valorised fields can remain in output resources
+		if err := unified.Validate(cue.Final(), cue.Concrete(true)); err != nil {
+			newWarningErrs, errs := splitWarnings(unified, collectErrors(unified, err, model, inputs))
+			if errs != nil {
+				return validationFailure(ctx, errs, includes, named, layout, paths, maxErrors, "failed to validate unified CUE instance")
+			}
+			warningErrs = appendNewErrors(warningErrs, newWarningErrs)
+		}
+		warnings = ValidationResults(warningErrs, includes, named, layout, paths)
+		for _, warning := range warnings {
+			warning.Severity = framework.Warning
+		}
+
+		modelWarnings, err := ProcessWarnings(ctx, unified, paths)
+		if err != nil {
+			return fmt.Errorf("failed to process warnings: %w", err)
+		}
+		warnings = append(warnings, modelWarnings...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &evaluation{unified: unified, warnings: warnings}, nil
+}
+
+// processEvaluation applies the deletions, patches and outputs of the unified CUE instance to the items,
+// and returns the warnings about the deletion and patch targets matching no item.
+// Outputs are stamped with the provenance annotations, if provenance is not nil.
+// It returns a PhaseError, before any step modifying the items, if ctx is done.
+func processEvaluation(ctx context.Context, unified cue.Value, items []*kyaml.RNode, config *api.KRMInput, paths Paths, provenance *Provenance) ([]*kyaml.RNode, framework.Results, error) {
+	if err := checkPhase(ctx, PhaseOutputProcessing); err != nil {
+		return nil, nil, err
+	}
+	items, deletionWarnings, err := ProcessDeletions(ctx, unified, items, config, paths)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to process deletions: %w", err)
+	}
+	if err := checkPhase(ctx, PhaseOutputProcessing); err != nil {
+		return nil, nil, err
+	}
+	items, patchWarnings, err := ProcessPatches(ctx, unified, items, config, paths)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to process patches: %w", err)
+	}
+	if err := checkPhase(ctx, PhaseOutputProcessing); err != nil {
+		return nil, nil, err
+	}
+	items, err = ProcessOutputsWithConfig(ctx, unified, items, config, paths, provenance)
+	if err != nil {
+		return nil, nil, err
+	}
+	return items, append(deletionWarnings, patchWarnings...), nil
 }
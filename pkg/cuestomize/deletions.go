@@ -0,0 +1,88 @@
+package cuestomize
+
+import (
+	"context"
+	"fmt"
+
+	"cuelang.org/go/cue"
+	"github.com/Workday/cuestomize/api"
+	"github.com/Workday/cuestomize/pkg/cuerrors"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/kustomize/kyaml/fn/framework"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+const (
+	// StrictDeletionsAnnotationKey is the annotation key that makes deletions matching no resource an error.
+	StrictDeletionsAnnotationKey = "config.cuestomize.io/strict-deletions"
+	// StrictDeletionsAnnotationValue is the value of the annotation that makes deletions matching no resource an error.
+	StrictDeletionsAnnotationValue = "true"
+)
+
+// ShouldFailOnUnmatchedDeletions checks if the KRMInput configuration has the strict deletions annotation set.
+func ShouldFailOnUnmatchedDeletions(config *api.KRMInput) bool {
+	return config.Annotations != nil &&
+		config.Annotations[StrictDeletionsAnnotationKey] == StrictDeletionsAnnotationValue
+}
+
+// ProcessDeletions removes the items matching the deletion targets found in the unified CUE instance.
+// Deletions are optional: if the deletions path does not exist in the unified instance, items are returned unchanged.
+// Deletion targets matching no item are returned as warnings, or make the function fail if the KRMInput configuration
+// has the strict deletions annotation set.
+func ProcessDeletions(ctx context.Context, unified cue.Value, items []*kyaml.RNode, config *api.KRMInput, paths Paths) ([]*kyaml.RNode, framework.Results, error) {
+	log := logr.FromContextOrDiscard(ctx)
+
+	detailer := cuerrors.FromContextOrEmpty(ctx)
+
+	deletionsValue := unified.LookupPath(cue.ParsePath(paths.Deletions))
+	if !deletionsValue.Exists() {
+		return items, nil, nil
+	} else if deletionsValue.Err() != nil {
+		return nil, nil, detailer.ErrorWithDetails(deletionsValue.Err(), "failed to lookup '%s' in unified CUE instance", paths.Deletions)
+	}
+	deletionsIter, err := getIter(deletionsValue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get iterator over '%s' in unified CUE instance: %v", paths.Deletions, err)
+	}
+
+	strict := ShouldFailOnUnmatchedDeletions(config)
+	index := api.NewItemIndex(items)
+	deleted := map[*kyaml.RNode]struct{}{}
+	var warnings framework.Results
+	for deletionsIter.Next() {
+		target, err := decodeTarget(deletionsIter.Value())
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode deletion '%s': %w", deletionsIter.Selector(), err)
+		}
+
+		matched, err := target.Match(index)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to match items against target [%s]: %w", target.String(), err)
+		}
+		deletedCount := 0
+		for _, item := range matched {
+			if _, ok := deleted[item]; ok {
+				continue
+			}
+			log.V(4).Info("deleting input resource", "resource", resid.FromRNode(item).String())
+			deleted[item] = struct{}{}
+			deletedCount++
+		}
+
+		if deletedCount == 0 {
+			if strict {
+				return nil, nil, fmt.Errorf("no items matched for deletion target [%s]", target.String())
+			}
+			warnings = append(warnings, unmatchedTargetWarning("deletion", target))
+		}
+	}
+
+	remaining := make([]*kyaml.RNode, 0, len(items)-len(deleted))
+	for _, item := range items {
+		if _, ok := deleted[item]; !ok {
+			remaining = append(remaining, item)
+		}
+	}
+	return remaining, warnings, nil
+}
@@ -10,36 +10,55 @@ import (
 )
 
 const (
-	// InputFillPath is the CUE path in which the input resources will be injected into the CUE model.
+	// InputFillPath is the default CUE path in which the input resources will be injected into the CUE model.
 	InputFillPath = "input"
-	// IncludesFillPath is the CUE path in which the includes will be injected into the CUE model.
+	// IncludesFillPath is the default CUE path in which the includes will be injected into the CUE model.
 	IncludesFillPath = "includes"
+	// IncludesLayoutFillPath is the default CUE path in which the layout of the includes will be filled into the CUE model.
+	IncludesLayoutFillPath = "includesLayout"
+	// ItemFillPath is the default CUE path in which the current item will be injected into the CUE model, when evaluating it for each item.
+	ItemFillPath = "item"
+	// StreamFillPath is the default CUE path in which the items of the input stream will be injected into the CUE model, when enabled.
+	StreamFillPath = "stream"
 
-	// OutputsPath is the CUE path in which the function expects the output resources (as a list) to be placed.
+	// OutputsPath is the default CUE path in which the function expects the output resources to be placed.
 	OutputsPath = "outputs"
+	// PatchesPath is the default CUE path in which the function expects the patches to apply to the input resources to be placed.
+	PatchesPath = "patches"
+	// DeletionsPath is the default CUE path in which the function expects the targets of the input resources to delete to be placed.
+	DeletionsPath = "deletions"
+	// WarningsPath is the default CUE path in which the function expects the warnings reported by the model to be placed.
+	WarningsPath = "warnings"
 )
 
 const (
-	// APIVersionFillPath is the CUE path in which the API version of the KRMInput will be filled.
+	// APIVersionFillPath is the default CUE path in which the API version of the KRMInput will be filled.
 	APIVersionFillPath = "apiVersion"
-	// KindFillPath is the CUE path in which the kind of the KRMInput will be filled.
+	// KindFillPath is the default CUE path in which the kind of the KRMInput will be filled.
 	KindFillPath = "kind"
-	// MetadataFillPath is the CUE path in which the metadata of the KRMInput will be filled.
+	// MetadataFillPath is the default CUE path in which the metadata of the KRMInput will be filled.
 	MetadataFillPath = "metadata"
 )
 
-// FillMetadata fills the CUE schema with the API version, kind, and metadata from the KRMInput configuration.
+// FillMetadata fills the CUE schema with the API version, kind, and metadata from the KRMInput configuration,
+// in their default paths.
 func FillMetadata(ctx context.Context, schema cue.Value, config *api.KRMInput) (cue.Value, error) {
+	return FillMetadataWithPaths(ctx, schema, config, DefaultPaths())
+}
+
+// FillMetadataWithPaths fills the CUE schema with the API version, kind, and metadata from the KRMInput configuration,
+// in the given paths.
+func FillMetadataWithPaths(ctx context.Context, schema cue.Value, config *api.KRMInput, paths Paths) (cue.Value, error) {
 	detailer := cuerrors.FromContextOrEmpty(ctx)
 
-	filledSchema := schema.FillPath(cue.ParsePath(APIVersionFillPath), config.APIVersion)
-	filledSchema = filledSchema.FillPath(cue.ParsePath(KindFillPath), config.Kind)
+	filledSchema := schema.FillPath(cue.ParsePath(paths.APIVersion), config.APIVersion)
+	filledSchema = filledSchema.FillPath(cue.ParsePath(paths.Kind), config.Kind)
 
 	meta, err := api.IntoCueValue(cuecontext.New(), config.ObjectMeta)
 	if err != nil {
 		return cue.Value{}, detailer.ErrorWithDetails(err, "failed to convert ObjectMeta into CUE value")
 	}
 
-	filledSchema = filledSchema.FillPath(cue.ParsePath(MetadataFillPath), meta)
+	filledSchema = filledSchema.FillPath(cue.ParsePath(paths.Metadata), meta)
 	return filledSchema, nil
 }
@@ -0,0 +1,120 @@
+package cuestomize
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"cuelang.org/go/cue/cuecontext"
+	"github.com/Workday/cuestomize/api"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/kustomize/kyaml/fn/framework"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+// cuestomizeForEach evaluates the CUE model once for each item matching the forEach selector of the KRMInput,
+// injecting the item in the model, with the data of Secrets redacted unless the KRMInput annotations include it.
+// Evaluations run in parallel, and their results are then applied to the items
+// in the order the matching items appear in the input stream.
+func cuestomizeForEach(ctx context.Context, items []*kyaml.RNode, config *api.KRMInput, paths Paths, includes api.Includes, named api.NamedIncludes, stream any, resourcesPath string, provenance *Provenance, results *framework.Results) ([]*kyaml.RNode, error) {
+	log := logr.FromContextOrDiscard(ctx)
+
+	selector, err := api.CompileSelector(config.ForEach)
+	if err != nil {
+		return nil, fmt.Errorf("invalid forEach selector [%v]: %w", config.ForEach.String(), err)
+	}
+	matching := api.NewItemIndex(items).Match(selector)
+	if len(matching) == 0 {
+		log.V(-1).Info("no items matched for forEach selector", "selector", config.ForEach.String())
+		return items, nil
+	}
+	log.V(4).Info("evaluating CUE model for each matching item", "count", len(matching))
+	injected, err := redactSecretData(config, matching)
+	if err != nil {
+		return nil, err
+	}
+
+	// CUE contexts are not safe for concurrent use, so each evaluation uses its own
+	evaluations := make([]*evaluation, len(matching))
+	errs := make([]error, len(matching))
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	var wg sync.WaitGroup
+	for i, item := range matching {
+		wg.Go(func() {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			evaluations[i], errs[i] = evaluate(ctx, cuecontext.New(), resourcesPath, config, paths, includes, named, stream, injected[i])
+			if errs[i] != nil {
+				errs[i] = fmt.Errorf("item [%s]: %w", resid.FromRNode(item).String(), errs[i])
+			}
+		})
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		err = fmt.Errorf("failed to evaluate CUE model for each item: %w", err)
+
+		// collect the errors and results of all the failed evaluations, so that none is lost when retrieving them with errors.As
+		merged := NewValidationError(err, nil)
+		for _, itemErr := range errs {
+			var validationErr *ValidationError
+			if errors.As(itemErr, &validationErr) {
+				merged.Errors = append(merged.Errors, validationErr.Errors...)
+				merged.Results = append(merged.Results, validationErr.Results...)
+			}
+		}
+		if len(merged.Results) > 0 {
+			return nil, merged
+		}
+		return nil, err
+	}
+
+	var warnings framework.Results
+	for i, eval := range evaluations {
+		for _, warning := range eval.warnings {
+			if warning.ResourceRef == nil {
+				// attribute the warnings not related to an included resource to the item the model was evaluated for
+				warning.ResourceRef = resourceIdentifier(matching[i])
+			}
+		}
+		warnings = append(warnings, eval.warnings...)
+	}
+	if err := reportWarnings(ctx, warnings, config, results); err != nil {
+		return nil, err
+	}
+
+	if ShouldActAsValidator(config) {
+		log.V(4).Info("cuestomize is acting in validator mode.")
+		return items, nil // if the function is a validator, return the original items without processing
+	}
+
+	processed := items
+	var processingWarnings framework.Results
+	for i, eval := range evaluations {
+		itemProvenance, err := provenance.withInputHash(config, includes, stream, injected[i])
+		if err != nil {
+			return nil, fmt.Errorf("item [%s]: failed to compute provenance: %w", resid.FromRNode(matching[i]).String(), err)
+		}
+		var itemWarnings framework.Results
+		processed, itemWarnings, err = processEvaluation(ctx, eval.unified, processed, config, paths, itemProvenance)
+		if err != nil {
+			return nil, fmt.Errorf("item [%s]: %w", resid.FromRNode(matching[i]).String(), err)
+		}
+		for _, warning := range itemWarnings {
+			warning.ResourceRef = resourceIdentifier(matching[i])
+		}
+		processingWarnings = append(processingWarnings, itemWarnings...)
+	}
+	if err := reportWarnings(ctx, processingWarnings, config, results); err != nil {
+		return nil, err
+	}
+	if err := checkPhase(ctx, PhaseOutputProcessing); err != nil {
+		return nil, err
+	}
+	// hash suffixes are appended once all the outputs are known, so that references across evaluations are rewritten too
+	return ProcessHashSuffixes(ctx, processed)
+}
@@ -0,0 +1,201 @@
+package cuestomize
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/Workday/cuestomize/api"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/kustomize/api/filters/nameref"
+	"sigs.k8s.io/kustomize/api/hasher"
+	"sigs.k8s.io/kustomize/api/konfig"
+	"sigs.k8s.io/kustomize/api/resmap"
+	"sigs.k8s.io/kustomize/api/resource"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// HashSuffixAnnotationKey is the annotation key that appends a content-hash suffix to the name of outputs,
+	// like kustomize does for generated ConfigMaps and Secrets.
+	// Set on the function config, it applies to all the ConfigMap and Secret outputs; set on a single output,
+	// it applies to that output only, and takes precedence over the one of the function config.
+	HashSuffixAnnotationKey = "config.cuestomize.io/hash-suffix"
+	// HashSuffixAnnotationValue is the value of the annotation that appends a content-hash suffix to the name of outputs.
+	HashSuffixAnnotationValue = "true"
+
+	// hashSuffixMarkerAnnotationKey is the annotation marking the outputs Cuestomize appends a hash suffix to.
+	// It is private to Cuestomize, so that the items marked by kustomize (e.g. by an upstream configMapGenerator)
+	// are left to kustomize's own hash transformer.
+	hashSuffixMarkerAnnotationKey = "internal.config.cuestomize.io/needs-hash-suffix"
+	// hashSuffixMarkerAnnotationValue is the value of the annotation marking the outputs needing a hash suffix.
+	hashSuffixMarkerAnnotationValue = "enabled"
+)
+
+// hashSuffixBuildAnnotations are the build annotations kustomize uses to track the name of a resource before
+// the suffix is appended, and the resources referring to it.
+var hashSuffixBuildAnnotations = []string{
+	konfig.ConfigAnnoDomain + "/previousNames",
+	konfig.ConfigAnnoDomain + "/previousKinds",
+	konfig.ConfigAnnoDomain + "/previousNamespaces",
+	konfig.ConfigAnnoDomain + "/refBy",
+}
+
+// ShouldAddHashSuffixes checks if the KRMInput configuration has the hash suffix annotation set.
+func ShouldAddHashSuffixes(config *api.KRMInput) bool {
+	return config.Annotations != nil &&
+		config.Annotations[HashSuffixAnnotationKey] == HashSuffixAnnotationValue
+}
+
+// nameBackReferences holds the fields of the resources (the referrers) referring to resources of a given kind by name.
+type nameBackReferences struct {
+	resid.Gvk `json:",inline,omitempty"`
+	Referrers types.FsSlice `json:"fieldSpecs,omitempty"`
+}
+
+// loadNameReferences parses the name reference field specs once.
+var loadNameReferences = sync.OnceValues(func() ([]nameBackReferences, error) {
+	var config struct {
+		NameReference []nameBackReferences `json:"nameReference"`
+	}
+	if err := yaml.Unmarshal([]byte(nameReferenceFieldSpecs), &config); err != nil {
+		return nil, fmt.Errorf("failed to parse name reference field specs: %w", err)
+	}
+	return config.NameReference, nil
+})
+
+// markHashSuffix marks the output as needing a hash suffix, with an annotation private to Cuestomize, if the output
+// sets the hash suffix annotation, or if the KRMInput configuration does and the output is a ConfigMap or a Secret.
+// The hash suffix annotation is removed from the output, as it is only meant for Cuestomize.
+func markHashSuffix(output *kyaml.RNode, config *api.KRMInput) error {
+	enabled := ShouldAddHashSuffixes(config) && supportsHashSuffix(output)
+	if value, ok := output.GetAnnotations()[HashSuffixAnnotationKey]; ok {
+		enabled = value == HashSuffixAnnotationValue
+		if err := output.PipeE(kyaml.ClearAnnotation(HashSuffixAnnotationKey)); err != nil {
+			return fmt.Errorf("failed to clear hash suffix annotation: %w", err)
+		}
+		if err := kyaml.ClearEmptyAnnotations(output); err != nil {
+			return fmt.Errorf("failed to clear empty annotations: %w", err)
+		}
+	}
+	if !enabled {
+		return nil
+	}
+	if !supportsHashSuffix(output) {
+		return fmt.Errorf("hash suffixes are only supported for ConfigMaps and Secrets, got [%s]", resid.FromRNode(output).String())
+	}
+	return output.PipeE(kyaml.SetAnnotation(hashSuffixMarkerAnnotationKey, hashSuffixMarkerAnnotationValue))
+}
+
+// needsHashSuffix checks if the item is an output marked as needing a hash suffix by markHashSuffix.
+func needsHashSuffix(item *kyaml.RNode) bool {
+	return item.GetAnnotations()[hashSuffixMarkerAnnotationKey] == hashSuffixMarkerAnnotationValue
+}
+
+// supportsHashSuffix checks if the item is a ConfigMap or a Secret, the only kinds kustomize appends hash suffixes to.
+func supportsHashSuffix(item *kyaml.RNode) bool {
+	return item.GetApiVersion() == "v1" && (item.GetKind() == "ConfigMap" || item.GetKind() == "Secret")
+}
+
+// ProcessHashSuffixes appends a content-hash suffix to the name of the outputs marked as needing one,
+// and rewrites the references to them in all the items, with the same hashing and name reference rules as kustomize.
+// Only the build annotations of the renamed outputs are removed: the other items, and the file and identity
+// annotations of kio, are left untouched. Items marked by kustomize itself, and not by Cuestomize, are not renamed.
+func ProcessHashSuffixes(ctx context.Context, items []*kyaml.RNode) ([]*kyaml.RNode, error) {
+	log := logr.FromContextOrDiscard(ctx)
+
+	if !slices.ContainsFunc(items, needsHashSuffix) {
+		return items, nil
+	}
+
+	// resources share the YAML nodes of the items, so changes to the former are reflected in the latter
+	resources := make([]*resource.Resource, 0, len(items))
+	renamed := resmap.New()
+	for _, item := range items {
+		res := &resource.Resource{RNode: *item}
+		resources = append(resources, res)
+		if !needsHashSuffix(item) {
+			continue
+		}
+		// the marker is not part of the content the hash is computed on
+		if err := item.PipeE(kyaml.ClearAnnotation(hashSuffixMarkerAnnotationKey)); err != nil {
+			return nil, fmt.Errorf("failed to clear hash suffix marker of [%s]: %w", res.CurId().String(), err)
+		}
+		if err := kyaml.ClearEmptyAnnotations(item); err != nil {
+			return nil, fmt.Errorf("failed to clear empty annotations of [%s]: %w", res.CurId().String(), err)
+		}
+		hash, err := (&hasher.Hasher{}).Hash(&res.RNode)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute hash of [%s]: %w", res.CurId().String(), err)
+		}
+		name := fmt.Sprintf("%s-%s", res.GetName(), hash)
+		log.V(4).Info("appending hash suffix to output name", "resource", res.CurId().String(), "name", name)
+
+		res.StorePreviousId()
+		if err := res.SetName(name); err != nil {
+			return nil, fmt.Errorf("failed to set name of [%s]: %w", res.CurId().String(), err)
+		}
+		if err := renamed.Append(res); err != nil {
+			return nil, fmt.Errorf("failed to collect hash-suffixed output [%s]: %w", res.CurId().String(), err)
+		}
+	}
+	if renamed.Size() == 0 {
+		return items, nil
+	}
+
+	if err := fixNameReferences(resources, renamed); err != nil {
+		return nil, fmt.Errorf("failed to rewrite references to hash-suffixed names: %w", err)
+	}
+	for _, res := range renamed.Resources() {
+		if err := clearHashSuffixBuildAnnotations(&res.RNode); err != nil {
+			return nil, fmt.Errorf("failed to clear build annotations of [%s]: %w", res.CurId().String(), err)
+		}
+	}
+	return items, nil
+}
+
+// clearHashSuffixBuildAnnotations removes the build annotations set on an output renamed with a hash suffix.
+func clearHashSuffixBuildAnnotations(output *kyaml.RNode) error {
+	for _, key := range hashSuffixBuildAnnotations {
+		if err := output.PipeE(kyaml.ClearAnnotation(key)); err != nil {
+			return err
+		}
+	}
+	return kyaml.ClearEmptyAnnotations(output)
+}
+
+// fixNameReferences rewrites the references to the renamed resources held by the referrers,
+// as kustomize's name reference transformer does.
+func fixNameReferences(referrers []*resource.Resource, renamed resmap.ResMap) error {
+	backReferences, err := loadNameReferences()
+	if err != nil {
+		return err
+	}
+
+	for _, backReference := range backReferences {
+		for _, referrerSpec := range backReference.Referrers {
+			for _, res := range referrers {
+				if !res.OrgId().IsSelected(&referrerSpec.Gvk) {
+					continue
+				}
+				candidates, err := renamed.SubsetThatCouldBeReferencedByResource(res)
+				if err != nil {
+					return err
+				}
+				if err := res.ApplyFilter(nameref.Filter{
+					Referrer:           res,
+					NameFieldToUpdate:  referrerSpec,
+					ReferralTarget:     backReference.Gvk,
+					ReferralCandidates: candidates,
+				}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package cuestomize
+
+import (
+	"fmt"
+	"strconv"
+
+	"cuelang.org/go/cue"
+	"github.com/Workday/cuestomize/api"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+const (
+	// IncludesLayoutAnnotationKey is the annotation key that sets the shape in which the includes are injected into the CUE model.
+	IncludesLayoutAnnotationKey = "config.cuestomize.io/includes-layout"
+	// NamedIncludesKey is the key under which the named includes are injected, within the includes.
+	NamedIncludesKey = "named"
+)
+
+// IncludesLayout defines the shape in which the includes are injected into the CUE model.
+type IncludesLayout string
+
+const (
+	// IncludesLayoutNested indexes the includes by API version, kind, namespace and name, respectively.
+	IncludesLayoutNested IncludesLayout = "nested"
+	// IncludesLayoutList lists the includes, sorted by API version, kind, namespace and name.
+	IncludesLayoutList IncludesLayout = "list"
+	// IncludesLayoutFlat indexes the includes by "<kind>/<namespace>/<name>", or "<kind>/<name>" for cluster-scoped ones.
+	IncludesLayoutFlat IncludesLayout = "flat"
+	// IncludesLayoutByKind groups the includes by kind, in lists sorted like with IncludesLayoutList.
+	IncludesLayoutByKind IncludesLayout = "by-kind"
+
+	// DefaultIncludesLayout is the includes layout used when none is configured.
+	DefaultIncludesLayout = IncludesLayoutNested
+)
+
+// GetIncludesLayout returns the includes layout configured in the KRMInput annotations.
+func GetIncludesLayout(config *api.KRMInput) (IncludesLayout, error) {
+	switch layout := IncludesLayout(config.Annotations[IncludesLayoutAnnotationKey]); layout {
+	case "":
+		return DefaultIncludesLayout, nil
+	case IncludesLayoutNested, IncludesLayoutList, IncludesLayoutFlat, IncludesLayoutByKind:
+		return layout, nil
+	default:
+		return "", fmt.Errorf("unknown includes layout '%s', must be one of: %s, %s, %s, %s",
+			layout, IncludesLayoutNested, IncludesLayoutList, IncludesLayoutFlat, IncludesLayoutByKind)
+	}
+}
+
+// fillsIncludesLayout checks if the layout of the includes is filled into the CUE model: only if the KRMInput
+// configuration sets it, or if the model declares the field it is filled at, so that models with a closed
+// root keep working.
+func fillsIncludesLayout(config *api.KRMInput, schema cue.Value, paths Paths) bool {
+	if _, ok := config.Annotations[IncludesLayoutAnnotationKey]; ok {
+		return true
+	}
+	return schema.LookupPath(cue.ParsePath(paths.IncludesLayout)).Exists()
+}
+
+// shape returns the includes in the layout, with the named includes under the NamedIncludesKey key, if any.
+// Named includes cannot be combined with the list layout, which has no keys, and resources of different
+// API groups or versions with the same kind, namespace and name cannot be combined with the flat layout.
+func (l IncludesLayout) shape(includes api.Includes, named api.NamedIncludes) (any, error) {
+	var shaped map[string]any
+	switch l {
+	case IncludesLayoutList:
+		if len(named) > 0 {
+			return nil, fmt.Errorf("named includes cannot be used with the '%s' includes layout", IncludesLayoutList)
+		}
+		items := includes.Items()
+		if items == nil {
+			return []*kyaml.RNode{}, nil
+		}
+		return items, nil
+	case IncludesLayoutFlat:
+		shaped = make(map[string]any)
+		for _, item := range includes.Items() {
+			key := flatKey(item)
+			if other, ok := shaped[key].(*kyaml.RNode); ok {
+				return nil, fmt.Errorf("included resources [%s] and [%s] have the same key '%s' in the '%s' includes layout, use the '%s' layout to include both",
+					resourceIdentifierString(resourceIdentifier(other)), resourceIdentifierString(resourceIdentifier(item)), key, IncludesLayoutFlat, IncludesLayoutNested)
+			}
+			shaped[key] = item
+		}
+	case IncludesLayoutByKind:
+		byKind := make(map[string][]*kyaml.RNode)
+		for _, item := range includes.Items() {
+			byKind[item.GetKind()] = append(byKind[item.GetKind()], item)
+		}
+		shaped = make(map[string]any, len(byKind))
+		for kind, items := range byKind {
+			shaped[kind] = items
+		}
+	default:
+		if len(named) == 0 {
+			return includes, nil
+		}
+		shaped = make(map[string]any, len(includes))
+		for apiVersion, kinds := range includes {
+			shaped[apiVersion] = kinds
+		}
+	}
+	if len(named) > 0 {
+		shaped[NamedIncludesKey] = named
+	}
+	return shaped, nil
+}
+
+// resolve returns the identifier of the included resource the path (relative to the includes) refers to,
+// the resource itself if found, and the remaining path, relative to the resource.
+// It returns a nil identifier if the path does not refer to an included resource.
+func (l IncludesLayout) resolve(path []string, includes api.Includes, named api.NamedIncludes) (*kyaml.ResourceIdentifier, *kyaml.RNode, []string) {
+	if len(path) >= 2 && path[0] == NamedIncludesKey {
+		if items, ok := named[path[1]]; ok {
+			return resolveIndex(items, path[2:])
+		}
+	}
+
+	switch l {
+	case IncludesLayoutList:
+		return resolveIndex(includes.Items(), path)
+	case IncludesLayoutFlat:
+		if len(path) < 1 {
+			return nil, nil, nil
+		}
+		for _, item := range includes.Items() {
+			if flatKey(item) == path[0] {
+				return resourceIdentifier(item), item, path[1:]
+			}
+		}
+		return nil, nil, nil
+	case IncludesLayoutByKind:
+		if len(path) < 1 {
+			return nil, nil, nil
+		}
+		var ofKind []*kyaml.RNode
+		for _, item := range includes.Items() {
+			if item.GetKind() == path[0] {
+				ofKind = append(ofKind, item)
+			}
+		}
+		return resolveIndex(ofKind, path[1:])
+	default:
+		if len(path) < 4 {
+			return nil, nil, nil
+		}
+		apiVersion, kind, namespace, name := path[0], path[1], path[2], path[3]
+		ref := &kyaml.ResourceIdentifier{
+			TypeMeta: kyaml.TypeMeta{APIVersion: apiVersion, Kind: kind},
+			NameMeta: kyaml.NameMeta{Name: name, Namespace: namespace},
+		}
+		item, _ := includes[apiVersion][kind][namespace][name].(*kyaml.RNode)
+		return ref, item, path[4:]
+	}
+}
+
+// resolveIndex returns the identifier of the item at the index the path starts with, the item itself,
+// and the remaining path.
+func resolveIndex(items []*kyaml.RNode, path []string) (*kyaml.ResourceIdentifier, *kyaml.RNode, []string) {
+	if len(path) < 1 {
+		return nil, nil, nil
+	}
+	i, err := strconv.Atoi(path[0])
+	if err != nil || i < 0 || i >= len(items) {
+		return nil, nil, nil
+	}
+	return resourceIdentifier(items[i]), items[i], path[1:]
+}
+
+// flatKey returns the key of the item in the flat layout.
+func flatKey(item *kyaml.RNode) string {
+	if item.GetNamespace() == "" {
+		return item.GetKind() + "/" + item.GetName()
+	}
+	return item.GetKind() + "/" + item.GetNamespace() + "/" + item.GetName()
+}
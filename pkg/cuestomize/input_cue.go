@@ -0,0 +1,83 @@
+package cuestomize
+
+import (
+	"context"
+	"strconv"
+
+	"cuelang.org/go/cue"
+	cueerrors "cuelang.org/go/cue/errors"
+	"github.com/Workday/cuestomize/api"
+	"github.com/Workday/cuestomize/pkg/cuerrors"
+	"sigs.k8s.io/kustomize/kyaml/fn/framework"
+	"sigs.k8s.io/kustomize/kyaml/kio/kioutil"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+const (
+	// InputCUEField is the field of the function config holding the inline CUE input.
+	InputCUEField = "inputCUE"
+)
+
+// CompileInput converts the input of the KRMInput configuration into a CUE value, unified with its inline CUE input,
+// compiled with the same CUE context.
+// If the inline CUE input fails to compile, the returned error is a ValidationError with one result for each
+// compile error, attributed to the inputCUE field of the function config file.
+func CompileInput(ctx context.Context, cueCtx *cue.Context, config *api.KRMInput) (cue.Value, error) {
+	detailer := cuerrors.FromContextOrEmpty(ctx)
+
+	input, err := config.IntoCueValue(cueCtx)
+	if err != nil {
+		return cue.Value{}, detailer.ErrorWithDetails(err, "failed to convert config into CUE value")
+	}
+	if config.InputCUE == "" {
+		return *input, nil
+	}
+
+	inline := cueCtx.CompileString(config.InputCUE, cue.Filename(inputCUEFilename(config)))
+	if err := inline.Err(); err != nil {
+		return cue.Value{}, NewValidationError(detailer.ErrorWithDetails(err, "failed to compile %s of function config", InputCUEField),
+			inputCUEResults(err, config))
+	}
+	return input.Unify(inline), nil
+}
+
+// inputCUEResults converts the compile errors of the inline CUE input into KRM function results,
+// attributed to the inputCUE field of the function config.
+func inputCUEResults(err error, config *api.KRMInput) framework.Results {
+	ref := &kyaml.ResourceIdentifier{
+		TypeMeta: kyaml.TypeMeta{APIVersion: config.APIVersion, Kind: config.Kind},
+		NameMeta: kyaml.NameMeta{Name: config.Name, Namespace: config.Namespace},
+	}
+	file := configFile(config)
+
+	var results framework.Results
+	for _, e := range cueerrors.Errors(err) {
+		result := (&FieldError{Err: e, Resource: ref, Path: []string{InputCUEField}}).Result()
+		result.File = file
+		results = append(results, result)
+	}
+	return results
+}
+
+// inputCUEFilename returns the file name the inline CUE input is compiled with, so that the positions
+// of its errors name the function config file, when known.
+func inputCUEFilename(config *api.KRMInput) string {
+	if file := configFile(config); file != nil {
+		return file.Path + "#" + InputCUEField
+	}
+	return InputCUEField
+}
+
+// configFile returns the file the function config was read from, according to its file annotations.
+func configFile(config *api.KRMInput) *framework.File {
+	path, index := config.Annotations[kioutil.PathAnnotation], config.Annotations[kioutil.IndexAnnotation]
+	if path == "" {
+		path, index = config.Annotations[kioutil.LegacyPathAnnotation], config.Annotations[kioutil.LegacyIndexAnnotation]
+	}
+	if path == "" {
+		return nil
+	}
+	file := &framework.File{Path: path}
+	file.Index, _ = strconv.Atoi(index)
+	return file
+}
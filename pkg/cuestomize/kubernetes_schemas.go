@@ -0,0 +1,40 @@
+package cuestomize
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Workday/cuestomize/api"
+	"github.com/Workday/cuestomize/pkg/k8sschema"
+)
+
+const (
+	// KubernetesVersionAnnotationKey is the annotation key that sets the Kubernetes minor version (e.g. "1.36")
+	// of the bundled API schemas the CUE model can import, the latest bundled one by default.
+	KubernetesVersionAnnotationKey = "config.cuestomize.io/kubernetes-version"
+	// KubernetesVersionNone is the Kubernetes version disabling the bundled API schemas.
+	KubernetesVersionNone = "none"
+)
+
+// GetKubernetesVersion returns the Kubernetes minor version of the bundled API schemas configured
+// in the KRMInput annotations, or an empty string if they are disabled.
+func GetKubernetesVersion(config *api.KRMInput) (string, error) {
+	version := ""
+	if config != nil {
+		version = config.Annotations[KubernetesVersionAnnotationKey]
+	}
+	switch version {
+	case "":
+		return k8sschema.LatestVersion(), nil
+	case KubernetesVersionNone:
+		return "", nil
+	}
+
+	version = strings.TrimPrefix(version, "v")
+	if versions := k8sschema.Versions(); !slices.Contains(versions, version) {
+		return "", fmt.Errorf("no API schemas bundled for Kubernetes '%s', must be one of: %s, or '%s'",
+			version, strings.Join(versions, ", "), KubernetesVersionNone)
+	}
+	return version, nil
+}
@@ -4,13 +4,39 @@ import (
 	"context"
 	"fmt"
 
+	"cuelang.org/go/cue/ast"
 	"cuelang.org/go/cue/build"
 	"cuelang.org/go/cue/load"
+	"github.com/Workday/cuestomize/api"
+	"github.com/Workday/cuestomize/pkg/k8sschema"
 )
 
 // LoadCUEModel loads a CUE model from the specified path and returns the instances.
 func LoadCUEModel(ctx context.Context, path string) ([]*build.Instance, error) {
+	return LoadCUEModelWithConfig(ctx, path, nil)
+}
+
+// LoadCUEModelWithConfig loads a CUE model from the specified path and returns the instances.
+// The build tags and tag variables of the KRMInput configuration, if not nil, are passed to the loader,
+// to be injected in the fields with a @tag() attribute and to satisfy the @if() build constraints.
+// The bundled Kubernetes API schemas of the configured version are overlaid on the CUE module.
+func LoadCUEModelWithConfig(ctx context.Context, path string, config *api.KRMInput) ([]*build.Instance, error) {
 	cfg := &load.Config{Dir: path}
+	if config != nil {
+		cfg.Tags = config.Tags
+		cfg.TagVars = tagVars(config.TagVars)
+	}
+
+	version, err := GetKubernetesVersion(config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid '%s' annotation: %w", KubernetesVersionAnnotationKey, err)
+	}
+	if version != "" {
+		cfg.Overlay, err = k8sschema.Overlay(path, version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to overlay Kubernetes %s API schemas: %w", version, err)
+		}
+	}
 	instances := load.Instances([]string{"."}, cfg)
 	if len(instances) == 0 {
 		return nil, fmt.Errorf("no CUE instances found")
@@ -18,3 +44,22 @@ func LoadCUEModel(ctx context.Context, path string) ([]*build.Instance, error) {
 
 	return instances, CheckInstances(ctx, instances)
 }
+
+// tagVars converts the given variables into the tag variables of the loader, which are injected as strings.
+// Unlike with the cue command, no other variable (e.g. now or hostname) is available, to keep the evaluation deterministic.
+// Note that, when variables are given, every variable referenced by the CUE model must be among them.
+func tagVars(vars map[string]string) map[string]load.TagVar {
+	if len(vars) == 0 {
+		return nil
+	}
+	result := make(map[string]load.TagVar, len(vars))
+	for name, value := range vars {
+		result[name] = load.TagVar{
+			Func: func() (ast.Expr, error) {
+				return ast.NewString(value), nil
+			},
+			Description: fmt.Sprintf("the '%s' tag variable of the function config", name),
+		}
+	}
+	return result
+}
@@ -24,3 +24,13 @@ func NewLocalPathProvider(resourcesPath string) *LocalPathProvider {
 func (p *LocalPathProvider) Get(_ context.Context) error {
 	return nil
 }
+
+// Ref returns the local file system path to the CUE model.
+func (p *LocalPathProvider) Ref() string {
+	return p.resourcesPath
+}
+
+// Digest returns an empty string, since local CUE models have no resolved digest.
+func (p *LocalPathProvider) Digest() string {
+	return ""
+}
@@ -127,6 +127,8 @@ type OCIModelProvider struct {
 	workingDir    string
 	client        *auth.Client
 	postFetchFunc postFetchFunc
+	// digest is the digest of the fetched artifact, set by Get.
+	digest string
 }
 
 // NewOCIModelProviderFromConfigAndItems creates a new OCIModelProvider based on the provided KRMInput configuration and options.
@@ -193,7 +195,7 @@ func (p *OCIModelProvider) Get(ctx context.Context) error {
 
 	log.Info("fetching from OCI registry", "plainHTTP", p.plainHTTP)
 
-	err := fetcher.FetchFromOCIRegistry(
+	desc, err := fetcher.FetchDescriptorFromOCIRegistry(
 		ctx,
 		p.client,
 		p.workingDir,
@@ -203,6 +205,7 @@ func (p *OCIModelProvider) Get(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to fetch from OCI registry: %w", err)
 	}
+	p.digest = desc.Digest.String()
 
 	if p.postFetchFunc != nil {
 		if err := p.postFetchFunc(ctx, p); err != nil {
@@ -218,3 +221,13 @@ func (p *OCIModelProvider) Get(ctx context.Context) error {
 
 	return nil
 }
+
+// Ref returns the OCI reference of the CUE model.
+func (p *OCIModelProvider) Ref() string {
+	return p.reference.String()
+}
+
+// Digest returns the digest of the CUE model fetched from the OCI registry, or an empty string if it was not fetched yet.
+func (p *OCIModelProvider) Digest() string {
+	return p.digest
+}
@@ -15,3 +15,13 @@ type Provider interface {
 	// Path returns the file system path where the CUE model is located.
 	Path() string
 }
+
+// Describer is an optional interface implemented by providers able to identify the CUE model they provide,
+// e.g. to record the provenance of generated resources.
+type Describer interface {
+	// Ref returns the reference of the CUE model, like its OCI reference or its local path.
+	Ref() string
+	// Digest returns the resolved digest of the CUE model, or an empty string if unknown.
+	// It is only meaningful after Get.
+	Digest() string
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/Workday/cuestomize/pkg/cuestomize/model"
+	"sigs.k8s.io/kustomize/kyaml/fn/framework"
 )
 
 // Option defines a functional option for configuring Cuestomize.
@@ -12,6 +13,8 @@ type Option func(*options)
 // options holds configuration options for the Cuestomize function.
 type options struct {
 	ModelProvider model.Provider
+	Results       *framework.Results
+	Paths         Paths
 }
 
 func (o *options) validate() error {
@@ -27,3 +30,18 @@ func WithModelProvider(provider model.Provider) Option {
 		opts.ModelProvider = provider
 	}
 }
+
+// WithResults sets the KRM function results to which the non-fatal results of the evaluation (e.g. warnings) are appended.
+func WithResults(results *framework.Results) Option {
+	return func(opts *options) {
+		opts.Results = results
+	}
+}
+
+// WithPaths sets the CUE paths in which the inputs are filled into the CUE model, and its results are looked up.
+// Fields left empty keep their default value, and the paths set in the function config annotations take precedence.
+func WithPaths(paths Paths) Option {
+	return func(opts *options) {
+		opts.Paths = paths
+	}
+}
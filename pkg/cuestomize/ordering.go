@@ -0,0 +1,140 @@
+package cuestomize
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/Workday/cuestomize/api"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+const (
+	// OutputOrderAnnotationKey is the annotation key that configures the order in which outputs are added to the stream.
+	OutputOrderAnnotationKey = "config.cuestomize.io/output-order"
+)
+
+// OutputOrder defines the order in which the outputs of the CUE model are added to the stream.
+type OutputOrder string
+
+const (
+	// OutputOrderDeclared keeps the outputs in the order they are declared in the CUE model.
+	OutputOrderDeclared OutputOrder = "declared"
+	// OutputOrderGVK sorts the outputs by group, version, kind, namespace and name.
+	OutputOrderGVK OutputOrder = "gvk"
+	// OutputOrderInstall sorts the outputs in the order they should be installed in a cluster, like Helm does:
+	// Namespaces and CustomResourceDefinitions first, webhook configurations last.
+	// Outputs of the same kind are sorted by group, version, namespace and name.
+	OutputOrderInstall OutputOrder = "install"
+
+	// DefaultOutputOrder is the output order used when none is configured.
+	DefaultOutputOrder = OutputOrderDeclared
+)
+
+// installOrder is the order in which kinds are installed, based on the one of Helm.
+// CustomResourceDefinitions are moved right after Namespaces, since other outputs may be custom resources.
+// Kinds not in the list are installed after the listed ones, and before the ones in installLast.
+var installOrder = []string{
+	"PriorityClass",
+	"Namespace",
+	"CustomResourceDefinition",
+	"NetworkPolicy",
+	"ResourceQuota",
+	"LimitRange",
+	"PodSecurityPolicy",
+	"PodDisruptionBudget",
+	"ServiceAccount",
+	"Secret",
+	"SecretList",
+	"ConfigMap",
+	"StorageClass",
+	"PersistentVolume",
+	"PersistentVolumeClaim",
+	"ClusterRole",
+	"ClusterRoleList",
+	"ClusterRoleBinding",
+	"ClusterRoleBindingList",
+	"Role",
+	"RoleList",
+	"RoleBinding",
+	"RoleBindingList",
+	"Service",
+	"DaemonSet",
+	"Pod",
+	"ReplicationController",
+	"ReplicaSet",
+	"Deployment",
+	"HorizontalPodAutoscaler",
+	"StatefulSet",
+	"Job",
+	"CronJob",
+	"IngressClass",
+	"Ingress",
+	"APIService",
+}
+
+// installLast lists the kinds installed after all the other ones.
+var installLast = []string{
+	"MutatingWebhookConfiguration",
+	"ValidatingWebhookConfiguration",
+}
+
+// ParseOutputOrder parses the given string into an OutputOrder.
+// An empty string results in the DefaultOutputOrder.
+func ParseOutputOrder(order string) (OutputOrder, error) {
+	switch o := OutputOrder(order); o {
+	case "":
+		return DefaultOutputOrder, nil
+	case OutputOrderDeclared, OutputOrderGVK, OutputOrderInstall:
+		return o, nil
+	default:
+		return "", fmt.Errorf("unknown output order '%s', must be one of: %s, %s, %s",
+			order, OutputOrderDeclared, OutputOrderGVK, OutputOrderInstall)
+	}
+}
+
+// GetOutputOrder returns the output order configured in the KRMInput annotations.
+func GetOutputOrder(config *api.KRMInput) (OutputOrder, error) {
+	return ParseOutputOrder(config.Annotations[OutputOrderAnnotationKey])
+}
+
+// sortOutputs sorts the outputs in place according to the given order. The sort is stable, so outputs
+// comparing equal (e.g. with the same GVK, namespace and name) keep the order they are declared in.
+func sortOutputs(outputs []*kyaml.RNode, order OutputOrder) {
+	switch order {
+	case OutputOrderGVK:
+		slices.SortStableFunc(outputs, compareGVK)
+	case OutputOrderInstall:
+		slices.SortStableFunc(outputs, func(a, b *kyaml.RNode) int {
+			return cmp.Or(
+				cmp.Compare(installRank(a.GetKind()), installRank(b.GetKind())),
+				cmp.Compare(a.GetKind(), b.GetKind()),
+				compareGVK(a, b),
+			)
+		})
+	}
+}
+
+// installRank returns the rank of the kind in the install order.
+func installRank(kind string) int {
+	if i := slices.Index(installOrder, kind); i >= 0 {
+		return i
+	}
+	if i := slices.Index(installLast, kind); i >= 0 {
+		return len(installOrder) + 1 + i
+	}
+	return len(installOrder)
+}
+
+// compareGVK compares two items by group, version, kind, namespace and name.
+func compareGVK(a, b *kyaml.RNode) int {
+	idA, idB := resid.FromRNode(a), resid.FromRNode(b)
+	return cmp.Or(
+		cmp.Compare(idA.Group, idB.Group),
+		cmp.Compare(idA.Version, idB.Version),
+		cmp.Compare(idA.Kind, idB.Kind),
+		cmp.Compare(idA.Namespace, idB.Namespace),
+		cmp.Compare(idA.Name, idB.Name),
+	)
+}
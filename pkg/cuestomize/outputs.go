@@ -6,44 +6,131 @@ import (
 
 	"cuelang.org/go/cue"
 	"cuelang.org/go/encoding/yaml"
+	"github.com/Workday/cuestomize/api"
 	"github.com/Workday/cuestomize/pkg/cuerrors"
-	"github.com/go-logr/logr"
+	"sigs.k8s.io/kustomize/kyaml/resid"
 
 	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
 )
 
+const (
+	// resourceKindPath is the CUE path of the kind of a resource.
+	resourceKindPath = "kind"
+	// listKind is the kind of the resources wrapping a list of resources in their items.
+	listKind = "List"
+	// listItemsPath is the CUE path of the items of a resource of kind List.
+	listItemsPath = "items"
+)
+
 // ProcessOutputs processes the outputs from the CUE model and appends them to the output slice.
+// Outputs are read from the default outputs path, and added with the default order and conflict policy,
+// without provenance annotations. See ProcessOutputsWithConfig to configure them.
 func ProcessOutputs(ctx context.Context, unified cue.Value, items []*kyaml.RNode) ([]*kyaml.RNode, error) {
-	log := logr.FromContextOrDiscard(ctx)
+	return ProcessOutputsWithConfig(ctx, unified, items, &api.KRMInput{}, DefaultPaths(), nil)
+}
 
+// ProcessOutputsWithConfig processes the outputs from the CUE model and appends them to the output slice.
+// Outputs are read from the output paths configured in the KRMInput, or from the outputs path if none is.
+// The outputs path is optional for CUE models that delete or patch resources of the input stream.
+// Outputs are added in the order configured in the KRMInput, and the ones colliding with existing items are handled
+// according to the conflict policy configured in the KRMInput, or in the output itself.
+// If provenance is not nil, outputs are stamped with its annotations.
+func ProcessOutputsWithConfig(ctx context.Context, unified cue.Value, items []*kyaml.RNode, config *api.KRMInput, paths Paths, provenance *Provenance) ([]*kyaml.RNode, error) {
 	detailer := cuerrors.FromContextOrEmpty(ctx)
 
-	outputsValue := unified.LookupPath(cue.ParsePath(OutputsPath))
-	if !outputsValue.Exists() {
-		return nil, fmt.Errorf("'%s' not found in unified CUE instance", OutputsPath)
-	} else if outputsValue.Err() != nil {
-		return nil, detailer.ErrorWithDetails(outputsValue.Err(), "failed to lookup '%s' in unified CUE instance", OutputsPath)
+	defaultPolicy, err := GetConflictPolicy(config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid '%s' annotation: %w", ConflictPolicyAnnotationKey, err)
 	}
-	outputsIter, err := getIter(outputsValue)
+	order, err := GetOutputOrder(config)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get iterator over '%s' in unified CUE instance: %v", OutputsPath, err)
+		return nil, fmt.Errorf("invalid '%s' annotation: %w", OutputOrderAnnotationKey, err)
 	}
 
-	for outputsIter.Next() {
-		item := outputsIter.Value()
-
-		rNode, err := cueValueToRNode(&item)
+	var outputs []*kyaml.RNode
+	for _, path := range GetOutputPaths(config, paths) {
+		outputsPath := cue.ParsePath(path)
+		if err := outputsPath.Err(); err != nil {
+			return nil, fmt.Errorf("invalid output path '%s': %w", path, err)
+		}
+		outputsValue := unified.LookupPath(outputsPath)
+		if !outputsValue.Exists() && len(config.OutputPaths) == 0 && modifiesStream(unified, paths) {
+			continue
+		} else if !outputsValue.Exists() {
+			return nil, fmt.Errorf("'%s' not found in unified CUE instance", path)
+		} else if outputsValue.Err() != nil {
+			return nil, detailer.ErrorWithDetails(outputsValue.Err(), "failed to lookup '%s' in unified CUE instance", path)
+		}
+		outputs, err = collectOutputs(outputsValue, outputs)
 		if err != nil {
-			return nil, fmt.Errorf("failed to convert CUE value to kyaml.RNode: %w", err)
+			return nil, fmt.Errorf("failed to collect outputs from '%s' in unified CUE instance: %w", path, err)
 		}
+	}
+	sortOutputs(outputs, order)
 
-		log.V(4).Info("adding item to output resources",
-			"kind", rNode.GetKind(), "apiVersion", rNode.GetApiVersion(), "namespace", rNode.GetNamespace(), "name", rNode.GetName())
-		items = append(items, rNode)
+	for _, output := range outputs {
+		policy, err := outputConflictPolicy(output, defaultPolicy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid '%s' annotation on output [%s]: %w", ConflictPolicyAnnotationKey, resid.FromRNode(output).String(), err)
+		}
+		if err := markHashSuffix(output, config); err != nil {
+			return nil, fmt.Errorf("failed to mark output [%s] for hash suffix: %w", resid.FromRNode(output).String(), err)
+		}
+		if err := provenance.stamp(output); err != nil {
+			return nil, fmt.Errorf("failed to stamp provenance on output [%s]: %w", resid.FromRNode(output).String(), err)
+		}
+		items, err = addOutput(ctx, items, output, policy)
+		if err != nil {
+			return nil, err
+		}
 	}
 	return items, nil
 }
 
+// GetOutputPaths returns the CUE paths of the outputs configured in the KRMInput, or the outputs path if none is.
+func GetOutputPaths(config *api.KRMInput, paths Paths) []string {
+	if len(config.OutputPaths) == 0 {
+		return []string{paths.Outputs}
+	}
+	return config.OutputPaths
+}
+
+// modifiesStream checks if the unified CUE instance has deletions or patches.
+func modifiesStream(unified cue.Value, paths Paths) bool {
+	return unified.LookupPath(cue.ParsePath(paths.Deletions)).Exists() ||
+		unified.LookupPath(cue.ParsePath(paths.Patches)).Exists()
+}
+
+// collectOutputs appends the resources found in value to outputs.
+// Lists and structs that are not resources themselves (i.e. that have no kind) are flattened recursively,
+// and the items of resources of kind List are expanded (a List without items has no outputs).
+func collectOutputs(value cue.Value, outputs []*kyaml.RNode) ([]*kyaml.RNode, error) {
+	if kind := value.LookupPath(cue.ParsePath(resourceKindPath)); kind.Exists() {
+		if kindName, _ := kind.String(); kindName != listKind {
+			rNode, err := cueValueToRNode(&value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert CUE value at '%s' to kyaml.RNode: %w", value.Path(), err)
+			}
+			return append(outputs, rNode), nil
+		}
+		value = value.LookupPath(cue.ParsePath(listItemsPath))
+		if !value.Exists() {
+			return outputs, nil
+		}
+	}
+
+	iter, err := getIter(value)
+	if err != nil {
+		return nil, fmt.Errorf("'%s' is not a resource, nor a list or struct of resources: %w", value.Path(), err)
+	}
+	for iter.Next() {
+		if outputs, err = collectOutputs(iter.Value(), outputs); err != nil {
+			return nil, err
+		}
+	}
+	return outputs, nil
+}
+
 // getIter returns a cue.Iterator over a cue.Value of kind list or struct.
 // It returns an error if the value is not a list nor a struct.
 func getIter(value cue.Value) (*cue.Iterator, error) {
@@ -0,0 +1,225 @@
+package cuestomize
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"cuelang.org/go/cue"
+	"github.com/Workday/cuestomize/api"
+	"github.com/Workday/cuestomize/pkg/cuerrors"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/kustomize/api/filters/patchstrategicmerge"
+	"sigs.k8s.io/kustomize/kyaml/fn/framework"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+const (
+	// StrictPatchesAnnotationKey is the annotation key that makes patches matching no resource an error.
+	StrictPatchesAnnotationKey = "config.cuestomize.io/strict-patches"
+	// StrictPatchesAnnotationValue is the value of the annotation that makes patches matching no resource an error.
+	StrictPatchesAnnotationValue = "true"
+)
+
+// ShouldFailOnUnmatchedPatches checks if the KRMInput configuration has the strict patches annotation set.
+func ShouldFailOnUnmatchedPatches(config *api.KRMInput) bool {
+	return config.Annotations != nil &&
+		config.Annotations[StrictPatchesAnnotationKey] == StrictPatchesAnnotationValue
+}
+
+// PatchType is the strategy used to apply a patch to the matching resources.
+type PatchType string
+
+const (
+	// StrategicMergePatchType applies the patch as a Kubernetes strategic-merge patch.
+	StrategicMergePatchType PatchType = "strategic-merge"
+	// JSONMergePatchType applies the patch as a JSON merge patch (RFC 7386).
+	JSONMergePatchType PatchType = "json-merge"
+)
+
+// Patch is a patch, emitted by the CUE model, to apply to the resources of the input stream.
+type Patch struct {
+	// Target identifies the resources the patch applies to.
+	Target Target `json:"target"`
+	// Type is the patch strategy. Defaults to StrategicMergePatchType.
+	Type PatchType `json:"type,omitempty"`
+	// Patch is the patch content.
+	Patch *kyaml.RNode `json:"-"`
+}
+
+// ProcessPatches applies the patches found in the unified CUE instance to the matching items.
+// Patches are optional: if the patches path does not exist in the unified instance, items are returned unchanged.
+// Patch targets matching no item are returned as warnings, or make the function fail if the KRMInput configuration
+// has the strict patches annotation set.
+func ProcessPatches(ctx context.Context, unified cue.Value, items []*kyaml.RNode, config *api.KRMInput, paths Paths) ([]*kyaml.RNode, framework.Results, error) {
+	detailer := cuerrors.FromContextOrEmpty(ctx)
+
+	patchesValue := unified.LookupPath(cue.ParsePath(paths.Patches))
+	if !patchesValue.Exists() {
+		return items, nil, nil
+	} else if patchesValue.Err() != nil {
+		return nil, nil, detailer.ErrorWithDetails(patchesValue.Err(), "failed to lookup '%s' in unified CUE instance", paths.Patches)
+	}
+	patchesIter, err := getIter(patchesValue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get iterator over '%s' in unified CUE instance: %v", paths.Patches, err)
+	}
+
+	strict := ShouldFailOnUnmatchedPatches(config)
+	index := api.NewItemIndex(items)
+	var warnings framework.Results
+	for patchesIter.Next() {
+		patch, err := decodePatch(patchesIter.Value())
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode patch '%s': %w", patchesIter.Selector(), err)
+		}
+
+		var matched, reindex bool
+		items, matched, reindex, err = applyPatch(ctx, patch, items, index)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to apply patch '%s': %w", patchesIter.Selector(), err)
+		}
+		if reindex {
+			index = api.NewItemIndex(items)
+		}
+		if !matched {
+			if strict {
+				return nil, nil, fmt.Errorf("no items matched for patch target [%s]", patch.Target.String())
+			}
+			warnings = append(warnings, unmatchedTargetWarning("patch", &patch.Target))
+		}
+	}
+	return items, warnings, nil
+}
+
+// decodePatch decodes and validates a patch from its CUE value.
+func decodePatch(value cue.Value) (*Patch, error) {
+	asBytes, err := value.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal patch as JSON: %w", err)
+	}
+
+	patch := &Patch{}
+	if err := json.Unmarshal(asBytes, patch); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal patch: %w", err)
+	}
+
+	if err := patch.Target.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid target: %w", err)
+	}
+	if err := patch.Target.compile(); err != nil {
+		return nil, fmt.Errorf("invalid target: %w", err)
+	}
+
+	patchValue := value.LookupPath(cue.ParsePath("patch"))
+	if !patchValue.Exists() {
+		return nil, fmt.Errorf("'patch' is required")
+	}
+	patch.Patch, err = cueValueToRNode(&patchValue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert patch content: %w", err)
+	}
+
+	switch patch.Type {
+	case "":
+		patch.Type = StrategicMergePatchType
+	case StrategicMergePatchType, JSONMergePatchType:
+	default:
+		return nil, fmt.Errorf("unsupported patch type '%s', must be one of: %s, %s", patch.Type, StrategicMergePatchType, JSONMergePatchType)
+	}
+	return patch, nil
+}
+
+// applyPatch applies the patch to all the items, indexed by index, matching its target, and reports whether any did.
+// Items deleted by the patch (e.g. through a '$patch: delete' directive) are removed from the returned slice.
+// It also reports whether the index no longer reflects the returned items, because the patch deleted or replaced
+// an item, or changed its kind or namespace.
+func applyPatch(ctx context.Context, patch *Patch, items []*kyaml.RNode, index *api.ItemIndex) ([]*kyaml.RNode, bool, bool, error) {
+	log := logr.FromContextOrDiscard(ctx)
+
+	matched, err := patch.Target.Match(index)
+	if err != nil {
+		return nil, false, false, fmt.Errorf("failed to match items against target [%s]: %w", patch.Target.String(), err)
+	}
+	matches := make(map[*kyaml.RNode]struct{}, len(matched))
+	for _, item := range matched {
+		matches[item] = struct{}{}
+	}
+
+	patched := make([]*kyaml.RNode, 0, len(items))
+	reindex := false
+	for _, item := range items {
+		if _, ok := matches[item]; !ok {
+			patched = append(patched, item)
+			continue
+		}
+		kind, namespace := item.GetKind(), item.GetNamespace()
+
+		log.V(4).Info("patching input resource", "type", patch.Type, "resource", resid.FromRNode(item).String())
+		// the patch node can be modified by the merge, so each item is patched with a copy
+		result, err := patchItem(patch.Type, item, patch.Patch.Copy())
+		if err != nil {
+			return nil, false, false, fmt.Errorf("failed to patch resource [%s]: %w", resid.FromRNode(item).String(), err)
+		}
+		if result != item || result.GetKind() != kind || result.GetNamespace() != namespace {
+			reindex = true
+		}
+		if result != nil {
+			patched = append(patched, result)
+		}
+	}
+	return patched, len(matched) > 0, reindex, nil
+}
+
+// patchItem applies the patch to the item using the given strategy.
+// A nil result means that the patch deleted the item.
+func patchItem(patchType PatchType, item, patch *kyaml.RNode) (*kyaml.RNode, error) {
+	switch patchType {
+	case JSONMergePatchType:
+		return jsonMergePatch(item, patch)
+	default:
+		result, err := patchstrategicmerge.Filter{Patch: patch}.Filter([]*kyaml.RNode{item})
+		if err != nil {
+			return nil, err
+		}
+		if len(result) == 0 {
+			return nil, nil
+		}
+		return result[0], nil
+	}
+}
+
+// jsonMergePatch applies the patch to the target following the JSON merge patch semantics (RFC 7386):
+// objects are merged recursively, null values delete the corresponding field, and any other value
+// (lists included) replaces the target value.
+func jsonMergePatch(target, patch *kyaml.RNode) (*kyaml.RNode, error) {
+	if patch.YNode().Kind != kyaml.MappingNode {
+		return patch, nil
+	}
+	if target.IsNil() || target.YNode().Kind != kyaml.MappingNode {
+		target = kyaml.NewMapRNode(nil)
+	}
+
+	err := patch.VisitFields(func(field *kyaml.MapNode) error {
+		key := field.Key.YNode().Value
+		if field.Value.IsTaggedNull() {
+			_, err := target.Pipe(kyaml.Clear(key))
+			return err
+		}
+
+		var current *kyaml.RNode
+		if existing := target.Field(key); existing != nil {
+			current = existing.Value
+		}
+		merged, err := jsonMergePatch(current, field.Value)
+		if err != nil {
+			return err
+		}
+		return target.PipeE(kyaml.SetField(key, merged))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return target, nil
+}
@@ -0,0 +1,175 @@
+package cuestomize
+
+import (
+	"fmt"
+	"slices"
+
+	"cuelang.org/go/cue"
+	"github.com/Workday/cuestomize/api"
+)
+
+const (
+	// InputPathAnnotationKey is the annotation key that sets the CUE path in which the input is injected.
+	InputPathAnnotationKey = "config.cuestomize.io/input-path"
+	// IncludesPathAnnotationKey is the annotation key that sets the CUE path in which the includes are injected.
+	IncludesPathAnnotationKey = "config.cuestomize.io/includes-path"
+	// IncludesLayoutPathAnnotationKey is the annotation key that sets the CUE path in which the layout of the includes is filled.
+	IncludesLayoutPathAnnotationKey = "config.cuestomize.io/includes-layout-path"
+	// ItemPathAnnotationKey is the annotation key that sets the CUE path in which the current item is injected.
+	ItemPathAnnotationKey = "config.cuestomize.io/item-path"
+	// StreamPathAnnotationKey is the annotation key that sets the CUE path in which the input stream is injected.
+	StreamPathAnnotationKey = "config.cuestomize.io/stream-path"
+	// APIVersionPathAnnotationKey is the annotation key that sets the CUE path in which the API version of the function config is filled.
+	APIVersionPathAnnotationKey = "config.cuestomize.io/api-version-path"
+	// KindPathAnnotationKey is the annotation key that sets the CUE path in which the kind of the function config is filled.
+	KindPathAnnotationKey = "config.cuestomize.io/kind-path"
+	// MetadataPathAnnotationKey is the annotation key that sets the CUE path in which the metadata of the function config is filled.
+	MetadataPathAnnotationKey = "config.cuestomize.io/metadata-path"
+	// OutputsPathAnnotationKey is the annotation key that sets the CUE path in which the outputs are looked up.
+	OutputsPathAnnotationKey = "config.cuestomize.io/outputs-path"
+	// PatchesPathAnnotationKey is the annotation key that sets the CUE path in which the patches are looked up.
+	PatchesPathAnnotationKey = "config.cuestomize.io/patches-path"
+	// DeletionsPathAnnotationKey is the annotation key that sets the CUE path in which the deletions are looked up.
+	DeletionsPathAnnotationKey = "config.cuestomize.io/deletions-path"
+	// WarningsPathAnnotationKey is the annotation key that sets the CUE path in which the warnings are looked up.
+	WarningsPathAnnotationKey = "config.cuestomize.io/warnings-path"
+)
+
+// Paths holds the CUE paths in which the function fills its inputs into the CUE model, and looks up its results.
+// Empty fields are left to their default value.
+type Paths struct {
+	// Input is the CUE path in which the input of the function config is injected.
+	Input string
+	// Includes is the CUE path in which the includes are injected.
+	Includes string
+	// IncludesLayout is the CUE path in which the layout of the includes is filled.
+	IncludesLayout string
+	// Item is the CUE path in which the current item is injected, when evaluating the model for each item.
+	Item string
+	// Stream is the CUE path in which the items of the input stream are injected, when enabled.
+	Stream string
+	// APIVersion is the CUE path in which the API version of the function config is filled.
+	APIVersion string
+	// Kind is the CUE path in which the kind of the function config is filled.
+	Kind string
+	// Metadata is the CUE path in which the metadata of the function config is filled.
+	Metadata string
+	// Outputs is the CUE path in which the outputs are looked up, unless the function config sets output paths.
+	Outputs string
+	// Patches is the CUE path in which the patches are looked up.
+	Patches string
+	// Deletions is the CUE path in which the deletions are looked up.
+	Deletions string
+	// Warnings is the CUE path in which the warnings are looked up.
+	Warnings string
+}
+
+// DefaultPaths returns the paths used when none is configured.
+func DefaultPaths() Paths {
+	return Paths{
+		Input:          InputFillPath,
+		Includes:       IncludesFillPath,
+		IncludesLayout: IncludesLayoutFillPath,
+		Item:           ItemFillPath,
+		Stream:         StreamFillPath,
+		APIVersion:     APIVersionFillPath,
+		Kind:           KindFillPath,
+		Metadata:       MetadataFillPath,
+		Outputs:        OutputsPath,
+		Patches:        PatchesPath,
+		Deletions:      DeletionsPath,
+		Warnings:       WarningsPath,
+	}
+}
+
+// GetPaths returns the paths configured in the KRMInput annotations, which take precedence over the given ones,
+// falling back to the default paths for the ones set in neither.
+// It returns an error if a path is not a valid CUE path, or if two paths overlap.
+func GetPaths(config *api.KRMInput, paths Paths) (Paths, error) {
+	defaults := DefaultPaths()
+	for _, path := range []struct {
+		value         *string
+		annotationKey string
+		defaultValue  string
+	}{
+		{&paths.Input, InputPathAnnotationKey, defaults.Input},
+		{&paths.Includes, IncludesPathAnnotationKey, defaults.Includes},
+		{&paths.IncludesLayout, IncludesLayoutPathAnnotationKey, defaults.IncludesLayout},
+		{&paths.Item, ItemPathAnnotationKey, defaults.Item},
+		{&paths.Stream, StreamPathAnnotationKey, defaults.Stream},
+		{&paths.APIVersion, APIVersionPathAnnotationKey, defaults.APIVersion},
+		{&paths.Kind, KindPathAnnotationKey, defaults.Kind},
+		{&paths.Metadata, MetadataPathAnnotationKey, defaults.Metadata},
+		{&paths.Outputs, OutputsPathAnnotationKey, defaults.Outputs},
+		{&paths.Patches, PatchesPathAnnotationKey, defaults.Patches},
+		{&paths.Deletions, DeletionsPathAnnotationKey, defaults.Deletions},
+		{&paths.Warnings, WarningsPathAnnotationKey, defaults.Warnings},
+	} {
+		if value, ok := config.Annotations[path.annotationKey]; ok {
+			*path.value = value
+		} else if *path.value == "" {
+			*path.value = path.defaultValue
+		}
+	}
+	return paths, paths.validate()
+}
+
+// validate checks that all the paths are valid CUE paths, and that none of them is equal to,
+// or contains, another one.
+func (p Paths) validate() error {
+	named := []struct {
+		name string
+		path string
+	}{
+		{"input", p.Input},
+		{"includes", p.Includes},
+		{"includesLayout", p.IncludesLayout},
+		{"item", p.Item},
+		{"stream", p.Stream},
+		{"apiVersion", p.APIVersion},
+		{"kind", p.Kind},
+		{"metadata", p.Metadata},
+		{"outputs", p.Outputs},
+		{"patches", p.Patches},
+		{"deletions", p.Deletions},
+		{"warnings", p.Warnings},
+	}
+
+	selectors := make([][]string, len(named))
+	for i, n := range named {
+		elems, err := pathElements(n.path)
+		if err != nil {
+			return fmt.Errorf("invalid %s path '%s': %w", n.name, n.path, err)
+		}
+		selectors[i] = elems
+	}
+	for i := range named {
+		for j := i + 1; j < len(named); j++ {
+			if isPrefix(selectors[i], selectors[j]) || isPrefix(selectors[j], selectors[i]) {
+				return fmt.Errorf("%s path '%s' and %s path '%s' overlap", named[i].name, named[i].path, named[j].name, named[j].path)
+			}
+		}
+	}
+	return nil
+}
+
+// pathElements parses the given CUE path, and returns its unquoted elements, as they appear in the paths of CUE errors.
+func pathElements(path string) ([]string, error) {
+	if path == "" {
+		return nil, fmt.Errorf("path is empty")
+	}
+	parsed := cue.ParsePath(path)
+	if err := parsed.Err(); err != nil {
+		return nil, err
+	}
+	elems := make([]string, 0, len(parsed.Selectors()))
+	for _, sel := range parsed.Selectors() {
+		elems = append(elems, sel.String())
+	}
+	return unquotePath(elems), nil
+}
+
+// isPrefix checks if prefix is a prefix of path, or equal to it.
+func isPrefix(prefix, path []string) bool {
+	return len(prefix) <= len(path) && slices.Equal(prefix, path[:len(prefix)])
+}
@@ -0,0 +1,175 @@
+package cuestomize
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/Workday/cuestomize/api"
+	"github.com/Workday/cuestomize/pkg/cuestomize/model"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+const (
+	// ProvenanceAnnotationKey is the annotation key that enables the provenance annotations on generated resources.
+	ProvenanceAnnotationKey = "config.cuestomize.io/provenance"
+	// ProvenanceAnnotationValue is the value of the annotation that enables the provenance annotations on generated resources.
+	ProvenanceAnnotationValue = "true"
+	// ProvenancePrefixAnnotationKey is the annotation key that sets the prefix of the provenance annotation keys.
+	ProvenancePrefixAnnotationKey = "config.cuestomize.io/provenance-prefix"
+	// DefaultProvenancePrefix is the prefix of the provenance annotation keys when the prefix annotation is not set.
+	DefaultProvenancePrefix = "cuestomize.io/"
+	// ProvenanceKeyAnnotationPrefix is the prefix of the annotation keys that override the key of a single provenance
+	// annotation, followed by its name, e.g. "config.cuestomize.io/provenance-key.input-hash: example.com/hash".
+	// An empty value omits the provenance annotation.
+	ProvenanceKeyAnnotationPrefix = "config.cuestomize.io/provenance-key."
+
+	// ModuleRefProvenanceKey is the name of the provenance annotation holding the reference of the CUE model.
+	ModuleRefProvenanceKey = "module-ref"
+	// ModuleDigestProvenanceKey is the name of the provenance annotation holding the resolved digest of the CUE model.
+	ModuleDigestProvenanceKey = "module-digest"
+	// ConfigNameProvenanceKey is the name of the provenance annotation holding the name of the function config.
+	ConfigNameProvenanceKey = "config-name"
+	// ConfigNamespaceProvenanceKey is the name of the provenance annotation holding the namespace of the function config.
+	ConfigNamespaceProvenanceKey = "config-namespace"
+	// InputHashProvenanceKey is the name of the provenance annotation holding the hash of the inputs of the CUE model.
+	InputHashProvenanceKey = "input-hash"
+)
+
+// ShouldStampProvenance checks if the KRMInput configuration has the provenance annotation set.
+func ShouldStampProvenance(config *api.KRMInput) bool {
+	return config.Annotations != nil &&
+		config.Annotations[ProvenanceAnnotationKey] == ProvenanceAnnotationValue
+}
+
+// Provenance describes where the resources generated by the CUE model come from.
+type Provenance struct {
+	// Prefix is the prefix of the provenance annotation keys.
+	Prefix string
+	// Keys overrides the full key of the provenance annotations, by name. An empty key omits the annotation.
+	Keys map[string]string
+	// ModuleRef is the reference of the CUE model.
+	ModuleRef string
+	// ModuleDigest is the resolved digest of the CUE model, if known.
+	ModuleDigest string
+	// ConfigName is the name of the function config.
+	ConfigName string
+	// ConfigNamespace is the namespace of the function config.
+	ConfigNamespace string
+	// InputHash is the hash of the inputs the CUE model was evaluated with.
+	InputHash string
+}
+
+// provenanceKeys are the names of the provenance annotations.
+var provenanceKeys = []string{
+	ModuleRefProvenanceKey,
+	ModuleDigestProvenanceKey,
+	ConfigNameProvenanceKey,
+	ConfigNamespaceProvenanceKey,
+	InputHashProvenanceKey,
+}
+
+// NewProvenance returns the provenance of the resources generated with the given configuration and model provider,
+// or nil if the KRMInput configuration does not enable provenance annotations.
+// The reference and digest of the CUE model are only known if the provider implements model.Describer.
+// It returns an error if the KRMInput annotations override the key of an unknown provenance annotation.
+func NewProvenance(config *api.KRMInput, provider model.Provider) (*Provenance, error) {
+	if !ShouldStampProvenance(config) {
+		return nil, nil
+	}
+
+	provenance := &Provenance{
+		Prefix:          DefaultProvenancePrefix,
+		ConfigName:      config.Name,
+		ConfigNamespace: config.Namespace,
+	}
+	if prefix, ok := config.Annotations[ProvenancePrefixAnnotationKey]; ok {
+		provenance.Prefix = prefix
+	}
+	for annotation, key := range config.Annotations {
+		name, ok := strings.CutPrefix(annotation, ProvenanceKeyAnnotationPrefix)
+		if !ok {
+			continue
+		}
+		if !slices.Contains(provenanceKeys, name) {
+			return nil, fmt.Errorf("invalid '%s' annotation: unknown provenance annotation '%s', must be one of: %s",
+				annotation, name, strings.Join(provenanceKeys, ", "))
+		}
+		if provenance.Keys == nil {
+			provenance.Keys = map[string]string{}
+		}
+		provenance.Keys[name] = key
+	}
+	if describer, ok := provider.(model.Describer); ok {
+		provenance.ModuleRef = describer.Ref()
+		provenance.ModuleDigest = describer.Digest()
+	}
+	return provenance, nil
+}
+
+// withInputHash returns a copy of the provenance with the hash of the given inputs.
+// It is a no-op on a nil provenance.
+func (p *Provenance) withInputHash(config *api.KRMInput, includes api.Includes, stream any, item *kyaml.RNode) (*Provenance, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	inputs := map[string]any{
+		InputFillPath:    config,
+		IncludesFillPath: includes,
+	}
+	if stream != nil {
+		inputs[StreamFillPath] = stream
+	}
+	if item != nil {
+		inputs[ItemFillPath] = item
+	}
+	asBytes, err := json.Marshal(inputs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal inputs as JSON: %w", err)
+	}
+	hash := sha256.Sum256(asBytes)
+
+	withHash := *p
+	withHash.InputHash = "sha256:" + hex.EncodeToString(hash[:])
+	return &withHash, nil
+}
+
+// Annotations returns the provenance annotations, omitting the ones with an empty value or key.
+func (p *Provenance) Annotations() map[string]string {
+	annotations := map[string]string{}
+	for name, value := range map[string]string{
+		ModuleRefProvenanceKey:       p.ModuleRef,
+		ModuleDigestProvenanceKey:    p.ModuleDigest,
+		ConfigNameProvenanceKey:      p.ConfigName,
+		ConfigNamespaceProvenanceKey: p.ConfigNamespace,
+		InputHashProvenanceKey:       p.InputHash,
+	} {
+		key, ok := p.Keys[name]
+		if !ok {
+			key = p.Prefix + name
+		}
+		if value != "" && key != "" {
+			annotations[key] = value
+		}
+	}
+	return annotations
+}
+
+// stamp sets the provenance annotations on the output. It is a no-op on a nil provenance.
+func (p *Provenance) stamp(output *kyaml.RNode) error {
+	if p == nil {
+		return nil
+	}
+	annotations := p.Annotations()
+	for _, key := range slices.Sorted(maps.Keys(annotations)) {
+		if err := output.PipeE(kyaml.SetAnnotation(key, annotations[key])); err != nil {
+			return fmt.Errorf("failed to set annotation '%s': %w", key, err)
+		}
+	}
+	return nil
+}
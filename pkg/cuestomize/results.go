@@ -0,0 +1,94 @@
+package cuestomize
+
+import (
+	"strconv"
+
+	"github.com/Workday/cuestomize/api"
+	"sigs.k8s.io/kustomize/kyaml/fn/framework"
+	"sigs.k8s.io/kustomize/kyaml/kio/kioutil"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+const (
+	// PositionsResultTag is the tag of the KRM function results holding the positions, in the CUE model, of the error.
+	PositionsResultTag = "cuestomize.io/positions"
+)
+
+// ValidationError is the error returned when the CUE model fails to unify with, or to validate, the function inputs.
+// Besides the detailed error, it carries the validation errors and one KRM function result for each of them,
+// which can be retrieved with errors.As.
+type ValidationError struct {
+	err error
+	// Errors holds the validation errors, grouped by included resource. It is empty when the error
+	// is not caused by the CUE evaluation (e.g. warnings promoted to errors).
+	Errors ValidationErrors
+	// Results holds one KRM function result for each error.
+	Results framework.Results
+}
+
+// NewValidationError creates a new ValidationError wrapping err, and carrying the provided results.
+func NewValidationError(err error, results framework.Results) *ValidationError {
+	return &ValidationError{err: err, Results: results}
+}
+
+// Error returns the detailed error message.
+func (e *ValidationError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the wrapped error, the results and, if any, the validation errors, so that all of them can be retrieved with errors.As.
+func (e *ValidationError) Unwrap() []error {
+	errs := []error{e.err, e.Results}
+	if len(e.Errors) > 0 {
+		errs = append(errs, e.Errors)
+	}
+	return errs
+}
+
+// ValidationResults converts the given CUE error into KRM function results, one for each CUE error.
+// Errors located in the includes are attributed to the included resource they refer to, with the field path
+// relative to the resource, and the file annotations of the resource.
+func ValidationResults(err error, includes api.Includes, named api.NamedIncludes, layout IncludesLayout, paths Paths) framework.Results {
+	return NewValidationErrors(err, includes, named, layout, paths).Results()
+}
+
+// includedResource returns the identifier of the included resource the path refers to, and the resource itself,
+// if the path points inside the includes (e.g. <includes path>.<apiVersion>.<kind>.<namespace>.<name> with the
+// nested layout, or <includes path>.named.<alias>.<index>), together with the path relative to the resource.
+func includedResource(path []string, includes api.Includes, named api.NamedIncludes, layout IncludesLayout, includesPath []string) (*kyaml.ResourceIdentifier, *kyaml.RNode, []string) {
+	if !isPrefix(includesPath, path) {
+		return nil, nil, nil
+	}
+	return layout.resolve(path[len(includesPath):], includes, named)
+}
+
+// resourceIdentifier returns the identifier of the given item.
+func resourceIdentifier(item *kyaml.RNode) *kyaml.ResourceIdentifier {
+	return &kyaml.ResourceIdentifier{
+		TypeMeta: kyaml.TypeMeta{APIVersion: item.GetApiVersion(), Kind: item.GetKind()},
+		NameMeta: kyaml.NameMeta{Name: item.GetName(), Namespace: item.GetNamespace()},
+	}
+}
+
+// fileOf returns the file the item was read from, according to its file annotations.
+func fileOf(item *kyaml.RNode) *framework.File {
+	path, index, _ := kioutil.GetFileAnnotations(item)
+	if path == "" {
+		return nil
+	}
+	file := &framework.File{Path: path}
+	file.Index, _ = strconv.Atoi(index)
+	return file
+}
+
+// unquotePath unquotes the elements of a CUE path that are quoted labels (e.g. "apps/v1").
+func unquotePath(path []string) []string {
+	unquoted := make([]string, len(path))
+	for i, sel := range path {
+		if s, err := strconv.Unquote(sel); err == nil {
+			sel = s
+		}
+		unquoted[i] = sel
+	}
+	return unquoted
+}
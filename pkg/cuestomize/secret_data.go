@@ -0,0 +1,41 @@
+package cuestomize
+
+import (
+	"fmt"
+
+	"github.com/Workday/cuestomize/api"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+const (
+	// IncludeSecretDataAnnotationKey is the annotation key that forwards the data and stringData of the Secrets
+	// injected with the stream, or as the forEach item, into the CUE model. Included Secrets are governed by the
+	// includeSecretData field of their selector instead.
+	IncludeSecretDataAnnotationKey = "config.cuestomize.io/include-secret-data"
+	// IncludeSecretDataAnnotationValue is the value of the annotation that forwards the data of Secrets.
+	IncludeSecretDataAnnotationValue = "true"
+)
+
+// ShouldIncludeSecretData checks if the KRMInput configuration has the include secret data annotation set.
+func ShouldIncludeSecretData(config *api.KRMInput) bool {
+	return config.Annotations != nil &&
+		config.Annotations[IncludeSecretDataAnnotationKey] == IncludeSecretDataAnnotationValue
+}
+
+// redactSecretData returns the items as injected into the CUE model: without the data and stringData of Secrets,
+// unless the KRMInput configuration includes them. The items themselves are left untouched.
+func redactSecretData(config *api.KRMInput, items []*kyaml.RNode) ([]*kyaml.RNode, error) {
+	if ShouldIncludeSecretData(config) {
+		return items, nil
+	}
+	redacted := make([]*kyaml.RNode, 0, len(items))
+	for _, item := range items {
+		injected, err := api.RedactSecretData(item)
+		if err != nil {
+			return nil, fmt.Errorf("failed to redact data of [%s]: %w", resid.FromRNode(item).String(), err)
+		}
+		redacted = append(redacted, injected)
+	}
+	return redacted, nil
+}
@@ -0,0 +1,130 @@
+package cuestomize
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Workday/cuestomize/api"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/kustomize/kyaml/kio/filters"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+const (
+	// StreamAnnotationKey is the annotation key that injects all the items of the input stream into the CUE model,
+	// with the layout set as value.
+	StreamAnnotationKey = "config.cuestomize.io/stream"
+	// StreamExcludeAnnotationKey is the annotation key that excludes some items from the injected stream.
+	// Its value is a comma-separated list of StreamExclusion.
+	StreamExcludeAnnotationKey = "config.cuestomize.io/stream-exclude"
+)
+
+// StreamLayout defines the shape in which the items of the input stream are injected into the CUE model.
+type StreamLayout string
+
+const (
+	// StreamLayoutList injects the items as a list, in the order they appear in the input stream.
+	StreamLayoutList StreamLayout = "list"
+	// StreamLayoutNested injects the items indexed by API version, kind, namespace and name, like the includes.
+	StreamLayoutNested StreamLayout = "nested"
+)
+
+// StreamExclusion defines a class of items excluded from the injected stream.
+type StreamExclusion string
+
+const (
+	// StreamExcludeLocalConfig excludes the items with the config.kubernetes.io/local-config annotation.
+	StreamExcludeLocalConfig StreamExclusion = "local-config"
+	// StreamExcludeFunctionConfig excludes the function config itself, when it is part of the input stream.
+	StreamExcludeFunctionConfig StreamExclusion = "function-config"
+)
+
+// GetStreamLayout returns the stream layout configured in the KRMInput annotations,
+// or an empty layout if the stream is not to be injected.
+func GetStreamLayout(config *api.KRMInput) (StreamLayout, error) {
+	switch layout := StreamLayout(config.Annotations[StreamAnnotationKey]); layout {
+	case "", StreamLayoutList, StreamLayoutNested:
+		return layout, nil
+	default:
+		return "", fmt.Errorf("unknown stream layout '%s', must be one of: %s, %s", layout, StreamLayoutList, StreamLayoutNested)
+	}
+}
+
+// GetStreamExclusions returns the stream exclusions configured in the KRMInput annotations.
+func GetStreamExclusions(config *api.KRMInput) ([]StreamExclusion, error) {
+	value := config.Annotations[StreamExcludeAnnotationKey]
+	if value == "" {
+		return nil, nil
+	}
+
+	var exclusions []StreamExclusion
+	for elem := range strings.SplitSeq(value, ",") {
+		switch exclusion := StreamExclusion(strings.TrimSpace(elem)); exclusion {
+		case StreamExcludeLocalConfig, StreamExcludeFunctionConfig:
+			exclusions = append(exclusions, exclusion)
+		default:
+			return nil, fmt.Errorf("unknown stream exclusion '%s', must be one of: %s, %s", exclusion, StreamExcludeLocalConfig, StreamExcludeFunctionConfig)
+		}
+	}
+	return exclusions, nil
+}
+
+// ExtractStream returns the items of the input stream to inject into the CUE model, in the layout configured in the
+// KRMInput annotations: either a list of items, or api.Includes. It returns nil if the stream is not to be injected.
+// The data of Secrets is redacted, unless the KRMInput annotations include it.
+func ExtractStream(ctx context.Context, config *api.KRMInput, items []*kyaml.RNode) (any, error) {
+	log := logr.FromContextOrDiscard(ctx)
+
+	layout, err := GetStreamLayout(config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid '%s' annotation: %w", StreamAnnotationKey, err)
+	}
+	if layout == "" {
+		return nil, nil
+	}
+	exclusions, err := GetStreamExclusions(config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid '%s' annotation: %w", StreamExcludeAnnotationKey, err)
+	}
+
+	stream := make([]*kyaml.RNode, 0, len(items))
+	for _, item := range items {
+		if slices.Contains(exclusions, StreamExcludeLocalConfig) && isLocalConfig(item) {
+			continue
+		}
+		if slices.Contains(exclusions, StreamExcludeFunctionConfig) && isFunctionConfig(item, config) {
+			continue
+		}
+		stream = append(stream, item)
+	}
+	stream, err = redactSecretData(config, stream)
+	if err != nil {
+		return nil, err
+	}
+	log.V(4).Info("injecting input stream into CUE model", "layout", layout, "count", len(stream), "excluded", len(items)-len(stream))
+
+	if layout == StreamLayoutList {
+		return stream, nil
+	}
+	nested := make(api.Includes)
+	for _, item := range stream {
+		nested.Add(item)
+	}
+	return nested, nil
+}
+
+// isLocalConfig checks if the item is marked as local config, which is not meant to be applied to a cluster.
+func isLocalConfig(item *kyaml.RNode) bool {
+	_, ok := item.GetAnnotations()[filters.LocalConfigAnnotation]
+	return ok
+}
+
+// isFunctionConfig checks if the item is the function config, i.e. has the same API version, kind, namespace and name.
+func isFunctionConfig(item *kyaml.RNode, config *api.KRMInput) bool {
+	return item.GetApiVersion() == config.APIVersion &&
+		item.GetKind() == config.Kind &&
+		item.GetNamespace() == config.Namespace &&
+		item.GetName() == config.Name
+}
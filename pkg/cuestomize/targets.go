@@ -0,0 +1,107 @@
+package cuestomize
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cuelang.org/go/cue"
+	"github.com/Workday/cuestomize/api"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/fn/framework"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+// Target identifies the resources in the input stream an operation of the CUE model applies to.
+// Exactly one of Selector and ID must be set.
+type Target struct {
+	// Selector matches resources with the same semantics as the includes selectors.
+	Selector *types.Selector `json:"selector,omitempty"`
+	// ID matches the single resource with the given group, version, kind, namespace and name.
+	ID *resid.ResId `json:"id,omitempty"`
+
+	// compiled is the Selector compiled, set by compile.
+	compiled *api.CompiledSelector
+}
+
+// Validate checks that exactly one of the Selector and ID of the target is set.
+func (t *Target) Validate() error {
+	if (t.Selector == nil) == (t.ID == nil) {
+		return fmt.Errorf("exactly one of 'selector' and 'id' must be set")
+	}
+	return nil
+}
+
+// compile compiles the Selector of the target, if any, so that it can be matched against many items cheaply.
+func (t *Target) compile() error {
+	if t.Selector == nil || t.compiled != nil {
+		return nil
+	}
+	compiled, err := api.CompileSelector(t.Selector)
+	if err != nil {
+		return err
+	}
+	t.compiled = compiled
+	return nil
+}
+
+// Matches checks if the given item is matched by the target.
+func (t *Target) Matches(item *kyaml.RNode) (bool, error) {
+	if t.ID != nil {
+		return resid.FromRNode(item).Equals(*t.ID), nil
+	}
+	if err := t.compile(); err != nil {
+		return false, err
+	}
+	return t.compiled.Matches(item), nil
+}
+
+// Match returns the items of the index matched by the target, in the order they were indexed.
+func (t *Target) Match(index *api.ItemIndex) ([]*kyaml.RNode, error) {
+	if t.ID != nil {
+		return index.MatchID(*t.ID), nil
+	}
+	if err := t.compile(); err != nil {
+		return nil, err
+	}
+	return index.Match(t.compiled), nil
+}
+
+// String returns a human-readable representation of the target.
+func (t *Target) String() string {
+	if t.ID != nil {
+		return t.ID.String()
+	}
+	if t.Selector != nil {
+		return t.Selector.String()
+	}
+	return "<empty target>"
+}
+
+// unmatchedTargetWarning returns the warning reported for a target of the given operation matching no resource.
+func unmatchedTargetWarning(operation string, target *Target) *framework.Result {
+	return &framework.Result{
+		Message:  fmt.Sprintf("%s target [%s] matched no resource", operation, target.String()),
+		Severity: framework.Warning,
+	}
+}
+
+// decodeTarget decodes and validates a target from its CUE value.
+func decodeTarget(value cue.Value) (*Target, error) {
+	asBytes, err := value.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal target as JSON: %w", err)
+	}
+
+	target := &Target{}
+	if err := json.Unmarshal(asBytes, target); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal target: %w", err)
+	}
+	if err := target.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid target: %w", err)
+	}
+	if err := target.compile(); err != nil {
+		return nil, fmt.Errorf("invalid target: %w", err)
+	}
+	return target, nil
+}
@@ -0,0 +1,131 @@
+package cuestomize
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Workday/cuestomize/api"
+)
+
+const (
+	// EvaluationTimeoutAnnotationKey is the annotation key that sets the deadline of the evaluation of the CUE model,
+	// as a duration (e.g. "30s"). It takes precedence over the EvaluationTimeoutEnvVar environment variable.
+	EvaluationTimeoutAnnotationKey = "config.cuestomize.io/evaluation-timeout"
+	// EvaluationTimeoutEnvVar is the name of the environment variable that sets the deadline of the evaluation
+	// of the CUE model, as a duration (e.g. "30s").
+	EvaluationTimeoutEnvVar = "CUESTOMIZE_EVALUATION_TIMEOUT"
+)
+
+// Phase is a phase of the evaluation of the CUE model.
+type Phase string
+
+const (
+	// PhaseLoading is the phase in which the CUE model is loaded from disk.
+	PhaseLoading Phase = "loading"
+	// PhaseBuilding is the phase in which the instances of the CUE model are built.
+	PhaseBuilding Phase = "building"
+	// PhaseUnification is the phase in which the CUE model is unified with the inputs.
+	PhaseUnification Phase = "unification"
+	// PhaseValidation is the phase in which the unified CUE model is validated, and its warnings collected.
+	PhaseValidation Phase = "validation"
+	// PhaseOutputProcessing is the phase in which the deletions, patches and outputs are applied to the items.
+	PhaseOutputProcessing Phase = "output processing"
+)
+
+// PhaseError is returned when the evaluation is interrupted, because its context is cancelled or its deadline exceeded.
+type PhaseError struct {
+	// Phase is the phase that was interrupted.
+	Phase Phase
+	// Err is the cause of the interruption.
+	Err error
+}
+
+// Error returns the phase that was interrupted, and why.
+func (e *PhaseError) Error() string {
+	return fmt.Sprintf("evaluation interrupted during the %s phase: %v", e.Phase, e.Err)
+}
+
+// Unwrap returns the cause of the interruption.
+func (e *PhaseError) Unwrap() error {
+	return e.Err
+}
+
+// timeoutError is the cause of the interruption of an evaluation whose deadline is exceeded.
+type timeoutError struct {
+	timeout time.Duration
+}
+
+// Error returns the exceeded timeout.
+func (e *timeoutError) Error() string {
+	return fmt.Sprintf("evaluation timeout of %s exceeded", e.timeout)
+}
+
+// Is makes the error match context.DeadlineExceeded.
+func (e *timeoutError) Is(target error) bool {
+	return target == context.DeadlineExceeded
+}
+
+// GetEvaluationTimeout returns the evaluation timeout configured in the KRMInput annotations,
+// or in the environment, 0 if none is.
+func GetEvaluationTimeout(config *api.KRMInput) (time.Duration, error) {
+	source := EvaluationTimeoutAnnotationKey
+	value, ok := config.Annotations[EvaluationTimeoutAnnotationKey]
+	if !ok {
+		source = EvaluationTimeoutEnvVar
+		value = os.Getenv(EvaluationTimeoutEnvVar)
+	}
+	if value == "" {
+		return 0, nil
+	}
+
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout < 0 {
+		return 0, fmt.Errorf("invalid value '%s' for '%s': must be a non-negative duration", value, source)
+	}
+	return timeout, nil
+}
+
+// withEvaluationTimeout returns a copy of ctx that is cancelled once the given timeout is exceeded.
+// A timeout of 0 leaves ctx without deadline.
+func withEvaluationTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout == 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeoutCause(ctx, timeout, &timeoutError{timeout: timeout})
+}
+
+// runPhase runs fn, returning early with a PhaseError if ctx is done before fn returns.
+// CUE evaluation cannot be interrupted, so fn keeps running in the background in that case,
+// and must not write to anything the caller still reads after an interruption: phases modifying the items
+// use checkPhase instead.
+func runPhase(ctx context.Context, phase Phase, fn func() error) error {
+	if ctx.Err() != nil {
+		return &PhaseError{Phase: phase, Err: context.Cause(ctx)}
+	}
+	if ctx.Done() == nil {
+		return fn() // no deadline nor cancellation, no need for a goroutine
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- fn()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return &PhaseError{Phase: phase, Err: context.Cause(ctx)}
+	}
+}
+
+// checkPhase returns a PhaseError if ctx is done.
+// Phases that only run Go code, like the output processing one, check it between their steps instead of running
+// in the background with runPhase, so that nothing keeps modifying the items after an interruption.
+func checkPhase(ctx context.Context, phase Phase) error {
+	if ctx.Err() != nil {
+		return &PhaseError{Phase: phase, Err: context.Cause(ctx)}
+	}
+	return nil
+}
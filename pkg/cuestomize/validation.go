@@ -0,0 +1,317 @@
+package cuestomize
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"cuelang.org/go/cue"
+	cueerrors "cuelang.org/go/cue/errors"
+	"github.com/Workday/cuestomize/api"
+	"github.com/Workday/cuestomize/pkg/cuerrors"
+	"sigs.k8s.io/kustomize/kyaml/fn/framework"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+const (
+	// MaxValidationErrorsAnnotationKey is the annotation key that sets the maximum number of validation errors reported.
+	// A value of 0 reports all the validation errors.
+	MaxValidationErrorsAnnotationKey = "config.cuestomize.io/max-validation-errors"
+	// DefaultMaxValidationErrors is the maximum number of validation errors reported when the annotation is not set.
+	DefaultMaxValidationErrors = 100
+)
+
+// GetMaxValidationErrors returns the maximum number of validation errors to report, as configured in the KRMInput.
+func GetMaxValidationErrors(config *api.KRMInput) (int, error) {
+	value, ok := config.Annotations[MaxValidationErrorsAnnotationKey]
+	if !ok {
+		return DefaultMaxValidationErrors, nil
+	}
+	maxErrors, err := strconv.Atoi(value)
+	if err != nil || maxErrors < 0 {
+		return 0, fmt.Errorf("invalid value '%s' for annotation '%s': must be a non-negative integer", value, MaxValidationErrorsAnnotationKey)
+	}
+	return maxErrors, nil
+}
+
+// FieldError is a validation error of a single field of the unified CUE instance.
+type FieldError struct {
+	// Err is the CUE error.
+	Err cueerrors.Error
+	// Resource identifies the included resource the field belongs to, nil if the field is not in the includes.
+	Resource *kyaml.ResourceIdentifier
+	// Path is the path of the field, relative to Resource if set, or to the root of the CUE model otherwise.
+	Path []string
+	// item is the included resource the field belongs to, if known.
+	item *kyaml.RNode
+}
+
+// Error returns the CUE error message, prefixed with the resource the field belongs to, if any.
+func (e *FieldError) Error() string {
+	format, args := e.Err.Msg()
+	msg := fmt.Sprintf(format, args...)
+	if len(e.Path) > 0 {
+		msg = strings.Join(e.Path, ".") + ": " + msg
+	}
+	if e.Resource != nil {
+		msg = resourceIdentifierString(e.Resource) + " " + msg
+	}
+	return msg
+}
+
+// Unwrap returns the CUE error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Result converts the error into a KRM function result, attributed to the included resource the field belongs to.
+func (e *FieldError) Result() *framework.Result {
+	format, args := e.Err.Msg()
+	result := &framework.Result{
+		Message:     fmt.Sprintf(format, args...),
+		Severity:    framework.Error,
+		ResourceRef: e.Resource,
+	}
+	if e.item != nil {
+		result.File = fileOf(e.item)
+	}
+	if len(e.Path) > 0 {
+		result.Field = &framework.Field{Path: strings.Join(e.Path, ".")}
+	}
+
+	if positions := cueerrors.Positions(e.Err); len(positions) > 0 {
+		asStrings := make([]string, 0, len(positions))
+		for _, pos := range positions {
+			asStrings = append(asStrings, pos.String())
+		}
+		result.Tags = map[string]string{PositionsResultTag: strings.Join(asStrings, ",")}
+	}
+	return result
+}
+
+// ValidationErrors holds the validation errors of the unified CUE instance, grouped by included resource.
+// It can be retrieved with errors.As from the errors returned by Cuestomize, and ranged over.
+type ValidationErrors []*FieldError
+
+// NewValidationErrors converts the given CUE error into validation errors, one for each CUE error.
+// Errors located in the includes are attributed to the included resource they refer to, and grouped
+// by resource in the order the resources first appear; errors not located in the includes come first.
+// The includes are looked up at the includes path, or at the default one if the former is not set,
+// in the given layout, together with the named includes.
+func NewValidationErrors(err error, includes api.Includes, named api.NamedIncludes, layout IncludesLayout, paths Paths) ValidationErrors {
+	includesPath, pathErr := pathElements(paths.Includes)
+	if pathErr != nil {
+		includesPath = []string{IncludesFillPath}
+	}
+
+	var errs ValidationErrors
+	for _, e := range cueerrors.Errors(err) {
+		fieldErr := &FieldError{Err: e, Path: unquotePath(e.Path())}
+		if ref, item, path := includedResource(fieldErr.Path, includes, named, layout, includesPath); ref != nil {
+			fieldErr.Resource = ref
+			fieldErr.Path = path
+			fieldErr.item = item
+		}
+		errs = append(errs, fieldErr)
+	}
+
+	groups := map[kyaml.ResourceIdentifier]int{}
+	for _, e := range errs {
+		if e.Resource == nil {
+			continue
+		}
+		if _, ok := groups[*e.Resource]; !ok {
+			groups[*e.Resource] = len(groups) + 1
+		}
+	}
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].group(groups) < errs[j].group(groups)
+	})
+	return errs
+}
+
+// group returns the index of the group of the error, 0 for errors not related to an included resource.
+func (e *FieldError) group(groups map[kyaml.ResourceIdentifier]int) int {
+	if e.Resource == nil {
+		return 0
+	}
+	return groups[*e.Resource]
+}
+
+// Error returns the number of validation errors, followed by one line for each of them.
+func (e ValidationErrors) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d validation errors:", len(e))
+	for _, err := range e {
+		b.WriteString("\n- ")
+		b.WriteString(err.Error())
+	}
+	return b.String()
+}
+
+// Unwrap returns the validation errors, so that each of them can be retrieved with errors.As.
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// ByResource returns the validation errors of each included resource.
+// Errors not related to an included resource are returned under the zero ResourceIdentifier.
+func (e ValidationErrors) ByResource() map[kyaml.ResourceIdentifier]ValidationErrors {
+	byResource := map[kyaml.ResourceIdentifier]ValidationErrors{}
+	for _, err := range e {
+		var ref kyaml.ResourceIdentifier
+		if err.Resource != nil {
+			ref = *err.Resource
+		}
+		byResource[ref] = append(byResource[ref], err)
+	}
+	return byResource
+}
+
+// Results converts the validation errors into KRM function results, one for each error.
+func (e ValidationErrors) Results() framework.Results {
+	results := make(framework.Results, 0, len(e))
+	for _, err := range e {
+		results = append(results, err.Result())
+	}
+	return results
+}
+
+// cueError returns the validation errors as a single CUE error list.
+func (e ValidationErrors) cueError() cueerrors.Error {
+	var errs cueerrors.Error
+	for _, err := range e {
+		errs = cueerrors.Append(errs, err.Err)
+	}
+	return errs
+}
+
+// collectErrors walks the value and returns all the errors it finds, including the ones found in err.
+// CUE stops reporting incomplete values once it finds a conflict, and only reports the first error of a value,
+// so walking the value is the only way to report every error at once.
+// Since an error makes all its parents erroneous, structs cannot always be iterated: the fields of the shapes
+// (the values the value was built from, like the CUE model and the inputs) are then walked instead.
+func collectErrors(value cue.Value, err error, shapes ...cue.Value) cueerrors.Error {
+	var errs cueerrors.Error
+	seen := map[string]bool{}
+	add := func(err error) {
+		for _, e := range cueerrors.Errors(err) {
+			format, args := e.Msg()
+			key := strings.Join(e.Path(), ".") + ": " + fmt.Sprintf(format, args...)
+			if !seen[key] {
+				seen[key] = true
+				errs = cueerrors.Append(errs, e)
+			}
+		}
+	}
+
+	add(err)
+	walkErrors(value, shapes, add)
+	return errs
+}
+
+// walkErrors calls add with the errors of each field of the value, recursively.
+// Errors of structs and lists are the ones of their children, so they are only reported if no child reports any.
+func walkErrors(value cue.Value, shapes []cue.Value, add func(error)) {
+	children := childSelectors(value, shapes)
+	if children == nil {
+		if err := value.Validate(cue.Final(), cue.Concrete(true)); err != nil {
+			add(err)
+		}
+		return
+	}
+
+	found := false
+	for _, sel := range children {
+		path := cue.MakePath(sel)
+		childShapes := make([]cue.Value, 0, len(shapes))
+		for _, shape := range shapes {
+			if child := shape.LookupPath(path); child.Exists() {
+				childShapes = append(childShapes, child)
+			}
+		}
+		walkErrors(value.LookupPath(path), childShapes, func(err error) {
+			found = true
+			add(err)
+		})
+	}
+	if err := value.Err(); err != nil && !found {
+		add(err)
+	}
+}
+
+// childSelectors returns the selectors of the regular fields, or of the elements, of the value.
+// If the value cannot be iterated, the ones of its shapes are returned. A nil slice is returned for leaves.
+func childSelectors(value cue.Value, shapes []cue.Value) []cue.Selector {
+	if selectors, ok := iterSelectors(value); ok {
+		return selectors
+	}
+
+	var selectors []cue.Selector
+	seen := map[string]bool{}
+	for _, shape := range shapes {
+		shapeSelectors, _ := iterSelectors(shape)
+		for _, sel := range shapeSelectors {
+			if !seen[sel.String()] {
+				seen[sel.String()] = true
+				selectors = append(selectors, sel)
+			}
+		}
+	}
+	return selectors
+}
+
+// iterSelectors returns the selectors of the regular fields of a struct, or of the elements of a list,
+// and whether the value could be iterated.
+func iterSelectors(value cue.Value) ([]cue.Selector, bool) {
+	var iter *cue.Iterator
+	switch value.Kind() {
+	case cue.StructKind:
+		fields, err := value.Fields()
+		if err != nil {
+			return nil, false
+		}
+		iter = fields
+	case cue.ListKind:
+		elems, err := value.List()
+		if err != nil {
+			return nil, false
+		}
+		iter = &elems
+	default:
+		return nil, false
+	}
+
+	selectors := []cue.Selector{}
+	for iter.Next() {
+		selectors = append(selectors, iter.Selector())
+	}
+	return selectors, true
+}
+
+// validationFailure returns the ValidationError reporting the given errors, capped to maxErrors (0 for no cap).
+func validationFailure(ctx context.Context, errs cueerrors.Error, includes api.Includes, named api.NamedIncludes, layout IncludesLayout, paths Paths, maxErrors int, msg string) error {
+	detailer := cuerrors.FromContextOrEmpty(ctx)
+
+	fieldErrs := NewValidationErrors(errs, includes, named, layout, paths)
+	if maxErrors > 0 && len(fieldErrs) > maxErrors {
+		msg = fmt.Sprintf("%s (showing the first %d of %d errors, see the '%s' annotation)",
+			msg, maxErrors, len(fieldErrs), MaxValidationErrorsAnnotationKey)
+		fieldErrs = fieldErrs[:maxErrors]
+	}
+
+	validationErr := NewValidationError(detailer.ErrorWithDetails(fieldErrs.cueError(), "%s", msg), fieldErrs.Results())
+	validationErr.Errors = fieldErrs
+	return validationErr
+}
+
+// resourceIdentifierString returns a human-readable representation of the resource identifier.
+func resourceIdentifierString(ref *kyaml.ResourceIdentifier) string {
+	return strings.Join([]string{ref.APIVersion, ref.Kind, ref.Namespace, ref.Name}, "/")
+}
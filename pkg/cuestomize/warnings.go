@@ -0,0 +1,167 @@
+package cuestomize
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"cuelang.org/go/cue"
+	cueerrors "cuelang.org/go/cue/errors"
+	"github.com/Workday/cuestomize/api"
+	"github.com/Workday/cuestomize/pkg/cuerrors"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/kustomize/kyaml/fn/framework"
+)
+
+const (
+	// WarningsAsErrorsAnnotationKey is the annotation key that promotes the warnings of the CUE model to errors.
+	WarningsAsErrorsAnnotationKey = "config.cuestomize.io/warnings-as-errors"
+	// WarningsAsErrorsAnnotationValue is the value of the annotation that promotes the warnings of the CUE model to errors.
+	WarningsAsErrorsAnnotationValue = "true"
+
+	// SeverityAttribute is the name of the CUE field attribute used to configure the severity of the errors of a field.
+	// Errors of fields marked with @cuestomize(severity=warning), or of their children, are reported as warnings.
+	SeverityAttribute = "cuestomize"
+	// SeverityAttributeKey is the key of the SeverityAttribute holding the severity.
+	SeverityAttributeKey = "severity"
+)
+
+// ShouldPromoteWarnings checks if the KRMInput configuration has the warnings-as-errors annotation set.
+func ShouldPromoteWarnings(config *api.KRMInput) bool {
+	return config.Annotations != nil &&
+		config.Annotations[WarningsAsErrorsAnnotationKey] == WarningsAsErrorsAnnotationValue
+}
+
+// ProcessWarnings collects the warnings found in the unified CUE instance.
+// Each warning can either be a string, or a struct with the same shape as a KRM function result (message, resourceRef, field, ...).
+// Warnings are optional: if the warnings path does not exist in the unified instance, no warning is returned.
+func ProcessWarnings(ctx context.Context, unified cue.Value, paths Paths) (framework.Results, error) {
+	detailer := cuerrors.FromContextOrEmpty(ctx)
+
+	warningsValue := unified.LookupPath(cue.ParsePath(paths.Warnings))
+	if !warningsValue.Exists() {
+		return nil, nil
+	} else if warningsValue.Err() != nil {
+		return nil, detailer.ErrorWithDetails(warningsValue.Err(), "failed to lookup '%s' in unified CUE instance", paths.Warnings)
+	}
+	warningsIter, err := getIter(warningsValue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get iterator over '%s' in unified CUE instance: %v", paths.Warnings, err)
+	}
+
+	var warnings framework.Results
+	for warningsIter.Next() {
+		warning, err := decodeWarning(warningsIter.Value())
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode warning '%s': %w", warningsIter.Selector(), err)
+		}
+		warnings = append(warnings, warning)
+	}
+	return warnings, nil
+}
+
+// decodeWarning decodes a warning from its CUE value, which can either be a string or a KRM function result.
+func decodeWarning(value cue.Value) (*framework.Result, error) {
+	warning := &framework.Result{}
+	if value.Kind() == cue.StringKind {
+		message, err := value.String()
+		if err != nil {
+			return nil, err
+		}
+		warning.Message = message
+	} else {
+		asBytes, err := value.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal warning as JSON: %w", err)
+		}
+		if err := json.Unmarshal(asBytes, warning); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal warning: %w", err)
+		}
+	}
+	warning.Severity = framework.Warning
+	return warning, nil
+}
+
+// splitWarnings splits the given CUE error into the errors of fields marked with the warning severity attribute
+// (or whose parents are), and the other ones. Either of the returned errors can be nil.
+func splitWarnings(unified cue.Value, err error) (warnings, errs cueerrors.Error) {
+	for _, e := range cueerrors.Errors(err) {
+		if hasWarningSeverity(unified, e.Path()) {
+			warnings = cueerrors.Append(warnings, e)
+		} else {
+			errs = cueerrors.Append(errs, e)
+		}
+	}
+	return warnings, errs
+}
+
+// appendNewErrors appends to errs the errors of newErrs not already in errs.
+func appendNewErrors(errs, newErrs cueerrors.Error) cueerrors.Error {
+	seen := make(map[string]bool)
+	for _, e := range cueerrors.Errors(errs) {
+		seen[e.Error()] = true
+	}
+	for _, e := range cueerrors.Errors(newErrs) {
+		if !seen[e.Error()] {
+			errs = cueerrors.Append(errs, e)
+		}
+	}
+	return errs
+}
+
+// hasWarningSeverity checks if the field at the given path, or any of its parents, is marked with the warning severity attribute.
+func hasWarningSeverity(unified cue.Value, path []string) bool {
+	selectors := make([]cue.Selector, 0, len(path))
+	for _, elem := range path {
+		selectors = append(selectors, pathSelector(elem))
+
+		attr := unified.LookupPath(cue.MakePath(selectors...)).Attribute(SeverityAttribute)
+		if attr.Err() != nil {
+			continue
+		}
+		if severity, found, _ := attr.Lookup(0, SeverityAttributeKey); found && severity == string(framework.Warning) {
+			return true
+		}
+	}
+	return false
+}
+
+// pathSelector converts an element of a CUE error path into a selector.
+func pathSelector(elem string) cue.Selector {
+	if idx, err := strconv.Atoi(elem); err == nil {
+		return cue.Index(idx)
+	}
+	if s, err := strconv.Unquote(elem); err == nil {
+		return cue.Str(s)
+	}
+	if selectors := cue.ParsePath(elem).Selectors(); len(selectors) > 0 {
+		return selectors[0]
+	}
+	return cue.Str(elem)
+}
+
+// reportWarnings logs the warnings, and either appends them to the results or, if the KRMInput configuration
+// promotes warnings to errors, returns them as a ValidationError.
+func reportWarnings(ctx context.Context, warnings framework.Results, config *api.KRMInput, results *framework.Results) error {
+	log := logr.FromContextOrDiscard(ctx)
+
+	if len(warnings) == 0 {
+		return nil
+	}
+	for _, warning := range warnings {
+		log.V(-1).Info("CUE model reported a warning", "warning", warning.String())
+	}
+
+	if ShouldPromoteWarnings(config) {
+		for _, warning := range warnings {
+			warning.Severity = framework.Error
+		}
+		return NewValidationError(fmt.Errorf("CUE model reported %d warnings, promoted to errors by the '%s' annotation",
+			len(warnings), WarningsAsErrorsAnnotationKey), warnings)
+	}
+	if results != nil {
+		*results = append(*results, warnings...)
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+// Package k8sschema bundles the CUE definitions of the core Kubernetes API, generated from k8s.io/api,
+// and provides them to CUE models as a load overlay, so that they can be imported without being vendored.
+package k8sschema
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"cuelang.org/go/cue/load"
+)
+
+//go:generate ../../hack/generate-k8s-schemas.sh ./schemas
+
+const (
+	// schemasDir is the directory of the embedded schemas, holding one directory per Kubernetes minor version,
+	// laid out like the cue.mod/gen directory of a CUE module.
+	schemasDir = "schemas"
+	// moduleDir is the directory marking the root of a CUE module.
+	moduleDir = "cue.mod"
+)
+
+var (
+	//go:embed schemas
+	schemas embed.FS
+
+	// schemaFiles caches the embedded schema files by Kubernetes minor version, so that models evaluated many times
+	// (e.g. once for each forEach item) do not read them again.
+	schemaFiles sync.Map
+
+	// vendorDirs are the directories of a CUE module where imported packages can be vendored.
+	// A package vendored by the module takes precedence over the bundled one.
+	vendorDirs = []string{"gen", "pkg", "usr"}
+)
+
+// Versions returns the Kubernetes minor versions whose schemas are bundled, e.g. "1.36", oldest first.
+func Versions() []string {
+	entries, err := schemas.ReadDir(schemasDir)
+	if err != nil {
+		return nil
+	}
+	versions := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			versions = append(versions, entry.Name())
+		}
+	}
+	slices.SortFunc(versions, compareVersions)
+	return versions
+}
+
+// LatestVersion returns the most recent Kubernetes minor version whose schemas are bundled.
+func LatestVersion() string {
+	versions := Versions()
+	if len(versions) == 0 {
+		return ""
+	}
+	return versions[len(versions)-1]
+}
+
+// Packages returns the import paths of the CUE packages bundled for the Kubernetes minor version, sorted.
+func Packages(version string) ([]string, error) {
+	root, err := versionDir(version)
+	if err != nil {
+		return nil, err
+	}
+	var packages []string
+	err = fs.WalkDir(schemas, root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && path.Ext(p) == ".cue" {
+			pkg := strings.TrimPrefix(path.Dir(p), root+"/")
+			if !slices.Contains(packages, pkg) {
+				packages = append(packages, pkg)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Kubernetes %s schemas: %w", version, err)
+	}
+	slices.Sort(packages)
+	return packages, nil
+}
+
+// Overlay returns the load overlay adding the schemas of the Kubernetes minor version to the CUE module
+// the directory belongs to, as if they were in its cue.mod/gen directory.
+// Packages the module vendors itself are left out, as well as all of them if the directory is not within a CUE module.
+// The embedded schemas of each version are only read once per process.
+func Overlay(dir, version string) (map[string]load.Source, error) {
+	files, err := versionFiles(version)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleRoot, err := findModuleRoot(dir)
+	if err != nil {
+		return nil, err
+	}
+	if moduleRoot == "" {
+		return nil, nil
+	}
+
+	overlay := make(map[string]load.Source, len(files))
+	for _, file := range files {
+		if isVendored(moduleRoot, path.Dir(file.path)) {
+			continue
+		}
+		overlay[filepath.Join(moduleRoot, moduleDir, "gen", filepath.FromSlash(file.path))] = load.FromBytes(file.content)
+	}
+	return overlay, nil
+}
+
+// schemaFile is a file of the embedded schemas of a Kubernetes minor version.
+type schemaFile struct {
+	// path is the path of the file, relative to the directory of its version, e.g. "k8s.io/api/core/v1/types_go_gen.cue".
+	path    string
+	content []byte
+}
+
+// versionFiles returns the files of the embedded schemas of the Kubernetes minor version.
+// They are read once per version, so the cache is bounded by the number of bundled versions.
+func versionFiles(version string) ([]schemaFile, error) {
+	if cached, ok := schemaFiles.Load(version); ok {
+		return cached.([]schemaFile), nil
+	}
+
+	root, err := versionDir(version)
+	if err != nil {
+		return nil, err
+	}
+	var files []schemaFile
+	err = fs.WalkDir(schemas, root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || path.Ext(p) != ".cue" {
+			return nil
+		}
+		content, err := schemas.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		files = append(files, schemaFile{path: strings.TrimPrefix(p, root+"/"), content: content})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Kubernetes %s schemas: %w", version, err)
+	}
+	schemaFiles.Store(version, files)
+	return files, nil
+}
+
+// versionDir returns the directory of the embedded schemas of the Kubernetes minor version.
+func versionDir(version string) (string, error) {
+	versions := Versions()
+	if !slices.Contains(versions, version) {
+		return "", fmt.Errorf("no schemas bundled for Kubernetes %s, available versions: %s", version, strings.Join(versions, ", "))
+	}
+	return path.Join(schemasDir, version), nil
+}
+
+// findModuleRoot returns the closest directory containing a cue.mod directory, starting from dir and
+// walking up, or an empty string if there is none.
+func findModuleRoot(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve CUE model directory '%s': %w", dir, err)
+	}
+	for {
+		if info, err := os.Stat(filepath.Join(abs, moduleDir)); err == nil && info.IsDir() {
+			return abs, nil
+		}
+		parent := filepath.Dir(abs)
+		if parent == abs {
+			return "", nil
+		}
+		abs = parent
+	}
+}
+
+// isVendored checks if the module at the root vendors the package with the import path.
+func isVendored(moduleRoot, pkg string) bool {
+	for _, vendorDir := range vendorDirs {
+		if _, err := os.Stat(filepath.Join(moduleRoot, moduleDir, vendorDir, filepath.FromSlash(pkg))); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// compareVersions compares the Kubernetes minor versions numerically.
+func compareVersions(a, b string) int {
+	aMajor, aMinor := splitVersion(a)
+	bMajor, bMinor := splitVersion(b)
+	if aMajor != bMajor {
+		return aMajor - bMajor
+	}
+	return aMinor - bMinor
+}
+
+// splitVersion returns the major and minor numbers of the Kubernetes minor version, 0 if not numeric.
+func splitVersion(version string) (int, int) {
+	majorStr, minorStr, _ := strings.Cut(version, ".")
+	major, _ := strconv.Atoi(majorStr)
+	minor, _ := strconv.Atoi(minorStr)
+	return major, minor
+}
@@ -0,0 +1,87 @@
+package k8sschema
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersions(t *testing.T) {
+	versions := Versions()
+	require.NotEmpty(t, versions)
+	assert.Equal(t, versions[len(versions)-1], LatestVersion())
+
+	packages, err := Packages(LatestVersion())
+	require.NoError(t, err)
+	assert.Contains(t, packages, "k8s.io/api/core/v1")
+	assert.Contains(t, packages, "k8s.io/api/apps/v1")
+	assert.Contains(t, packages, "k8s.io/apimachinery/pkg/apis/meta/v1")
+}
+
+func TestCompareVersions(t *testing.T) {
+	assert.Negative(t, compareVersions("1.9", "1.10"))
+	assert.Positive(t, compareVersions("1.36", "1.35"))
+	assert.Zero(t, compareVersions("1.36", "1.36"))
+}
+
+func TestOverlay(t *testing.T) {
+	version := LatestVersion()
+
+	t.Run("unknown version", func(t *testing.T) {
+		_, err := Overlay(t.TempDir(), "1.0")
+		require.ErrorContains(t, err, "no schemas bundled for Kubernetes 1.0")
+	})
+
+	t.Run("not within a CUE module", func(t *testing.T) {
+		overlay, err := Overlay(t.TempDir(), version)
+		require.NoError(t, err)
+		assert.Empty(t, overlay)
+	})
+
+	t.Run("within a CUE module", func(t *testing.T) {
+		root := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(root, "cue.mod"), 0o755))
+		dir := filepath.Join(root, "pkg", "model")
+		require.NoError(t, os.MkdirAll(dir, 0o755))
+
+		overlay, err := Overlay(dir, version)
+		require.NoError(t, err)
+		require.NotEmpty(t, overlay)
+		for path := range overlay {
+			assert.True(t, strings.HasPrefix(path, filepath.Join(root, "cue.mod", "gen", "k8s.io")), path)
+		}
+	})
+
+	t.Run("cached schemas are not modified through the overlay", func(t *testing.T) {
+		root := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(root, "cue.mod"), 0o755))
+
+		first, err := Overlay(root, version)
+		require.NoError(t, err)
+		require.NotEmpty(t, first)
+		size := len(first)
+		clear(first)
+
+		second, err := Overlay(filepath.Join(root, "cue.mod"), version)
+		require.NoError(t, err)
+		assert.Len(t, second, size, "the cached schemas should not be modified through the returned map")
+	})
+
+	for _, vendorDir := range []string{"gen", "pkg", "usr"} {
+		t.Run("packages vendored in "+vendorDir+" take precedence", func(t *testing.T) {
+			root := t.TempDir()
+			require.NoError(t, os.MkdirAll(filepath.Join(root, "cue.mod", vendorDir, "k8s.io", "api", "core", "v1"), 0o755))
+
+			overlay, err := Overlay(root, version)
+			require.NoError(t, err)
+			require.NotEmpty(t, overlay)
+			for path := range overlay {
+				assert.NotEqual(t, filepath.Join(root, "cue.mod", "gen", "k8s.io", "api", "core", "v1"), filepath.Dir(path))
+			}
+		})
+	}
+}
@@ -0,0 +1,9176 @@
+// Code generated by cue get go. DO NOT EDIT.
+
+//cue:generate cue get go k8s.io/api/core/v1
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	resource_9 "k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// NamespaceDefault means the object is in the default namespace which is applied when not specified by clients
+#NamespaceDefault: "default"
+
+// NamespaceAll is the default argument to specify on a context when you want to list or filter resources across all namespaces
+#NamespaceAll: ""
+
+// NamespaceNodeLease is the namespace where we place node lease objects (used for node heartbeats)
+#NamespaceNodeLease: "kube-node-lease"
+
+// Volume represents a named volume in a pod that may be accessed by any container in the pod.
+#Volume: {
+	// name of the volume.
+	// Must be a DNS_LABEL and unique within the pod.
+	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+	name: string @go(Name) @protobuf(1,bytes,opt)
+
+	#VolumeSource
+}
+
+// Represents the source of a volume to mount.
+// Only one of its members may be specified.
+#VolumeSource: {
+	// hostPath represents a pre-existing file or directory on the host
+	// machine that is directly exposed to the container. This is generally
+	// used for system agents or other privileged things that are allowed
+	// to see the host machine. Most containers will NOT need this.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#hostpath
+	// ---
+	// TODO(jonesdl) We need to restrict who can use host directory mounts and who can/can not
+	// mount host directories as read/write.
+	// +optional
+	hostPath?: #HostPathVolumeSource @go(HostPath,*HostPathVolumeSource) @protobuf(1,bytes,opt)
+
+	// emptyDir represents a temporary directory that shares a pod's lifetime.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#emptydir
+	// +optional
+	emptyDir?: #EmptyDirVolumeSource @go(EmptyDir,*EmptyDirVolumeSource) @protobuf(2,bytes,opt)
+
+	// gcePersistentDisk represents a GCE Disk resource that is attached to a
+	// kubelet's host machine and then exposed to the pod.
+	// Deprecated: GCEPersistentDisk is deprecated. All operations for the in-tree
+	// gcePersistentDisk type are redirected to the pd.csi.storage.gke.io CSI driver.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk
+	// +optional
+	gcePersistentDisk?: #GCEPersistentDiskVolumeSource @go(GCEPersistentDisk,*GCEPersistentDiskVolumeSource) @protobuf(3,bytes,opt)
+
+	// awsElasticBlockStore represents an AWS Disk resource that is attached to a
+	// kubelet's host machine and then exposed to the pod.
+	// Deprecated: AWSElasticBlockStore is deprecated. All operations for the in-tree
+	// awsElasticBlockStore type are redirected to the ebs.csi.aws.com CSI driver.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore
+	// +optional
+	awsElasticBlockStore?: #AWSElasticBlockStoreVolumeSource @go(AWSElasticBlockStore,*AWSElasticBlockStoreVolumeSource) @protobuf(4,bytes,opt)
+
+	// gitRepo represents a git repository at a particular revision.
+	// Deprecated: GitRepo is deprecated. To provision a container with a git repo, mount an
+	// EmptyDir into an InitContainer that clones the repo using git, then mount the EmptyDir
+	// into the Pod's container.
+	// +optional
+	gitRepo?: #GitRepoVolumeSource @go(GitRepo,*GitRepoVolumeSource) @protobuf(5,bytes,opt)
+
+	// secret represents a secret that should populate this volume.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#secret
+	// +optional
+	secret?: #SecretVolumeSource @go(Secret,*SecretVolumeSource) @protobuf(6,bytes,opt)
+
+	// nfs represents an NFS mount on the host that shares a pod's lifetime
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#nfs
+	// +optional
+	nfs?: #NFSVolumeSource @go(NFS,*NFSVolumeSource) @protobuf(7,bytes,opt)
+
+	// iscsi represents an ISCSI Disk resource that is attached to a
+	// kubelet's host machine and then exposed to the pod.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes/#iscsi
+	// +optional
+	iscsi?: #ISCSIVolumeSource @go(ISCSI,*ISCSIVolumeSource) @protobuf(8,bytes,opt)
+
+	// glusterfs represents a Glusterfs mount on the host that shares a pod's lifetime.
+	// Deprecated: Glusterfs is deprecated and the in-tree glusterfs type is no longer supported.
+	// +optional
+	glusterfs?: #GlusterfsVolumeSource @go(Glusterfs,*GlusterfsVolumeSource) @protobuf(9,bytes,opt)
+
+	// persistentVolumeClaimVolumeSource represents a reference to a
+	// PersistentVolumeClaim in the same namespace.
+	// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+	// +optional
+	persistentVolumeClaim?: #PersistentVolumeClaimVolumeSource @go(PersistentVolumeClaim,*PersistentVolumeClaimVolumeSource) @protobuf(10,bytes,opt)
+
+	// rbd represents a Rados Block Device mount on the host that shares a pod's lifetime.
+	// Deprecated: RBD is deprecated and the in-tree rbd type is no longer supported.
+	// +optional
+	rbd?: #RBDVolumeSource @go(RBD,*RBDVolumeSource) @protobuf(11,bytes,opt)
+
+	// flexVolume represents a generic volume resource that is
+	// provisioned/attached using an exec based plugin.
+	// Deprecated: FlexVolume is deprecated. Consider using a CSIDriver instead.
+	// +optional
+	flexVolume?: #FlexVolumeSource @go(FlexVolume,*FlexVolumeSource) @protobuf(12,bytes,opt)
+
+	// cinder represents a cinder volume attached and mounted on kubelets host machine.
+	// Deprecated: Cinder is deprecated. All operations for the in-tree cinder type
+	// are redirected to the cinder.csi.openstack.org CSI driver.
+	// More info: https://examples.k8s.io/mysql-cinder-pd/README.md
+	// +optional
+	cinder?: #CinderVolumeSource @go(Cinder,*CinderVolumeSource) @protobuf(13,bytes,opt)
+
+	// cephFS represents a Ceph FS mount on the host that shares a pod's lifetime.
+	// Deprecated: CephFS is deprecated and the in-tree cephfs type is no longer supported.
+	// +optional
+	cephfs?: #CephFSVolumeSource @go(CephFS,*CephFSVolumeSource) @protobuf(14,bytes,opt)
+
+	// flocker represents a Flocker volume attached to a kubelet's host machine. This depends on the Flocker control service being running.
+	// Deprecated: Flocker is deprecated and the in-tree flocker type is no longer supported.
+	// +optional
+	flocker?: #FlockerVolumeSource @go(Flocker,*FlockerVolumeSource) @protobuf(15,bytes,opt)
+
+	// downwardAPI represents downward API about the pod that should populate this volume
+	// +optional
+	downwardAPI?: #DownwardAPIVolumeSource @go(DownwardAPI,*DownwardAPIVolumeSource) @protobuf(16,bytes,opt)
+
+	// fc represents a Fibre Channel resource that is attached to a kubelet's host machine and then exposed to the pod.
+	// +optional
+	fc?: #FCVolumeSource @go(FC,*FCVolumeSource) @protobuf(17,bytes,opt)
+
+	// azureFile represents an Azure File Service mount on the host and bind mount to the pod.
+	// Deprecated: AzureFile is deprecated. All operations for the in-tree azureFile type
+	// are redirected to the file.csi.azure.com CSI driver.
+	// +optional
+	azureFile?: #AzureFileVolumeSource @go(AzureFile,*AzureFileVolumeSource) @protobuf(18,bytes,opt)
+
+	// configMap represents a configMap that should populate this volume
+	// +optional
+	configMap?: #ConfigMapVolumeSource @go(ConfigMap,*ConfigMapVolumeSource) @protobuf(19,bytes,opt)
+
+	// vsphereVolume represents a vSphere volume attached and mounted on kubelets host machine.
+	// Deprecated: VsphereVolume is deprecated. All operations for the in-tree vsphereVolume type
+	// are redirected to the csi.vsphere.vmware.com CSI driver.
+	// +optional
+	vsphereVolume?: #VsphereVirtualDiskVolumeSource @go(VsphereVolume,*VsphereVirtualDiskVolumeSource) @protobuf(20,bytes,opt)
+
+	// quobyte represents a Quobyte mount on the host that shares a pod's lifetime.
+	// Deprecated: Quobyte is deprecated and the in-tree quobyte type is no longer supported.
+	// +optional
+	quobyte?: #QuobyteVolumeSource @go(Quobyte,*QuobyteVolumeSource) @protobuf(21,bytes,opt)
+
+	// azureDisk represents an Azure Data Disk mount on the host and bind mount to the pod.
+	// Deprecated: AzureDisk is deprecated. All operations for the in-tree azureDisk type
+	// are redirected to the disk.csi.azure.com CSI driver.
+	// +optional
+	azureDisk?: #AzureDiskVolumeSource @go(AzureDisk,*AzureDiskVolumeSource) @protobuf(22,bytes,opt)
+
+	// photonPersistentDisk represents a PhotonController persistent disk attached and mounted on kubelets host machine.
+	// Deprecated: PhotonPersistentDisk is deprecated and the in-tree photonPersistentDisk type is no longer supported.
+	photonPersistentDisk?: #PhotonPersistentDiskVolumeSource @go(PhotonPersistentDisk,*PhotonPersistentDiskVolumeSource) @protobuf(23,bytes,opt)
+
+	// projected items for all in one resources secrets, configmaps, and downward API
+	projected?: #ProjectedVolumeSource @go(Projected,*ProjectedVolumeSource) @protobuf(26,bytes,opt)
+
+	// portworxVolume represents a portworx volume attached and mounted on kubelets host machine.
+	// Deprecated: PortworxVolume is deprecated. All operations for the in-tree portworxVolume type
+	// are redirected to the pxd.portworx.com CSI driver.
+	// +optional
+	portworxVolume?: #PortworxVolumeSource @go(PortworxVolume,*PortworxVolumeSource) @protobuf(24,bytes,opt)
+
+	// scaleIO represents a ScaleIO persistent volume attached and mounted on Kubernetes nodes.
+	// Deprecated: ScaleIO is deprecated and the in-tree scaleIO type is no longer supported.
+	// +optional
+	scaleIO?: #ScaleIOVolumeSource @go(ScaleIO,*ScaleIOVolumeSource) @protobuf(25,bytes,opt)
+
+	// storageOS represents a StorageOS volume attached and mounted on Kubernetes nodes.
+	// Deprecated: StorageOS is deprecated and the in-tree storageos type is no longer supported.
+	// +optional
+	storageos?: #StorageOSVolumeSource @go(StorageOS,*StorageOSVolumeSource) @protobuf(27,bytes,opt)
+
+	// csi (Container Storage Interface) represents ephemeral storage that is handled by certain external CSI drivers.
+	// +optional
+	csi?: #CSIVolumeSource @go(CSI,*CSIVolumeSource) @protobuf(28,bytes,opt)
+
+	// ephemeral represents a volume that is handled by a cluster storage driver.
+	// The volume's lifecycle is tied to the pod that defines it - it will be created before the pod starts,
+	// and deleted when the pod is removed.
+	//
+	// Use this if:
+	// a) the volume is only needed while the pod runs,
+	// b) features of normal volumes like restoring from snapshot or capacity
+	//    tracking are needed,
+	// c) the storage driver is specified through a storage class, and
+	// d) the storage driver supports dynamic volume provisioning through
+	//    a PersistentVolumeClaim (see EphemeralVolumeSource for more
+	//    information on the connection between this volume type
+	//    and PersistentVolumeClaim).
+	//
+	// Use PersistentVolumeClaim or one of the vendor-specific
+	// APIs for volumes that persist for longer than the lifecycle
+	// of an individual pod.
+	//
+	// Use CSI for light-weight local ephemeral volumes if the CSI driver is meant to
+	// be used that way - see the documentation of the driver for
+	// more information.
+	//
+	// A pod can use both types of ephemeral volumes and
+	// persistent volumes at the same time.
+	//
+	// +optional
+	ephemeral?: #EphemeralVolumeSource @go(Ephemeral,*EphemeralVolumeSource) @protobuf(29,bytes,opt)
+
+	// image represents an OCI object (a container image or artifact) pulled and mounted on the kubelet's host machine.
+	// The volume is resolved at pod startup depending on which PullPolicy value is provided:
+	//
+	// - Always: the kubelet always attempts to pull the reference. Container creation will fail If the pull fails.
+	// - Never: the kubelet never pulls the reference and only uses a local image or artifact. Container creation will fail if the reference isn't present.
+	// - IfNotPresent: the kubelet pulls if the reference isn't already present on disk. Container creation will fail if the reference isn't present and the pull fails.
+	//
+	// The volume gets re-resolved if the pod gets deleted and recreated, which means that new remote content will become available on pod recreation.
+	// A failure to resolve or pull the image during pod startup will block containers from starting and may add significant latency. Failures will be retried using normal volume backoff and will be reported on the pod reason and message.
+	// The types of objects that may be mounted by this volume are defined by the container runtime implementation on a host machine and at minimum must include all valid types supported by the container image field.
+	// The OCI object gets mounted in a single directory (spec.containers[*].volumeMounts.mountPath) by merging the manifest layers in the same way as for container images.
+	// The volume will be mounted read-only (ro).
+	// Sub path mounts for containers are not supported (spec.containers[*].volumeMounts.subpath) before 1.33.
+	// The field spec.securityContext.fsGroupChangePolicy has no effect on this volume type.
+	// +optional
+	image?: #ImageVolumeSource @go(Image,*ImageVolumeSource) @protobuf(30,bytes,opt)
+}
+
+// PersistentVolumeClaimVolumeSource references the user's PVC in the same namespace.
+// This volume finds the bound PV and mounts that volume for the pod. A
+// PersistentVolumeClaimVolumeSource is, essentially, a wrapper around another
+// type of volume that is owned by someone else (the system).
+#PersistentVolumeClaimVolumeSource: {
+	// claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
+	// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+	claimName: string @go(ClaimName) @protobuf(1,bytes,opt)
+
+	// readOnly Will force the ReadOnly setting in VolumeMounts.
+	// Default false.
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(2,varint,opt)
+}
+
+// PersistentVolumeSource is similar to VolumeSource but meant for the
+// administrator who creates PVs. Exactly one of its members must be set.
+#PersistentVolumeSource: {
+	// gcePersistentDisk represents a GCE Disk resource that is attached to a
+	// kubelet's host machine and then exposed to the pod. Provisioned by an admin.
+	// Deprecated: GCEPersistentDisk is deprecated. All operations for the in-tree
+	// gcePersistentDisk type are redirected to the pd.csi.storage.gke.io CSI driver.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk
+	// +optional
+	gcePersistentDisk?: #GCEPersistentDiskVolumeSource @go(GCEPersistentDisk,*GCEPersistentDiskVolumeSource) @protobuf(1,bytes,opt)
+
+	// awsElasticBlockStore represents an AWS Disk resource that is attached to a
+	// kubelet's host machine and then exposed to the pod.
+	// Deprecated: AWSElasticBlockStore is deprecated. All operations for the in-tree
+	// awsElasticBlockStore type are redirected to the ebs.csi.aws.com CSI driver.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore
+	// +optional
+	awsElasticBlockStore?: #AWSElasticBlockStoreVolumeSource @go(AWSElasticBlockStore,*AWSElasticBlockStoreVolumeSource) @protobuf(2,bytes,opt)
+
+	// hostPath represents a directory on the host.
+	// Provisioned by a developer or tester.
+	// This is useful for single-node development and testing only!
+	// On-host storage is not supported in any way and WILL NOT WORK in a multi-node cluster.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#hostpath
+	// +optional
+	hostPath?: #HostPathVolumeSource @go(HostPath,*HostPathVolumeSource) @protobuf(3,bytes,opt)
+
+	// glusterfs represents a Glusterfs volume that is attached to a host and
+	// exposed to the pod. Provisioned by an admin.
+	// Deprecated: Glusterfs is deprecated and the in-tree glusterfs type is no longer supported.
+	// More info: https://examples.k8s.io/volumes/glusterfs/README.md
+	// +optional
+	glusterfs?: #GlusterfsPersistentVolumeSource @go(Glusterfs,*GlusterfsPersistentVolumeSource) @protobuf(4,bytes,opt)
+
+	// nfs represents an NFS mount on the host. Provisioned by an admin.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#nfs
+	// +optional
+	nfs?: #NFSVolumeSource @go(NFS,*NFSVolumeSource) @protobuf(5,bytes,opt)
+
+	// rbd represents a Rados Block Device mount on the host that shares a pod's lifetime.
+	// Deprecated: RBD is deprecated and the in-tree rbd type is no longer supported.
+	// More info: https://examples.k8s.io/volumes/rbd/README.md
+	// +optional
+	rbd?: #RBDPersistentVolumeSource @go(RBD,*RBDPersistentVolumeSource) @protobuf(6,bytes,opt)
+
+	// iscsi represents an ISCSI Disk resource that is attached to a
+	// kubelet's host machine and then exposed to the pod. Provisioned by an admin.
+	// +optional
+	iscsi?: #ISCSIPersistentVolumeSource @go(ISCSI,*ISCSIPersistentVolumeSource) @protobuf(7,bytes,opt)
+
+	// cinder represents a cinder volume attached and mounted on kubelets host machine.
+	// Deprecated: Cinder is deprecated. All operations for the in-tree cinder type
+	// are redirected to the cinder.csi.openstack.org CSI driver.
+	// More info: https://examples.k8s.io/mysql-cinder-pd/README.md
+	// +optional
+	cinder?: #CinderPersistentVolumeSource @go(Cinder,*CinderPersistentVolumeSource) @protobuf(8,bytes,opt)
+
+	// cephFS represents a Ceph FS mount on the host that shares a pod's lifetime.
+	// Deprecated: CephFS is deprecated and the in-tree cephfs type is no longer supported.
+	// +optional
+	cephfs?: #CephFSPersistentVolumeSource @go(CephFS,*CephFSPersistentVolumeSource) @protobuf(9,bytes,opt)
+
+	// fc represents a Fibre Channel resource that is attached to a kubelet's host machine and then exposed to the pod.
+	// +optional
+	fc?: #FCVolumeSource @go(FC,*FCVolumeSource) @protobuf(10,bytes,opt)
+
+	// flocker represents a Flocker volume attached to a kubelet's host machine and exposed to the pod for its usage. This depends on the Flocker control service being running.
+	// Deprecated: Flocker is deprecated and the in-tree flocker type is no longer supported.
+	// +optional
+	flocker?: #FlockerVolumeSource @go(Flocker,*FlockerVolumeSource) @protobuf(11,bytes,opt)
+
+	// flexVolume represents a generic volume resource that is
+	// provisioned/attached using an exec based plugin.
+	// Deprecated: FlexVolume is deprecated. Consider using a CSIDriver instead.
+	// +optional
+	flexVolume?: #FlexPersistentVolumeSource @go(FlexVolume,*FlexPersistentVolumeSource) @protobuf(12,bytes,opt)
+
+	// azureFile represents an Azure File Service mount on the host and bind mount to the pod.
+	// Deprecated: AzureFile is deprecated. All operations for the in-tree azureFile type
+	// are redirected to the file.csi.azure.com CSI driver.
+	// +optional
+	azureFile?: #AzureFilePersistentVolumeSource @go(AzureFile,*AzureFilePersistentVolumeSource) @protobuf(13,bytes,opt)
+
+	// vsphereVolume represents a vSphere volume attached and mounted on kubelets host machine.
+	// Deprecated: VsphereVolume is deprecated. All operations for the in-tree vsphereVolume type
+	// are redirected to the csi.vsphere.vmware.com CSI driver.
+	// +optional
+	vsphereVolume?: #VsphereVirtualDiskVolumeSource @go(VsphereVolume,*VsphereVirtualDiskVolumeSource) @protobuf(14,bytes,opt)
+
+	// quobyte represents a Quobyte mount on the host that shares a pod's lifetime.
+	// Deprecated: Quobyte is deprecated and the in-tree quobyte type is no longer supported.
+	// +optional
+	quobyte?: #QuobyteVolumeSource @go(Quobyte,*QuobyteVolumeSource) @protobuf(15,bytes,opt)
+
+	// azureDisk represents an Azure Data Disk mount on the host and bind mount to the pod.
+	// Deprecated: AzureDisk is deprecated. All operations for the in-tree azureDisk type
+	// are redirected to the disk.csi.azure.com CSI driver.
+	// +optional
+	azureDisk?: #AzureDiskVolumeSource @go(AzureDisk,*AzureDiskVolumeSource) @protobuf(16,bytes,opt)
+
+	// photonPersistentDisk represents a PhotonController persistent disk attached and mounted on kubelets host machine.
+	// Deprecated: PhotonPersistentDisk is deprecated and the in-tree photonPersistentDisk type is no longer supported.
+	photonPersistentDisk?: #PhotonPersistentDiskVolumeSource @go(PhotonPersistentDisk,*PhotonPersistentDiskVolumeSource) @protobuf(17,bytes,opt)
+
+	// portworxVolume represents a portworx volume attached and mounted on kubelets host machine.
+	// Deprecated: PortworxVolume is deprecated. All operations for the in-tree portworxVolume type
+	// are redirected to the pxd.portworx.com CSI driver.
+	// +optional
+	portworxVolume?: #PortworxVolumeSource @go(PortworxVolume,*PortworxVolumeSource) @protobuf(18,bytes,opt)
+
+	// scaleIO represents a ScaleIO persistent volume attached and mounted on Kubernetes nodes.
+	// Deprecated: ScaleIO is deprecated and the in-tree scaleIO type is no longer supported.
+	// +optional
+	scaleIO?: #ScaleIOPersistentVolumeSource @go(ScaleIO,*ScaleIOPersistentVolumeSource) @protobuf(19,bytes,opt)
+
+	// local represents directly-attached storage with node affinity
+	// +optional
+	local?: #LocalVolumeSource @go(Local,*LocalVolumeSource) @protobuf(20,bytes,opt)
+
+	// storageOS represents a StorageOS volume that is attached to the kubelet's host machine and mounted into the pod.
+	// Deprecated: StorageOS is deprecated and the in-tree storageos type is no longer supported.
+	// More info: https://examples.k8s.io/volumes/storageos/README.md
+	// +optional
+	storageos?: #StorageOSPersistentVolumeSource @go(StorageOS,*StorageOSPersistentVolumeSource) @protobuf(21,bytes,opt)
+
+	// csi represents storage that is handled by an external CSI driver.
+	// +optional
+	csi?: #CSIPersistentVolumeSource @go(CSI,*CSIPersistentVolumeSource) @protobuf(22,bytes,opt)
+}
+
+// BetaStorageClassAnnotation represents the beta/previous StorageClass annotation.
+// It's currently still used and will be held for backwards compatibility
+#BetaStorageClassAnnotation: "volume.beta.kubernetes.io/storage-class"
+
+// MountOptionAnnotation defines mount option annotation used in PVs
+#MountOptionAnnotation: "volume.beta.kubernetes.io/mount-options"
+
+// PersistentVolume (PV) is a storage resource provisioned by an administrator.
+// It is analogous to a node.
+// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes
+#PersistentVolume: {
+	metav1.#TypeMeta
+
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metadata?: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// spec defines a specification of a persistent volume owned by the cluster.
+	// Provisioned by an administrator.
+	// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistent-volumes
+	// +optional
+	spec?: #PersistentVolumeSpec @go(Spec) @protobuf(2,bytes,opt)
+
+	// status represents the current information/status for the persistent volume.
+	// Populated by the system.
+	// Read-only.
+	// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistent-volumes
+	// +optional
+	status?: #PersistentVolumeStatus @go(Status) @protobuf(3,bytes,opt)
+}
+
+// PersistentVolumeSpec is the specification of a persistent volume.
+#PersistentVolumeSpec: {
+	// capacity is the description of the persistent volume's resources and capacity.
+	// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#capacity
+	// +optional
+	capacity?: #ResourceList @go(Capacity) @protobuf(1,bytes,rep,casttype=ResourceList,castkey=ResourceName)
+
+	#PersistentVolumeSource
+
+	// accessModes contains all ways the volume can be mounted.
+	// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes
+	// +optional
+	// +listType=atomic
+	accessModes?: [...#PersistentVolumeAccessMode] @go(AccessModes,[]PersistentVolumeAccessMode) @protobuf(3,bytes,rep,casttype=PersistentVolumeAccessMode)
+
+	// claimRef is part of a bi-directional binding between PersistentVolume and PersistentVolumeClaim.
+	// Expected to be non-nil when bound.
+	// claim.VolumeName is the authoritative bind between PV and PVC.
+	// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#binding
+	// +optional
+	// +structType=granular
+	claimRef?: #ObjectReference @go(ClaimRef,*ObjectReference) @protobuf(4,bytes,opt)
+
+	// persistentVolumeReclaimPolicy defines what happens to a persistent volume when released from its claim.
+	// Valid options are Retain (default for manually created PersistentVolumes), Delete (default
+	// for dynamically provisioned PersistentVolumes), and Recycle (deprecated).
+	// Recycle must be supported by the volume plugin underlying this PersistentVolume.
+	// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#reclaiming
+	// +optional
+	persistentVolumeReclaimPolicy?: #PersistentVolumeReclaimPolicy @go(PersistentVolumeReclaimPolicy) @protobuf(5,bytes,opt,casttype=PersistentVolumeReclaimPolicy)
+
+	// storageClassName is the name of StorageClass to which this persistent volume belongs. Empty value
+	// means that this volume does not belong to any StorageClass.
+	// +optional
+	storageClassName?: string @go(StorageClassName) @protobuf(6,bytes,opt)
+
+	// mountOptions is the list of mount options, e.g. ["ro", "soft"]. Not validated - mount will
+	// simply fail if one is invalid.
+	// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes/#mount-options
+	// +optional
+	// +listType=atomic
+	mountOptions?: [...string] @go(MountOptions,[]string) @protobuf(7,bytes,opt)
+
+	// volumeMode defines if a volume is intended to be used with a formatted filesystem
+	// or to remain in raw block state. Value of Filesystem is implied when not included in spec.
+	// +optional
+	volumeMode?: #PersistentVolumeMode @go(VolumeMode,*PersistentVolumeMode) @protobuf(8,bytes,opt,casttype=PersistentVolumeMode)
+
+	// nodeAffinity defines constraints that limit what nodes this volume can be accessed from.
+	// This field influences the scheduling of pods that use this volume.
+	// This field is mutable if MutablePVNodeAffinity feature gate is enabled.
+	// +optional
+	nodeAffinity?: #VolumeNodeAffinity @go(NodeAffinity,*VolumeNodeAffinity) @protobuf(9,bytes,opt)
+
+	// Name of VolumeAttributesClass to which this persistent volume belongs. Empty value
+	// is not allowed. When this field is not set, it indicates that this volume does not belong to any
+	// VolumeAttributesClass. This field is mutable and can be changed by the CSI driver
+	// after a volume has been updated successfully to a new class.
+	// For an unbound PersistentVolume, the volumeAttributesClassName will be matched with unbound
+	// PersistentVolumeClaims during the binding process.
+	// +featureGate=VolumeAttributesClass
+	// +optional
+	volumeAttributesClassName?: string @go(VolumeAttributesClassName,*string) @protobuf(10,bytes,opt)
+}
+
+// VolumeNodeAffinity defines constraints that limit what nodes this volume can be accessed from.
+#VolumeNodeAffinity: {
+	// required specifies hard node constraints that must be met.
+	required?: #NodeSelector @go(Required,*NodeSelector) @protobuf(1,bytes,opt)
+}
+
+// PersistentVolumeReclaimPolicy describes a policy for end-of-life maintenance of persistent volumes.
+// +enum
+#PersistentVolumeReclaimPolicy: string // #enumPersistentVolumeReclaimPolicy
+
+#enumPersistentVolumeReclaimPolicy:
+	#PersistentVolumeReclaimRecycle |
+	#PersistentVolumeReclaimDelete |
+	#PersistentVolumeReclaimRetain
+
+// PersistentVolumeReclaimRecycle means the volume will be recycled back into the pool of unbound persistent volumes on release from its claim.
+// The volume plugin must support Recycling.
+#PersistentVolumeReclaimRecycle: #PersistentVolumeReclaimPolicy & "Recycle"
+
+// PersistentVolumeReclaimDelete means the volume will be deleted from Kubernetes on release from its claim.
+// The volume plugin must support Deletion.
+#PersistentVolumeReclaimDelete: #PersistentVolumeReclaimPolicy & "Delete"
+
+// PersistentVolumeReclaimRetain means the volume will be left in its current phase (Released) for manual reclamation by the administrator.
+// The default policy is Retain.
+#PersistentVolumeReclaimRetain: #PersistentVolumeReclaimPolicy & "Retain"
+
+// PersistentVolumeMode describes how a volume is intended to be consumed, either Block or Filesystem.
+// +enum
+#PersistentVolumeMode: string // #enumPersistentVolumeMode
+
+#enumPersistentVolumeMode:
+	#PersistentVolumeBlock |
+	#PersistentVolumeFilesystem
+
+// PersistentVolumeBlock means the volume will not be formatted with a filesystem and will remain a raw block device.
+#PersistentVolumeBlock: #PersistentVolumeMode & "Block"
+
+// PersistentVolumeFilesystem means the volume will be or is formatted with a filesystem.
+#PersistentVolumeFilesystem: #PersistentVolumeMode & "Filesystem"
+
+// PersistentVolumeStatus is the current status of a persistent volume.
+#PersistentVolumeStatus: {
+	// phase indicates if a volume is available, bound to a claim, or released by a claim.
+	// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#phase
+	// +optional
+	phase?: #PersistentVolumePhase @go(Phase) @protobuf(1,bytes,opt,casttype=PersistentVolumePhase)
+
+	// message is a human-readable message indicating details about why the volume is in this state.
+	// +optional
+	message?: string @go(Message) @protobuf(2,bytes,opt)
+
+	// reason is a brief CamelCase string that describes any failure and is meant
+	// for machine parsing and tidy display in the CLI.
+	// +optional
+	reason?: string @go(Reason) @protobuf(3,bytes,opt)
+
+	// lastPhaseTransitionTime is the time the phase transitioned from one to another
+	// and automatically resets to current time everytime a volume phase transitions.
+	// +optional
+	lastPhaseTransitionTime?: metav1.#Time @go(LastPhaseTransitionTime,*metav1.Time) @protobuf(4,bytes,opt)
+}
+
+// PersistentVolumeList is a list of PersistentVolume items.
+#PersistentVolumeList: {
+	metav1.#TypeMeta
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metadata?: metav1.#ListMeta @go(ListMeta) @protobuf(1,bytes,opt)
+
+	// items is a list of persistent volumes.
+	// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes
+	items: [...#PersistentVolume] @go(Items,[]PersistentVolume) @protobuf(2,bytes,rep)
+}
+
+// PersistentVolumeClaim is a user's request for and claim to a persistent volume
+#PersistentVolumeClaim: {
+	metav1.#TypeMeta
+
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metadata?: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// spec defines the desired characteristics of a volume requested by a pod author.
+	// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+	// +optional
+	spec?: #PersistentVolumeClaimSpec @go(Spec) @protobuf(2,bytes,opt)
+
+	// status represents the current information/status of a persistent volume claim.
+	// Read-only.
+	// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+	// +optional
+	status?: #PersistentVolumeClaimStatus @go(Status) @protobuf(3,bytes,opt)
+}
+
+// PersistentVolumeClaimList is a list of PersistentVolumeClaim items.
+#PersistentVolumeClaimList: {
+	metav1.#TypeMeta
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metadata?: metav1.#ListMeta @go(ListMeta) @protobuf(1,bytes,opt)
+
+	// items is a list of persistent volume claims.
+	// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+	items: [...#PersistentVolumeClaim] @go(Items,[]PersistentVolumeClaim) @protobuf(2,bytes,rep)
+}
+
+// PersistentVolumeClaimSpec describes the common attributes of storage devices
+// and allows a Source for provider-specific attributes
+#PersistentVolumeClaimSpec: {
+	// accessModes contains the desired access modes the volume should have.
+	// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1
+	// +optional
+	// +listType=atomic
+	accessModes?: [...#PersistentVolumeAccessMode] @go(AccessModes,[]PersistentVolumeAccessMode) @protobuf(1,bytes,rep,casttype=PersistentVolumeAccessMode)
+
+	// selector is a label query over volumes to consider for binding.
+	// +optional
+	selector?: metav1.#LabelSelector @go(Selector,*metav1.LabelSelector) @protobuf(4,bytes,opt)
+
+	// resources represents the minimum resources the volume should have.
+	// Users are allowed to specify resource requirements
+	// that are lower than previous value but must still be higher than capacity recorded in the
+	// status field of the claim.
+	// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources
+	// +optional
+	resources?: #VolumeResourceRequirements @go(Resources) @protobuf(2,bytes,opt)
+
+	// volumeName is the binding reference to the PersistentVolume backing this claim.
+	// +optional
+	volumeName?: string @go(VolumeName) @protobuf(3,bytes,opt)
+
+	// storageClassName is the name of the StorageClass required by the claim.
+	// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#class-1
+	// +optional
+	storageClassName?: string @go(StorageClassName,*string) @protobuf(5,bytes,opt)
+
+	// volumeMode defines what type of volume is required by the claim.
+	// Value of Filesystem is implied when not included in claim spec.
+	// +optional
+	volumeMode?: #PersistentVolumeMode @go(VolumeMode,*PersistentVolumeMode) @protobuf(6,bytes,opt,casttype=PersistentVolumeMode)
+
+	// dataSource field can be used to specify either:
+	// * An existing VolumeSnapshot object (snapshot.storage.k8s.io/VolumeSnapshot)
+	// * An existing PVC (PersistentVolumeClaim)
+	// If the provisioner or an external controller can support the specified data source,
+	// it will create a new volume based on the contents of the specified data source.
+	// When the AnyVolumeDataSource feature gate is enabled, dataSource contents will be copied to dataSourceRef,
+	// and dataSourceRef contents will be copied to dataSource when dataSourceRef.namespace is not specified.
+	// If the namespace is specified, then dataSourceRef will not be copied to dataSource.
+	// +optional
+	dataSource?: #TypedLocalObjectReference @go(DataSource,*TypedLocalObjectReference) @protobuf(7,bytes,opt)
+
+	// dataSourceRef specifies the object from which to populate the volume with data, if a non-empty
+	// volume is desired. This may be any object from a non-empty API group (non
+	// core object) or a PersistentVolumeClaim object.
+	// When this field is specified, volume binding will only succeed if the type of
+	// the specified object matches some installed volume populator or dynamic
+	// provisioner.
+	// This field will replace the functionality of the dataSource field and as such
+	// if both fields are non-empty, they must have the same value. For backwards
+	// compatibility, when namespace isn't specified in dataSourceRef,
+	// both fields (dataSource and dataSourceRef) will be set to the same
+	// value automatically if one of them is empty and the other is non-empty.
+	// When namespace is specified in dataSourceRef,
+	// dataSource isn't set to the same value and must be empty.
+	// There are three important differences between dataSource and dataSourceRef:
+	// * While dataSource only allows two specific types of objects, dataSourceRef
+	//   allows any non-core object, as well as PersistentVolumeClaim objects.
+	// * While dataSource ignores disallowed values (dropping them), dataSourceRef
+	//   preserves all values, and generates an error if a disallowed value is
+	//   specified.
+	// * While dataSource only allows local objects, dataSourceRef allows objects
+	//   in any namespaces.
+	// (Beta) Using this field requires the AnyVolumeDataSource feature gate to be enabled.
+	// (Alpha) Using the namespace field of dataSourceRef requires the CrossNamespaceVolumeDataSource feature gate to be enabled.
+	// +optional
+	dataSourceRef?: #TypedObjectReference @go(DataSourceRef,*TypedObjectReference) @protobuf(8,bytes,opt)
+
+	// volumeAttributesClassName may be used to set the VolumeAttributesClass used by this claim.
+	// If specified, the CSI driver will create or update the volume with the attributes defined
+	// in the corresponding VolumeAttributesClass. This has a different purpose than storageClassName,
+	// it can be changed after the claim is created. An empty string or nil value indicates that no
+	// VolumeAttributesClass will be applied to the claim. If the claim enters an Infeasible error state,
+	// this field can be reset to its previous value (including nil) to cancel the modification.
+	// If the resource referred to by volumeAttributesClass does not exist, this PersistentVolumeClaim will be
+	// set to a Pending state, as reflected by the modifyVolumeStatus field, until such as a resource
+	// exists.
+	// More info: https://kubernetes.io/docs/concepts/storage/volume-attributes-classes/
+	// +featureGate=VolumeAttributesClass
+	// +optional
+	volumeAttributesClassName?: string @go(VolumeAttributesClassName,*string) @protobuf(9,bytes,opt)
+}
+
+// TypedObjectReference contains enough information to let you locate the typed referenced object
+#TypedObjectReference: {
+	// APIGroup is the group for the resource being referenced.
+	// If APIGroup is not specified, the specified Kind must be in the core API group.
+	// For any other third-party types, APIGroup is required.
+	// +optional
+	apiGroup?: string @go(APIGroup,*string) @protobuf(1,bytes,opt)
+
+	// Kind is the type of resource being referenced
+	kind: string @go(Kind) @protobuf(2,bytes,opt)
+
+	// Name is the name of resource being referenced
+	name: string @go(Name) @protobuf(3,bytes,opt)
+
+	// Namespace is the namespace of resource being referenced
+	// Note that when a namespace is specified, a gateway.networking.k8s.io/ReferenceGrant object is required in the referent namespace to allow that namespace's owner to accept the reference. See the ReferenceGrant documentation for details.
+	// (Alpha) This field requires the CrossNamespaceVolumeDataSource feature gate to be enabled.
+	// +featureGate=CrossNamespaceVolumeDataSource
+	// +optional
+	namespace?: string @go(Namespace,*string) @protobuf(4,bytes,opt)
+}
+
+// PersistentVolumeClaimConditionType defines the condition of PV claim.
+// Valid values are:
+//   - "Resizing", "FileSystemResizePending"
+//
+// The following additional values can be expected:
+//   - "ControllerResizeError", "NodeResizeError"
+//
+// If VolumeAttributesClass feature gate is enabled, then following additional values can be expected:
+//   - "ModifyVolumeError", "ModifyingVolume"
+#PersistentVolumeClaimConditionType: string // #enumPersistentVolumeClaimConditionType
+
+#enumPersistentVolumeClaimConditionType:
+	#PersistentVolumeClaimResizing |
+	#PersistentVolumeClaimFileSystemResizePending |
+	#PersistentVolumeClaimControllerResizeError |
+	#PersistentVolumeClaimNodeResizeError |
+	#PersistentVolumeClaimVolumeModifyVolumeError |
+	#PersistentVolumeClaimVolumeModifyingVolume |
+	#PersistentVolumeClaimUnused
+
+// PersistentVolumeClaimResizing - a user trigger resize of pvc has been started
+#PersistentVolumeClaimResizing: #PersistentVolumeClaimConditionType & "Resizing"
+
+// PersistentVolumeClaimFileSystemResizePending - controller resize is finished and a file system resize is pending on node
+#PersistentVolumeClaimFileSystemResizePending: #PersistentVolumeClaimConditionType & "FileSystemResizePending"
+
+// PersistentVolumeClaimControllerResizeError indicates an error while resizing volume for size in the controller
+#PersistentVolumeClaimControllerResizeError: #PersistentVolumeClaimConditionType & "ControllerResizeError"
+
+// PersistentVolumeClaimNodeResizeError indicates an error while resizing volume for size in the node.
+#PersistentVolumeClaimNodeResizeError: #PersistentVolumeClaimConditionType & "NodeResizeError"
+
+// Applying the target VolumeAttributesClass encountered an error
+#PersistentVolumeClaimVolumeModifyVolumeError: #PersistentVolumeClaimConditionType & "ModifyVolumeError"
+
+// Volume is being modified
+#PersistentVolumeClaimVolumeModifyingVolume: #PersistentVolumeClaimConditionType & "ModifyingVolume"
+
+// PersistentVolumeClaimUnused indicates whether the PVC is currently not in use by any Pod.
+// When status is True, the PVC is not referenced by any non-terminal Pod.
+// The lastTransitionTime indicates when the PVC last transitioned to being unused.
+//
+// Both in-use time and unused time duration indicated by this condition may be shorter or
+// slightly longer than actual in-use time or unused time because of processing delays or
+// when this feature was enabled in the cluster.
+//
+// Requires PersistentVolumeClaimUnusedSinceTime alpha featuregate
+#PersistentVolumeClaimUnused: #PersistentVolumeClaimConditionType & "Unused"
+
+// +enum
+// When a controller receives persistentvolume claim update with ClaimResourceStatus for a resource
+// that it does not recognizes, then it should ignore that update and let other controllers
+// handle it.
+#ClaimResourceStatus: string // #enumClaimResourceStatus
+
+#enumClaimResourceStatus:
+	#PersistentVolumeClaimControllerResizeInProgress |
+	#PersistentVolumeClaimControllerResizeInfeasible |
+	#PersistentVolumeClaimNodeResizePending |
+	#PersistentVolumeClaimNodeResizeInProgress |
+	#PersistentVolumeClaimNodeResizeInfeasible
+
+// State set when resize controller starts resizing the volume in control-plane.
+#PersistentVolumeClaimControllerResizeInProgress: #ClaimResourceStatus & "ControllerResizeInProgress"
+
+// State set when resize has failed in resize controller with a terminal unrecoverable error.
+// Transient errors such as timeout should not set this status and should leave allocatedResourceStatus
+// unmodified, so as resize controller can resume the volume expansion.
+#PersistentVolumeClaimControllerResizeInfeasible: #ClaimResourceStatus & "ControllerResizeInfeasible"
+
+// State set when resize controller has finished resizing the volume but further resizing of volume
+// is needed on the node.
+#PersistentVolumeClaimNodeResizePending: #ClaimResourceStatus & "NodeResizePending"
+
+// State set when kubelet starts resizing the volume.
+#PersistentVolumeClaimNodeResizeInProgress: #ClaimResourceStatus & "NodeResizeInProgress"
+
+// State set when resizing has failed in kubelet with a terminal unrecoverable error. Transient errors
+// shouldn't set this status
+#PersistentVolumeClaimNodeResizeInfeasible: #ClaimResourceStatus & "NodeResizeInfeasible"
+
+// +enum
+// New statuses can be added in the future. Consumers should check for unknown statuses and fail appropriately
+#PersistentVolumeClaimModifyVolumeStatus: string // #enumPersistentVolumeClaimModifyVolumeStatus
+
+#enumPersistentVolumeClaimModifyVolumeStatus:
+	#PersistentVolumeClaimModifyVolumePending |
+	#PersistentVolumeClaimModifyVolumeInProgress |
+	#PersistentVolumeClaimModifyVolumeInfeasible
+
+// Pending indicates that the PersistentVolumeClaim cannot be modified due to unmet requirements, such as
+// the specified VolumeAttributesClass not existing
+#PersistentVolumeClaimModifyVolumePending: #PersistentVolumeClaimModifyVolumeStatus & "Pending"
+
+// InProgress indicates that the volume is being modified
+#PersistentVolumeClaimModifyVolumeInProgress: #PersistentVolumeClaimModifyVolumeStatus & "InProgress"
+
+// Infeasible indicates that the request has been rejected as invalid by the CSI driver. To
+// resolve the error, a valid VolumeAttributesClass needs to be specified
+#PersistentVolumeClaimModifyVolumeInfeasible: #PersistentVolumeClaimModifyVolumeStatus & "Infeasible"
+
+// ModifyVolumeStatus represents the status object of ControllerModifyVolume operation
+#ModifyVolumeStatus: {
+	// targetVolumeAttributesClassName is the name of the VolumeAttributesClass the PVC currently being reconciled
+	targetVolumeAttributesClassName?: string @go(TargetVolumeAttributesClassName) @protobuf(1,bytes,opt)
+
+	// status is the status of the ControllerModifyVolume operation. It can be in any of following states:
+	//  - Pending
+	//    Pending indicates that the PersistentVolumeClaim cannot be modified due to unmet requirements, such as
+	//    the specified VolumeAttributesClass not existing.
+	//  - InProgress
+	//    InProgress indicates that the volume is being modified.
+	//  - Infeasible
+	//   Infeasible indicates that the request has been rejected as invalid by the CSI driver. To
+	// 	  resolve the error, a valid VolumeAttributesClass needs to be specified.
+	// Note: New statuses can be added in the future. Consumers should check for unknown statuses and fail appropriately.
+	status: #PersistentVolumeClaimModifyVolumeStatus @go(Status) @protobuf(2,bytes,opt,casttype=PersistentVolumeClaimModifyVolumeStatus)
+}
+
+// PersistentVolumeClaimCondition contains details about state of pvc
+#PersistentVolumeClaimCondition: {
+	// Type is the type of the condition.
+	// More info: https://kubernetes.io/docs/reference/kubernetes-api/config-and-storage-resources/persistent-volume-claim-v1/#:~:text=set%20to%20%27ResizeStarted%27.-,PersistentVolumeClaimCondition,-contains%20details%20about
+	type: #PersistentVolumeClaimConditionType @go(Type) @protobuf(1,bytes,opt,casttype=PersistentVolumeClaimConditionType)
+
+	// Status is the status of the condition.
+	// Can be True, False, Unknown.
+	// More info: https://kubernetes.io/docs/reference/kubernetes-api/config-and-storage-resources/persistent-volume-claim-v1/#:~:text=state%20of%20pvc-,conditions.status,-(string)%2C%20required
+	status: #ConditionStatus @go(Status) @protobuf(2,bytes,opt,casttype=ConditionStatus)
+
+	// lastProbeTime is the time we probed the condition.
+	// +optional
+	lastProbeTime?: metav1.#Time @go(LastProbeTime) @protobuf(3,bytes,opt)
+
+	// lastTransitionTime is the time the condition transitioned from one status to another.
+	// +optional
+	lastTransitionTime?: metav1.#Time @go(LastTransitionTime) @protobuf(4,bytes,opt)
+
+	// reason is a unique, this should be a short, machine understandable string that gives the reason
+	// for condition's last transition. If it reports "Resizing" that means the underlying
+	// persistent volume is being resized.
+	// +optional
+	reason?: string @go(Reason) @protobuf(5,bytes,opt)
+
+	// message is the human-readable message indicating details about last transition.
+	// +optional
+	message?: string @go(Message) @protobuf(6,bytes,opt)
+}
+
+// PersistentVolumeClaimStatus is the current status of a persistent volume claim.
+#PersistentVolumeClaimStatus: {
+	// phase represents the current phase of PersistentVolumeClaim.
+	// +optional
+	phase?: #PersistentVolumeClaimPhase @go(Phase) @protobuf(1,bytes,opt,casttype=PersistentVolumeClaimPhase)
+
+	// accessModes contains the actual access modes the volume backing the PVC has.
+	// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1
+	// +optional
+	// +listType=atomic
+	accessModes?: [...#PersistentVolumeAccessMode] @go(AccessModes,[]PersistentVolumeAccessMode) @protobuf(2,bytes,rep,casttype=PersistentVolumeAccessMode)
+
+	// capacity represents the actual resources of the underlying volume.
+	// +optional
+	capacity?: #ResourceList @go(Capacity) @protobuf(3,bytes,rep,casttype=ResourceList,castkey=ResourceName)
+
+	// conditions is the current Condition of persistent volume claim. If underlying persistent volume is being
+	// resized then the Condition will be set to 'Resizing'.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	conditions?: [...#PersistentVolumeClaimCondition] @go(Conditions,[]PersistentVolumeClaimCondition) @protobuf(4,bytes,rep)
+
+	// allocatedResources tracks the resources allocated to a PVC including its capacity.
+	// Key names follow standard Kubernetes label syntax. Valid values are either:
+	// 	* Un-prefixed keys:
+	//		- storage - the capacity of the volume.
+	//	* Custom resources must use implementation-defined prefixed names such as "example.com/my-custom-resource"
+	// Apart from above values - keys that are unprefixed or have kubernetes.io prefix are considered
+	// reserved and hence may not be used.
+	//
+	// Capacity reported here may be larger than the actual capacity when a volume expansion operation
+	// is requested.
+	// For storage quota, the larger value from allocatedResources and PVC.spec.resources is used.
+	// If allocatedResources is not set, PVC.spec.resources alone is used for quota calculation.
+	// If a volume expansion capacity request is lowered, allocatedResources is only
+	// lowered if there are no expansion operations in progress and if the actual volume capacity
+	// is equal or lower than the requested capacity.
+	//
+	// A controller that receives PVC update with previously unknown resourceName
+	// should ignore the update for the purpose it was designed. For example - a controller that
+	// only is responsible for resizing capacity of the volume, should ignore PVC updates that change other valid
+	// resources associated with PVC.
+	// +optional
+	allocatedResources?: #ResourceList @go(AllocatedResources) @protobuf(5,bytes,rep,casttype=ResourceList,castkey=ResourceName)
+
+	// allocatedResourceStatuses stores status of resource being resized for the given PVC.
+	// Key names follow standard Kubernetes label syntax. Valid values are either:
+	// 	* Un-prefixed keys:
+	//		- storage - the capacity of the volume.
+	//	* Custom resources must use implementation-defined prefixed names such as "example.com/my-custom-resource"
+	// Apart from above values - keys that are unprefixed or have kubernetes.io prefix are considered
+	// reserved and hence may not be used.
+	//
+	// ClaimResourceStatus can be in any of following states:
+	//	- ControllerResizeInProgress:
+	//		State set when resize controller starts resizing the volume in control-plane.
+	// 	- ControllerResizeFailed:
+	//		State set when resize has failed in resize controller with a terminal error.
+	//	- NodeResizePending:
+	//		State set when resize controller has finished resizing the volume but further resizing of
+	//		volume is needed on the node.
+	//	- NodeResizeInProgress:
+	//		State set when kubelet starts resizing the volume.
+	//	- NodeResizeFailed:
+	//		State set when resizing has failed in kubelet with a terminal error. Transient errors don't set
+	//		NodeResizeFailed.
+	// For example: if expanding a PVC for more capacity - this field can be one of the following states:
+	// 	- pvc.status.allocatedResourceStatus['storage'] = "ControllerResizeInProgress"
+	//      - pvc.status.allocatedResourceStatus['storage'] = "ControllerResizeFailed"
+	//      - pvc.status.allocatedResourceStatus['storage'] = "NodeResizePending"
+	//      - pvc.status.allocatedResourceStatus['storage'] = "NodeResizeInProgress"
+	//      - pvc.status.allocatedResourceStatus['storage'] = "NodeResizeFailed"
+	// When this field is not set, it means that no resize operation is in progress for the given PVC.
+	//
+	// A controller that receives PVC update with previously unknown resourceName or ClaimResourceStatus
+	// should ignore the update for the purpose it was designed. For example - a controller that
+	// only is responsible for resizing capacity of the volume, should ignore PVC updates that change other valid
+	// resources associated with PVC.
+	// +mapType=granular
+	// +optional
+	allocatedResourceStatuses?: {[string]: #ClaimResourceStatus} @go(AllocatedResourceStatuses,map[ResourceName]ClaimResourceStatus) @protobuf(7,bytes,rep)
+
+	// currentVolumeAttributesClassName is the current name of the VolumeAttributesClass the PVC is using.
+	// When unset, there is no VolumeAttributeClass applied to this PersistentVolumeClaim
+	// +featureGate=VolumeAttributesClass
+	// +optional
+	currentVolumeAttributesClassName?: string @go(CurrentVolumeAttributesClassName,*string) @protobuf(8,bytes,opt)
+
+	// ModifyVolumeStatus represents the status object of ControllerModifyVolume operation.
+	// When this is unset, there is no ModifyVolume operation being attempted.
+	// +featureGate=VolumeAttributesClass
+	// +optional
+	modifyVolumeStatus?: #ModifyVolumeStatus @go(ModifyVolumeStatus,*ModifyVolumeStatus) @protobuf(9,bytes,opt)
+}
+
+// +enum
+#PersistentVolumeAccessMode: string // #enumPersistentVolumeAccessMode
+
+#enumPersistentVolumeAccessMode:
+	#ReadWriteOnce |
+	#ReadOnlyMany |
+	#ReadWriteMany |
+	#ReadWriteOncePod
+
+// can be mounted in read/write mode to exactly 1 host
+#ReadWriteOnce: #PersistentVolumeAccessMode & "ReadWriteOnce"
+
+// can be mounted in read-only mode to many hosts
+#ReadOnlyMany: #PersistentVolumeAccessMode & "ReadOnlyMany"
+
+// can be mounted in read/write mode to many hosts
+#ReadWriteMany: #PersistentVolumeAccessMode & "ReadWriteMany"
+
+// can be mounted in read/write mode to exactly 1 pod
+// cannot be used in combination with other access modes
+#ReadWriteOncePod: #PersistentVolumeAccessMode & "ReadWriteOncePod"
+
+// +enum
+#PersistentVolumePhase: string // #enumPersistentVolumePhase
+
+#enumPersistentVolumePhase:
+	#VolumePending |
+	#VolumeAvailable |
+	#VolumeBound |
+	#VolumeReleased |
+	#VolumeFailed
+
+// used for PersistentVolumes that are not available
+#VolumePending: #PersistentVolumePhase & "Pending"
+
+// used for PersistentVolumes that are not yet bound
+// Available volumes are held by the binder and matched to PersistentVolumeClaims
+#VolumeAvailable: #PersistentVolumePhase & "Available"
+
+// used for PersistentVolumes that are bound
+#VolumeBound: #PersistentVolumePhase & "Bound"
+
+// used for PersistentVolumes where the bound PersistentVolumeClaim was deleted
+// released volumes must be recycled before becoming available again
+// this phase is used by the persistent volume claim binder to signal to another process to reclaim the resource
+#VolumeReleased: #PersistentVolumePhase & "Released"
+
+// used for PersistentVolumes that failed to be correctly recycled or deleted after being released from a claim
+#VolumeFailed: #PersistentVolumePhase & "Failed"
+
+// +enum
+#PersistentVolumeClaimPhase: string // #enumPersistentVolumeClaimPhase
+
+#enumPersistentVolumeClaimPhase:
+	#ClaimPending |
+	#ClaimBound |
+	#ClaimLost
+
+// used for PersistentVolumeClaims that are not yet bound
+#ClaimPending: #PersistentVolumeClaimPhase & "Pending"
+
+// used for PersistentVolumeClaims that are bound
+#ClaimBound: #PersistentVolumeClaimPhase & "Bound"
+
+// used for PersistentVolumeClaims that lost their underlying
+// PersistentVolume. The claim was bound to a PersistentVolume and this
+// volume does not exist any longer and all data on it was lost.
+#ClaimLost: #PersistentVolumeClaimPhase & "Lost"
+
+// +enum
+#HostPathType: string // #enumHostPathType
+
+#enumHostPathType:
+	#HostPathUnset |
+	#HostPathDirectoryOrCreate |
+	#HostPathDirectory |
+	#HostPathFileOrCreate |
+	#HostPathFile |
+	#HostPathSocket |
+	#HostPathCharDev |
+	#HostPathBlockDev
+
+// For backwards compatible, leave it empty if unset
+#HostPathUnset: #HostPathType & ""
+
+// If nothing exists at the given path, an empty directory will be created there
+// as needed with file mode 0755, having the same group and ownership with Kubelet.
+#HostPathDirectoryOrCreate: #HostPathType & "DirectoryOrCreate"
+
+// A directory must exist at the given path
+#HostPathDirectory: #HostPathType & "Directory"
+
+// If nothing exists at the given path, an empty file will be created there
+// as needed with file mode 0644, having the same group and ownership with Kubelet.
+#HostPathFileOrCreate: #HostPathType & "FileOrCreate"
+
+// A file must exist at the given path
+#HostPathFile: #HostPathType & "File"
+
+// A UNIX socket must exist at the given path
+#HostPathSocket: #HostPathType & "Socket"
+
+// A character device must exist at the given path
+#HostPathCharDev: #HostPathType & "CharDevice"
+
+// A block device must exist at the given path
+#HostPathBlockDev: #HostPathType & "BlockDevice"
+
+// Represents a host path mapped into a pod.
+// Host path volumes do not support ownership management or SELinux relabeling.
+#HostPathVolumeSource: {
+	// path of the directory on the host.
+	// If the path is a symlink, it will follow the link to the real path.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#hostpath
+	path: string @go(Path) @protobuf(1,bytes,opt)
+
+	// type for HostPath Volume
+	// Defaults to ""
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#hostpath
+	// +optional
+	type?: #HostPathType @go(Type,*HostPathType) @protobuf(2,bytes,opt)
+}
+
+// Represents an empty directory for a pod.
+// Empty directory volumes support ownership management and SELinux relabeling.
+#EmptyDirVolumeSource: {
+	// medium represents what type of storage medium should back this directory.
+	// The default is "" which means to use the node's default medium.
+	// Must be an empty string (default) or Memory.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#emptydir
+	// +optional
+	medium?: #StorageMedium @go(Medium) @protobuf(1,bytes,opt,casttype=StorageMedium)
+
+	// sizeLimit is the total amount of local storage required for this EmptyDir volume.
+	// The size limit is also applicable for memory medium.
+	// The maximum usage on memory medium EmptyDir would be the minimum value between
+	// the SizeLimit specified here and the sum of memory limits of all containers in a pod.
+	// The default is nil which means that the limit is undefined.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#emptydir
+	// +optional
+	sizeLimit?: resource.#Quantity @go(SizeLimit,*resource.Quantity) @protobuf(2,bytes,opt)
+}
+
+// Represents a Glusterfs mount that lasts the lifetime of a pod.
+// Glusterfs volumes do not support ownership management or SELinux relabeling.
+#GlusterfsVolumeSource: {
+	// endpoints is the endpoint name that details Glusterfs topology.
+	endpoints: string @go(EndpointsName) @protobuf(1,bytes,opt)
+
+	// path is the Glusterfs volume path.
+	// More info: https://examples.k8s.io/volumes/glusterfs/README.md#create-a-pod
+	path: string @go(Path) @protobuf(2,bytes,opt)
+
+	// readOnly here will force the Glusterfs volume to be mounted with read-only permissions.
+	// Defaults to false.
+	// More info: https://examples.k8s.io/volumes/glusterfs/README.md#create-a-pod
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(3,varint,opt)
+}
+
+// Represents a Glusterfs mount that lasts the lifetime of a pod.
+// Glusterfs volumes do not support ownership management or SELinux relabeling.
+#GlusterfsPersistentVolumeSource: {
+	// endpoints is the endpoint name that details Glusterfs topology.
+	// More info: https://examples.k8s.io/volumes/glusterfs/README.md#create-a-pod
+	endpoints: string @go(EndpointsName) @protobuf(1,bytes,opt)
+
+	// path is the Glusterfs volume path.
+	// More info: https://examples.k8s.io/volumes/glusterfs/README.md#create-a-pod
+	path: string @go(Path) @protobuf(2,bytes,opt)
+
+	// readOnly here will force the Glusterfs volume to be mounted with read-only permissions.
+	// Defaults to false.
+	// More info: https://examples.k8s.io/volumes/glusterfs/README.md#create-a-pod
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(3,varint,opt)
+
+	// endpointsNamespace is the namespace that contains Glusterfs endpoint.
+	// If this field is empty, the EndpointNamespace defaults to the same namespace as the bound PVC.
+	// More info: https://examples.k8s.io/volumes/glusterfs/README.md#create-a-pod
+	// +optional
+	endpointsNamespace?: string @go(EndpointsNamespace,*string) @protobuf(4,bytes,opt)
+}
+
+// Represents a Rados Block Device mount that lasts the lifetime of a pod.
+// RBD volumes support ownership management and SELinux relabeling.
+#RBDVolumeSource: {
+	// monitors is a collection of Ceph monitors.
+	// More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it
+	// +listType=atomic
+	monitors: [...string] @go(CephMonitors,[]string) @protobuf(1,bytes,rep)
+
+	// image is the rados image name.
+	// More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it
+	image: string @go(RBDImage) @protobuf(2,bytes,opt)
+
+	// fsType is the filesystem type of the volume that you want to mount.
+	// Tip: Ensure that the filesystem type is supported by the host operating system.
+	// Examples: "ext4", "xfs", "ntfs". Implicitly inferred to be "ext4" if unspecified.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#rbd
+	// TODO: how do we prevent errors in the filesystem from compromising the machine
+	// +optional
+	fsType?: string @go(FSType) @protobuf(3,bytes,opt)
+
+	// pool is the rados pool name.
+	// Default is rbd.
+	// More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it
+	// +optional
+	// +default="rbd"
+	pool?: string @go(RBDPool) @protobuf(4,bytes,opt)
+
+	// user is the rados user name.
+	// Default is admin.
+	// More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it
+	// +optional
+	// +default="admin"
+	user?: string @go(RadosUser) @protobuf(5,bytes,opt)
+
+	// keyring is the path to key ring for RBDUser.
+	// Default is /etc/ceph/keyring.
+	// More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it
+	// +optional
+	// +default="/etc/ceph/keyring"
+	keyring?: string @go(Keyring) @protobuf(6,bytes,opt)
+
+	// secretRef is name of the authentication secret for RBDUser. If provided
+	// overrides keyring.
+	// Default is nil.
+	// More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it
+	// +optional
+	secretRef?: #LocalObjectReference @go(SecretRef,*LocalObjectReference) @protobuf(7,bytes,opt)
+
+	// readOnly here will force the ReadOnly setting in VolumeMounts.
+	// Defaults to false.
+	// More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(8,varint,opt)
+}
+
+// Represents a Rados Block Device mount that lasts the lifetime of a pod.
+// RBD volumes support ownership management and SELinux relabeling.
+#RBDPersistentVolumeSource: {
+	// monitors is a collection of Ceph monitors.
+	// More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it
+	// +listType=atomic
+	monitors: [...string] @go(CephMonitors,[]string) @protobuf(1,bytes,rep)
+
+	// image is the rados image name.
+	// More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it
+	image: string @go(RBDImage) @protobuf(2,bytes,opt)
+
+	// fsType is the filesystem type of the volume that you want to mount.
+	// Tip: Ensure that the filesystem type is supported by the host operating system.
+	// Examples: "ext4", "xfs", "ntfs". Implicitly inferred to be "ext4" if unspecified.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#rbd
+	// TODO: how do we prevent errors in the filesystem from compromising the machine
+	// +optional
+	fsType?: string @go(FSType) @protobuf(3,bytes,opt)
+
+	// pool is the rados pool name.
+	// Default is rbd.
+	// More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it
+	// +optional
+	// +default="rbd"
+	pool?: string @go(RBDPool) @protobuf(4,bytes,opt)
+
+	// user is the rados user name.
+	// Default is admin.
+	// More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it
+	// +optional
+	// +default="admin"
+	user?: string @go(RadosUser) @protobuf(5,bytes,opt)
+
+	// keyring is the path to key ring for RBDUser.
+	// Default is /etc/ceph/keyring.
+	// More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it
+	// +optional
+	// +default="/etc/ceph/keyring"
+	keyring?: string @go(Keyring) @protobuf(6,bytes,opt)
+
+	// secretRef is name of the authentication secret for RBDUser. If provided
+	// overrides keyring.
+	// Default is nil.
+	// More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it
+	// +optional
+	secretRef?: #SecretReference @go(SecretRef,*SecretReference) @protobuf(7,bytes,opt)
+
+	// readOnly here will force the ReadOnly setting in VolumeMounts.
+	// Defaults to false.
+	// More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(8,varint,opt)
+}
+
+// Represents a cinder volume resource in Openstack.
+// A Cinder volume must exist before mounting to a container.
+// The volume must also be in the same region as the kubelet.
+// Cinder volumes support ownership management and SELinux relabeling.
+#CinderVolumeSource: {
+	// volumeID used to identify the volume in cinder.
+	// More info: https://examples.k8s.io/mysql-cinder-pd/README.md
+	volumeID: string @go(VolumeID) @protobuf(1,bytes,opt)
+
+	// fsType is the filesystem type to mount.
+	// Must be a filesystem type supported by the host operating system.
+	// Examples: "ext4", "xfs", "ntfs". Implicitly inferred to be "ext4" if unspecified.
+	// More info: https://examples.k8s.io/mysql-cinder-pd/README.md
+	// +optional
+	fsType?: string @go(FSType) @protobuf(2,bytes,opt)
+
+	// readOnly defaults to false (read/write). ReadOnly here will force
+	// the ReadOnly setting in VolumeMounts.
+	// More info: https://examples.k8s.io/mysql-cinder-pd/README.md
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(3,varint,opt)
+
+	// secretRef is optional: points to a secret object containing parameters used to connect
+	// to OpenStack.
+	// +optional
+	secretRef?: #LocalObjectReference @go(SecretRef,*LocalObjectReference) @protobuf(4,bytes,opt)
+}
+
+// Represents a cinder volume resource in Openstack.
+// A Cinder volume must exist before mounting to a container.
+// The volume must also be in the same region as the kubelet.
+// Cinder volumes support ownership management and SELinux relabeling.
+#CinderPersistentVolumeSource: {
+	// volumeID used to identify the volume in cinder.
+	// More info: https://examples.k8s.io/mysql-cinder-pd/README.md
+	volumeID: string @go(VolumeID) @protobuf(1,bytes,opt)
+
+	// fsType Filesystem type to mount.
+	// Must be a filesystem type supported by the host operating system.
+	// Examples: "ext4", "xfs", "ntfs". Implicitly inferred to be "ext4" if unspecified.
+	// More info: https://examples.k8s.io/mysql-cinder-pd/README.md
+	// +optional
+	fsType?: string @go(FSType) @protobuf(2,bytes,opt)
+
+	// readOnly is Optional: Defaults to false (read/write). ReadOnly here will force
+	// the ReadOnly setting in VolumeMounts.
+	// More info: https://examples.k8s.io/mysql-cinder-pd/README.md
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(3,varint,opt)
+
+	// secretRef is Optional: points to a secret object containing parameters used to connect
+	// to OpenStack.
+	// +optional
+	secretRef?: #SecretReference @go(SecretRef,*SecretReference) @protobuf(4,bytes,opt)
+}
+
+// Represents a Ceph Filesystem mount that lasts the lifetime of a pod
+// Cephfs volumes do not support ownership management or SELinux relabeling.
+#CephFSVolumeSource: {
+	// monitors is Required: Monitors is a collection of Ceph monitors
+	// More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it
+	// +listType=atomic
+	monitors: [...string] @go(Monitors,[]string) @protobuf(1,bytes,rep)
+
+	// path is Optional: Used as the mounted root, rather than the full Ceph tree, default is /
+	// +optional
+	path?: string @go(Path) @protobuf(2,bytes,opt)
+
+	// user is optional: User is the rados user name, default is admin
+	// More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it
+	// +optional
+	user?: string @go(User) @protobuf(3,bytes,opt)
+
+	// secretFile is Optional: SecretFile is the path to key ring for User, default is /etc/ceph/user.secret
+	// More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it
+	// +optional
+	secretFile?: string @go(SecretFile) @protobuf(4,bytes,opt)
+
+	// secretRef is Optional: SecretRef is reference to the authentication secret for User, default is empty.
+	// More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it
+	// +optional
+	secretRef?: #LocalObjectReference @go(SecretRef,*LocalObjectReference) @protobuf(5,bytes,opt)
+
+	// readOnly is Optional: Defaults to false (read/write). ReadOnly here will force
+	// the ReadOnly setting in VolumeMounts.
+	// More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(6,varint,opt)
+}
+
+// SecretReference represents a Secret Reference. It has enough information to retrieve secret
+// in any namespace
+// +structType=atomic
+#SecretReference: {
+	// name is unique within a namespace to reference a secret resource.
+	// +optional
+	name?: string @go(Name) @protobuf(1,bytes,opt)
+
+	// namespace defines the space within which the secret name must be unique.
+	// +optional
+	namespace?: string @go(Namespace) @protobuf(2,bytes,opt)
+}
+
+// Represents a Ceph Filesystem mount that lasts the lifetime of a pod
+// Cephfs volumes do not support ownership management or SELinux relabeling.
+#CephFSPersistentVolumeSource: {
+	// monitors is Required: Monitors is a collection of Ceph monitors
+	// More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it
+	// +listType=atomic
+	monitors: [...string] @go(Monitors,[]string) @protobuf(1,bytes,rep)
+
+	// path is Optional: Used as the mounted root, rather than the full Ceph tree, default is /
+	// +optional
+	path?: string @go(Path) @protobuf(2,bytes,opt)
+
+	// user is Optional: User is the rados user name, default is admin
+	// More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it
+	// +optional
+	user?: string @go(User) @protobuf(3,bytes,opt)
+
+	// secretFile is Optional: SecretFile is the path to key ring for User, default is /etc/ceph/user.secret
+	// More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it
+	// +optional
+	secretFile?: string @go(SecretFile) @protobuf(4,bytes,opt)
+
+	// secretRef is Optional: SecretRef is reference to the authentication secret for User, default is empty.
+	// More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it
+	// +optional
+	secretRef?: #SecretReference @go(SecretRef,*SecretReference) @protobuf(5,bytes,opt)
+
+	// readOnly is Optional: Defaults to false (read/write). ReadOnly here will force
+	// the ReadOnly setting in VolumeMounts.
+	// More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(6,varint,opt)
+}
+
+// Represents a Flocker volume mounted by the Flocker agent.
+// One and only one of datasetName and datasetUUID should be set.
+// Flocker volumes do not support ownership management or SELinux relabeling.
+#FlockerVolumeSource: {
+	// datasetName is Name of the dataset stored as metadata -> name on the dataset for Flocker
+	// should be considered as deprecated
+	// +optional
+	datasetName?: string @go(DatasetName) @protobuf(1,bytes,opt)
+
+	// datasetUUID is the UUID of the dataset. This is unique identifier of a Flocker dataset
+	// +optional
+	datasetUUID?: string @go(DatasetUUID) @protobuf(2,bytes,opt)
+}
+
+// StorageMedium defines ways that storage can be allocated to a volume.
+#StorageMedium: string // #enumStorageMedium
+
+#enumStorageMedium:
+	#StorageMediumDefault |
+	#StorageMediumMemory |
+	#StorageMediumHugePages |
+	#StorageMediumHugePagesPrefix
+
+#StorageMediumDefault:         #StorageMedium & ""
+#StorageMediumMemory:          #StorageMedium & "Memory"
+#StorageMediumHugePages:       #StorageMedium & "HugePages"
+#StorageMediumHugePagesPrefix: #StorageMedium & "HugePages-"
+
+// Protocol defines network protocols supported for things like container ports.
+// +enum
+#Protocol: string // #enumProtocol
+
+#enumProtocol:
+	#ProtocolTCP |
+	#ProtocolUDP |
+	#ProtocolSCTP
+
+// ProtocolTCP is the TCP protocol.
+#ProtocolTCP: #Protocol & "TCP"
+
+// ProtocolUDP is the UDP protocol.
+#ProtocolUDP: #Protocol & "UDP"
+
+// ProtocolSCTP is the SCTP protocol.
+#ProtocolSCTP: #Protocol & "SCTP"
+
+// Represents a Persistent Disk resource in Google Compute Engine.
+//
+// A GCE PD must exist before mounting to a container. The disk must
+// also be in the same GCE project and zone as the kubelet. A GCE PD
+// can only be mounted as read/write once or read-only many times. GCE
+// PDs support ownership management and SELinux relabeling.
+#GCEPersistentDiskVolumeSource: {
+	// pdName is unique name of the PD resource in GCE. Used to identify the disk in GCE.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk
+	pdName: string @go(PDName) @protobuf(1,bytes,opt)
+
+	// fsType is filesystem type of the volume that you want to mount.
+	// Tip: Ensure that the filesystem type is supported by the host operating system.
+	// Examples: "ext4", "xfs", "ntfs". Implicitly inferred to be "ext4" if unspecified.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk
+	// TODO: how do we prevent errors in the filesystem from compromising the machine
+	// +optional
+	fsType?: string @go(FSType) @protobuf(2,bytes,opt)
+
+	// partition is the partition in the volume that you want to mount.
+	// If omitted, the default is to mount by volume name.
+	// Examples: For volume /dev/sda1, you specify the partition as "1".
+	// Similarly, the volume partition for /dev/sda is "0" (or you can leave the property empty).
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk
+	// +optional
+	partition?: int32 @go(Partition) @protobuf(3,varint,opt)
+
+	// readOnly here will force the ReadOnly setting in VolumeMounts.
+	// Defaults to false.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(4,varint,opt)
+}
+
+// Represents a Quobyte mount that lasts the lifetime of a pod.
+// Quobyte volumes do not support ownership management or SELinux relabeling.
+#QuobyteVolumeSource: {
+	// registry represents a single or multiple Quobyte Registry services
+	// specified as a string as host:port pair (multiple entries are separated with commas)
+	// which acts as the central registry for volumes
+	registry: string @go(Registry) @protobuf(1,bytes,opt)
+
+	// volume is a string that references an already created Quobyte volume by name.
+	volume: string @go(Volume) @protobuf(2,bytes,opt)
+
+	// readOnly here will force the Quobyte volume to be mounted with read-only permissions.
+	// Defaults to false.
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(3,varint,opt)
+
+	// user to map volume access to
+	// Defaults to serivceaccount user
+	// +optional
+	user?: string @go(User) @protobuf(4,bytes,opt)
+
+	// group to map volume access to
+	// Default is no group
+	// +optional
+	group?: string @go(Group) @protobuf(5,bytes,opt)
+
+	// tenant owning the given Quobyte volume in the Backend
+	// Used with dynamically provisioned Quobyte volumes, value is set by the plugin
+	// +optional
+	tenant?: string @go(Tenant) @protobuf(6,bytes,opt)
+}
+
+// FlexPersistentVolumeSource represents a generic persistent volume resource that is
+// provisioned/attached using an exec based plugin.
+#FlexPersistentVolumeSource: {
+	// driver is the name of the driver to use for this volume.
+	driver: string @go(Driver) @protobuf(1,bytes,opt)
+
+	// fsType is the Filesystem type to mount.
+	// Must be a filesystem type supported by the host operating system.
+	// Ex. "ext4", "xfs", "ntfs". The default filesystem depends on FlexVolume script.
+	// +optional
+	fsType?: string @go(FSType) @protobuf(2,bytes,opt)
+
+	// secretRef is Optional: SecretRef is reference to the secret object containing
+	// sensitive information to pass to the plugin scripts. This may be
+	// empty if no secret object is specified. If the secret object
+	// contains more than one secret, all secrets are passed to the plugin
+	// scripts.
+	// +optional
+	secretRef?: #SecretReference @go(SecretRef,*SecretReference) @protobuf(3,bytes,opt)
+
+	// readOnly is Optional: defaults to false (read/write). ReadOnly here will force
+	// the ReadOnly setting in VolumeMounts.
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(4,varint,opt)
+
+	// options is Optional: this field holds extra command options if any.
+	// +optional
+	options?: {[string]: string} @go(Options,map[string]string) @protobuf(5,bytes,rep)
+}
+
+// FlexVolume represents a generic volume resource that is
+// provisioned/attached using an exec based plugin.
+#FlexVolumeSource: {
+	// driver is the name of the driver to use for this volume.
+	driver: string @go(Driver) @protobuf(1,bytes,opt)
+
+	// fsType is the filesystem type to mount.
+	// Must be a filesystem type supported by the host operating system.
+	// Ex. "ext4", "xfs", "ntfs". The default filesystem depends on FlexVolume script.
+	// +optional
+	fsType?: string @go(FSType) @protobuf(2,bytes,opt)
+
+	// secretRef is Optional: secretRef is reference to the secret object containing
+	// sensitive information to pass to the plugin scripts. This may be
+	// empty if no secret object is specified. If the secret object
+	// contains more than one secret, all secrets are passed to the plugin
+	// scripts.
+	// +optional
+	secretRef?: #LocalObjectReference @go(SecretRef,*LocalObjectReference) @protobuf(3,bytes,opt)
+
+	// readOnly is Optional: defaults to false (read/write). ReadOnly here will force
+	// the ReadOnly setting in VolumeMounts.
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(4,varint,opt)
+
+	// options is Optional: this field holds extra command options if any.
+	// +optional
+	options?: {[string]: string} @go(Options,map[string]string) @protobuf(5,bytes,rep)
+}
+
+// Represents a Persistent Disk resource in AWS.
+//
+// An AWS EBS disk must exist before mounting to a container. The disk
+// must also be in the same AWS zone as the kubelet. An AWS EBS disk
+// can only be mounted as read/write once. AWS EBS volumes support
+// ownership management and SELinux relabeling.
+#AWSElasticBlockStoreVolumeSource: {
+	// volumeID is unique ID of the persistent disk resource in AWS (Amazon EBS volume).
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore
+	volumeID: string @go(VolumeID) @protobuf(1,bytes,opt)
+
+	// fsType is the filesystem type of the volume that you want to mount.
+	// Tip: Ensure that the filesystem type is supported by the host operating system.
+	// Examples: "ext4", "xfs", "ntfs". Implicitly inferred to be "ext4" if unspecified.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore
+	// TODO: how do we prevent errors in the filesystem from compromising the machine
+	// +optional
+	fsType?: string @go(FSType) @protobuf(2,bytes,opt)
+
+	// partition is the partition in the volume that you want to mount.
+	// If omitted, the default is to mount by volume name.
+	// Examples: For volume /dev/sda1, you specify the partition as "1".
+	// Similarly, the volume partition for /dev/sda is "0" (or you can leave the property empty).
+	// +optional
+	partition?: int32 @go(Partition) @protobuf(3,varint,opt)
+
+	// readOnly value true will force the readOnly setting in VolumeMounts.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(4,varint,opt)
+}
+
+// Represents a volume that is populated with the contents of a git repository.
+// Git repo volumes do not support ownership management.
+// Git repo volumes support SELinux relabeling.
+//
+// DEPRECATED: GitRepo is deprecated. To provision a container with a git repo, mount an
+// EmptyDir into an InitContainer that clones the repo using git, then mount the EmptyDir
+// into the Pod's container.
+#GitRepoVolumeSource: {
+	// repository is the URL
+	repository: string @go(Repository) @protobuf(1,bytes,opt)
+
+	// revision is the commit hash for the specified revision.
+	// +optional
+	revision?: string @go(Revision) @protobuf(2,bytes,opt)
+
+	// directory is the target directory name.
+	// Must not contain or start with '..'.  If '.' is supplied, the volume directory will be the
+	// git repository.  Otherwise, if specified, the volume will contain the git repository in
+	// the subdirectory with the given name.
+	// +optional
+	directory?: string @go(Directory) @protobuf(3,bytes,opt)
+}
+
+// Adapts a Secret into a volume.
+//
+// The contents of the target Secret's Data field will be presented in a volume
+// as files using the keys in the Data field as the file names.
+// Secret volumes support ownership management and SELinux relabeling.
+#SecretVolumeSource: {
+	// secretName is the name of the secret in the pod's namespace to use.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#secret
+	// +optional
+	secretName?: string @go(SecretName) @protobuf(1,bytes,opt)
+
+	// items If unspecified, each key-value pair in the Data field of the referenced
+	// Secret will be projected into the volume as a file whose name is the
+	// key and content is the value. If specified, the listed keys will be
+	// projected into the specified paths, and unlisted keys will not be
+	// present. If a key is specified which is not present in the Secret,
+	// the volume setup will error unless it is marked optional. Paths must be
+	// relative and may not contain the '..' path or start with '..'.
+	// +optional
+	// +listType=atomic
+	items?: [...#KeyToPath] @go(Items,[]KeyToPath) @protobuf(2,bytes,rep)
+
+	// defaultMode is Optional: mode bits used to set permissions on created files by default.
+	// Must be an octal value between 0000 and 0777 or a decimal value between 0 and 511.
+	// YAML accepts both octal and decimal values, JSON requires decimal values
+	// for mode bits. Defaults to 0644.
+	// Directories within the path are not affected by this setting.
+	// This might be in conflict with other options that affect the file
+	// mode, like fsGroup, and the result can be other mode bits set.
+	// +optional
+	defaultMode?: int32 @go(DefaultMode,*int32) @protobuf(3,bytes,opt)
+
+	// optional field specify whether the Secret or its keys must be defined
+	// +optional
+	optional?: bool @go(Optional,*bool) @protobuf(4,varint,opt)
+}
+
+#SecretVolumeSourceDefaultMode: int32 & 0o644
+
+// Adapts a secret into a projected volume.
+//
+// The contents of the target Secret's Data field will be presented in a
+// projected volume as files using the keys in the Data field as the file names.
+// Note that this is identical to a secret volume source without the default
+// mode.
+#SecretProjection: {
+	#LocalObjectReference
+
+	// items if unspecified, each key-value pair in the Data field of the referenced
+	// Secret will be projected into the volume as a file whose name is the
+	// key and content is the value. If specified, the listed keys will be
+	// projected into the specified paths, and unlisted keys will not be
+	// present. If a key is specified which is not present in the Secret,
+	// the volume setup will error unless it is marked optional. Paths must be
+	// relative and may not contain the '..' path or start with '..'.
+	// +optional
+	// +listType=atomic
+	items?: [...#KeyToPath] @go(Items,[]KeyToPath) @protobuf(2,bytes,rep)
+
+	// optional field specify whether the Secret or its key must be defined
+	// +optional
+	optional?: bool @go(Optional,*bool) @protobuf(4,varint,opt)
+}
+
+// Represents an NFS mount that lasts the lifetime of a pod.
+// NFS volumes do not support ownership management or SELinux relabeling.
+#NFSVolumeSource: {
+	// server is the hostname or IP address of the NFS server.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#nfs
+	server: string @go(Server) @protobuf(1,bytes,opt)
+
+	// path that is exported by the NFS server.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#nfs
+	path: string @go(Path) @protobuf(2,bytes,opt)
+
+	// readOnly here will force the NFS export to be mounted with read-only permissions.
+	// Defaults to false.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#nfs
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(3,varint,opt)
+}
+
+// Represents an ISCSI disk.
+// ISCSI volumes can only be mounted as read/write once.
+// ISCSI volumes support ownership management and SELinux relabeling.
+#ISCSIVolumeSource: {
+	// targetPortal is iSCSI Target Portal. The Portal is either an IP or ip_addr:port if the port
+	// is other than default (typically TCP ports 860 and 3260).
+	targetPortal: string @go(TargetPortal) @protobuf(1,bytes,opt)
+
+	// iqn is the target iSCSI Qualified Name.
+	iqn: string @go(IQN) @protobuf(2,bytes,opt)
+
+	// lun represents iSCSI Target Lun number.
+	lun: int32 @go(Lun) @protobuf(3,varint,opt)
+
+	// iscsiInterface is the interface Name that uses an iSCSI transport.
+	// Defaults to 'default' (tcp).
+	// +optional
+	// +default="default"
+	iscsiInterface?: string @go(ISCSIInterface) @protobuf(4,bytes,opt)
+
+	// fsType is the filesystem type of the volume that you want to mount.
+	// Tip: Ensure that the filesystem type is supported by the host operating system.
+	// Examples: "ext4", "xfs", "ntfs". Implicitly inferred to be "ext4" if unspecified.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#iscsi
+	// TODO: how do we prevent errors in the filesystem from compromising the machine
+	// +optional
+	fsType?: string @go(FSType) @protobuf(5,bytes,opt)
+
+	// readOnly here will force the ReadOnly setting in VolumeMounts.
+	// Defaults to false.
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(6,varint,opt)
+
+	// portals is the iSCSI Target Portal List. The portal is either an IP or ip_addr:port if the port
+	// is other than default (typically TCP ports 860 and 3260).
+	// +optional
+	// +listType=atomic
+	portals?: [...string] @go(Portals,[]string) @protobuf(7,bytes,opt)
+
+	// chapAuthDiscovery defines whether support iSCSI Discovery CHAP authentication
+	// +optional
+	chapAuthDiscovery?: bool @go(DiscoveryCHAPAuth) @protobuf(8,varint,opt)
+
+	// chapAuthSession defines whether support iSCSI Session CHAP authentication
+	// +optional
+	chapAuthSession?: bool @go(SessionCHAPAuth) @protobuf(11,varint,opt)
+
+	// secretRef is the CHAP Secret for iSCSI target and initiator authentication
+	// +optional
+	secretRef?: #LocalObjectReference @go(SecretRef,*LocalObjectReference) @protobuf(10,bytes,opt)
+
+	// initiatorName is the custom iSCSI Initiator Name.
+	// If initiatorName is specified with iscsiInterface simultaneously, new iSCSI interface
+	// <target portal>:<volume name> will be created for the connection.
+	// +optional
+	initiatorName?: string @go(InitiatorName,*string) @protobuf(12,bytes,opt)
+}
+
+// ISCSIPersistentVolumeSource represents an ISCSI disk.
+// ISCSI volumes can only be mounted as read/write once.
+// ISCSI volumes support ownership management and SELinux relabeling.
+#ISCSIPersistentVolumeSource: {
+	// targetPortal is iSCSI Target Portal. The Portal is either an IP or ip_addr:port if the port
+	// is other than default (typically TCP ports 860 and 3260).
+	targetPortal: string @go(TargetPortal) @protobuf(1,bytes,opt)
+
+	// iqn is Target iSCSI Qualified Name.
+	iqn: string @go(IQN) @protobuf(2,bytes,opt)
+
+	// lun is iSCSI Target Lun number.
+	lun: int32 @go(Lun) @protobuf(3,varint,opt)
+
+	// iscsiInterface is the interface Name that uses an iSCSI transport.
+	// Defaults to 'default' (tcp).
+	// +optional
+	// +default="default"
+	iscsiInterface?: string @go(ISCSIInterface) @protobuf(4,bytes,opt)
+
+	// fsType is the filesystem type of the volume that you want to mount.
+	// Tip: Ensure that the filesystem type is supported by the host operating system.
+	// Examples: "ext4", "xfs", "ntfs". Implicitly inferred to be "ext4" if unspecified.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#iscsi
+	// TODO: how do we prevent errors in the filesystem from compromising the machine
+	// +optional
+	fsType?: string @go(FSType) @protobuf(5,bytes,opt)
+
+	// readOnly here will force the ReadOnly setting in VolumeMounts.
+	// Defaults to false.
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(6,varint,opt)
+
+	// portals is the iSCSI Target Portal List. The Portal is either an IP or ip_addr:port if the port
+	// is other than default (typically TCP ports 860 and 3260).
+	// +optional
+	// +listType=atomic
+	portals?: [...string] @go(Portals,[]string) @protobuf(7,bytes,opt)
+
+	// chapAuthDiscovery defines whether support iSCSI Discovery CHAP authentication
+	// +optional
+	chapAuthDiscovery?: bool @go(DiscoveryCHAPAuth) @protobuf(8,varint,opt)
+
+	// chapAuthSession defines whether support iSCSI Session CHAP authentication
+	// +optional
+	chapAuthSession?: bool @go(SessionCHAPAuth) @protobuf(11,varint,opt)
+
+	// secretRef is the CHAP Secret for iSCSI target and initiator authentication
+	// +optional
+	secretRef?: #SecretReference @go(SecretRef,*SecretReference) @protobuf(10,bytes,opt)
+
+	// initiatorName is the custom iSCSI Initiator Name.
+	// If initiatorName is specified with iscsiInterface simultaneously, new iSCSI interface
+	// <target portal>:<volume name> will be created for the connection.
+	// +optional
+	initiatorName?: string @go(InitiatorName,*string) @protobuf(12,bytes,opt)
+}
+
+// Represents a Fibre Channel volume.
+// Fibre Channel volumes can only be mounted as read/write once.
+// Fibre Channel volumes support ownership management and SELinux relabeling.
+#FCVolumeSource: {
+	// targetWWNs is Optional: FC target worldwide names (WWNs)
+	// +optional
+	// +listType=atomic
+	targetWWNs?: [...string] @go(TargetWWNs,[]string) @protobuf(1,bytes,rep)
+
+	// lun is Optional: FC target lun number
+	// +optional
+	lun?: int32 @go(Lun,*int32) @protobuf(2,varint,opt)
+
+	// fsType is the filesystem type to mount.
+	// Must be a filesystem type supported by the host operating system.
+	// Ex. "ext4", "xfs", "ntfs". Implicitly inferred to be "ext4" if unspecified.
+	// TODO: how do we prevent errors in the filesystem from compromising the machine
+	// +optional
+	fsType?: string @go(FSType) @protobuf(3,bytes,opt)
+
+	// readOnly is Optional: Defaults to false (read/write). ReadOnly here will force
+	// the ReadOnly setting in VolumeMounts.
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(4,varint,opt)
+
+	// wwids Optional: FC volume world wide identifiers (wwids)
+	// Either wwids or combination of targetWWNs and lun must be set, but not both simultaneously.
+	// +optional
+	// +listType=atomic
+	wwids?: [...string] @go(WWIDs,[]string) @protobuf(5,bytes,rep)
+}
+
+// AzureFile represents an Azure File Service mount on the host and bind mount to the pod.
+#AzureFileVolumeSource: {
+	// secretName is the  name of secret that contains Azure Storage Account Name and Key
+	secretName: string @go(SecretName) @protobuf(1,bytes,opt)
+
+	// shareName is the azure share Name
+	shareName: string @go(ShareName) @protobuf(2,bytes,opt)
+
+	// readOnly defaults to false (read/write). ReadOnly here will force
+	// the ReadOnly setting in VolumeMounts.
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(3,varint,opt)
+}
+
+// AzureFile represents an Azure File Service mount on the host and bind mount to the pod.
+#AzureFilePersistentVolumeSource: {
+	// secretName is the name of secret that contains Azure Storage Account Name and Key
+	secretName: string @go(SecretName) @protobuf(1,bytes,opt)
+
+	// shareName is the azure Share Name
+	shareName: string @go(ShareName) @protobuf(2,bytes,opt)
+
+	// readOnly defaults to false (read/write). ReadOnly here will force
+	// the ReadOnly setting in VolumeMounts.
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(3,varint,opt)
+
+	// secretNamespace is the namespace of the secret that contains Azure Storage Account Name and Key
+	// default is the same as the Pod
+	// +optional
+	secretNamespace?: string @go(SecretNamespace,*string) @protobuf(4,bytes,opt)
+}
+
+// Represents a vSphere volume resource.
+#VsphereVirtualDiskVolumeSource: {
+	// volumePath is the path that identifies vSphere volume vmdk
+	volumePath: string @go(VolumePath) @protobuf(1,bytes,opt)
+
+	// fsType is filesystem type to mount.
+	// Must be a filesystem type supported by the host operating system.
+	// Ex. "ext4", "xfs", "ntfs". Implicitly inferred to be "ext4" if unspecified.
+	// +optional
+	fsType?: string @go(FSType) @protobuf(2,bytes,opt)
+
+	// storagePolicyName is the storage Policy Based Management (SPBM) profile name.
+	// +optional
+	storagePolicyName?: string @go(StoragePolicyName) @protobuf(3,bytes,opt)
+
+	// storagePolicyID is the storage Policy Based Management (SPBM) profile ID associated with the StoragePolicyName.
+	// +optional
+	storagePolicyID?: string @go(StoragePolicyID) @protobuf(4,bytes,opt)
+}
+
+// Represents a Photon Controller persistent disk resource.
+#PhotonPersistentDiskVolumeSource: {
+	// pdID is the ID that identifies Photon Controller persistent disk
+	pdID: string @go(PdID) @protobuf(1,bytes,opt)
+
+	// fsType is the filesystem type to mount.
+	// Must be a filesystem type supported by the host operating system.
+	// Ex. "ext4", "xfs", "ntfs". Implicitly inferred to be "ext4" if unspecified.
+	fsType?: string @go(FSType) @protobuf(2,bytes,opt)
+}
+
+// +enum
+#AzureDataDiskCachingMode: string // #enumAzureDataDiskCachingMode
+
+#enumAzureDataDiskCachingMode:
+	#AzureDataDiskCachingNone |
+	#AzureDataDiskCachingReadOnly |
+	#AzureDataDiskCachingReadWrite
+
+// +enum
+#AzureDataDiskKind: string // #enumAzureDataDiskKind
+
+#enumAzureDataDiskKind:
+	#AzureSharedBlobDisk |
+	#AzureDedicatedBlobDisk |
+	#AzureManagedDisk
+
+#AzureDataDiskCachingNone:      #AzureDataDiskCachingMode & "None"
+#AzureDataDiskCachingReadOnly:  #AzureDataDiskCachingMode & "ReadOnly"
+#AzureDataDiskCachingReadWrite: #AzureDataDiskCachingMode & "ReadWrite"
+#AzureSharedBlobDisk:           #AzureDataDiskKind & "Shared"
+#AzureDedicatedBlobDisk:        #AzureDataDiskKind & "Dedicated"
+#AzureManagedDisk:              #AzureDataDiskKind & "Managed"
+
+// AzureDisk represents an Azure Data Disk mount on the host and bind mount to the pod.
+#AzureDiskVolumeSource: {
+	// diskName is the Name of the data disk in the blob storage
+	diskName: string @go(DiskName) @protobuf(1,bytes,opt)
+
+	// diskURI is the URI of data disk in the blob storage
+	diskURI: string @go(DataDiskURI) @protobuf(2,bytes,opt)
+
+	// cachingMode is the Host Caching mode: None, Read Only, Read Write.
+	// +optional
+	// +default=ref(AzureDataDiskCachingReadWrite)
+	cachingMode?: #AzureDataDiskCachingMode @go(CachingMode,*AzureDataDiskCachingMode) @protobuf(3,bytes,opt,casttype=AzureDataDiskCachingMode)
+
+	// fsType is Filesystem type to mount.
+	// Must be a filesystem type supported by the host operating system.
+	// Ex. "ext4", "xfs", "ntfs". Implicitly inferred to be "ext4" if unspecified.
+	// +optional
+	// +default="ext4"
+	fsType?: string @go(FSType,*string) @protobuf(4,bytes,opt)
+
+	// readOnly Defaults to false (read/write). ReadOnly here will force
+	// the ReadOnly setting in VolumeMounts.
+	// +optional
+	// +default=false
+	readOnly?: bool @go(ReadOnly,*bool) @protobuf(5,varint,opt)
+
+	// kind expected values are Shared: multiple blob disks per storage account  Dedicated: single blob disk per storage account  Managed: azure managed data disk (only in managed availability set). defaults to shared
+	// +default=ref(AzureSharedBlobDisk)
+	kind?: #AzureDataDiskKind @go(Kind,*AzureDataDiskKind) @protobuf(6,bytes,opt,casttype=AzureDataDiskKind)
+}
+
+// PortworxVolumeSource represents a Portworx volume resource.
+#PortworxVolumeSource: {
+	// volumeID uniquely identifies a Portworx volume
+	volumeID: string @go(VolumeID) @protobuf(1,bytes,opt)
+
+	// fSType represents the filesystem type to mount
+	// Must be a filesystem type supported by the host operating system.
+	// Ex. "ext4", "xfs". Implicitly inferred to be "ext4" if unspecified.
+	fsType?: string @go(FSType) @protobuf(2,bytes,opt)
+
+	// readOnly defaults to false (read/write). ReadOnly here will force
+	// the ReadOnly setting in VolumeMounts.
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(3,varint,opt)
+}
+
+// ScaleIOVolumeSource represents a persistent ScaleIO volume
+#ScaleIOVolumeSource: {
+	// gateway is the host address of the ScaleIO API Gateway.
+	gateway: string @go(Gateway) @protobuf(1,bytes,opt)
+
+	// system is the name of the storage system as configured in ScaleIO.
+	system: string @go(System) @protobuf(2,bytes,opt)
+
+	// secretRef references to the secret for ScaleIO user and other
+	// sensitive information. If this is not provided, Login operation will fail.
+	secretRef?: #LocalObjectReference @go(SecretRef,*LocalObjectReference) @protobuf(3,bytes,opt)
+
+	// sslEnabled Flag enable/disable SSL communication with Gateway, default false
+	// +optional
+	sslEnabled?: bool @go(SSLEnabled) @protobuf(4,varint,opt)
+
+	// protectionDomain is the name of the ScaleIO Protection Domain for the configured storage.
+	// +optional
+	protectionDomain?: string @go(ProtectionDomain) @protobuf(5,bytes,opt)
+
+	// storagePool is the ScaleIO Storage Pool associated with the protection domain.
+	// +optional
+	storagePool?: string @go(StoragePool) @protobuf(6,bytes,opt)
+
+	// storageMode indicates whether the storage for a volume should be ThickProvisioned or ThinProvisioned.
+	// Default is ThinProvisioned.
+	// +optional
+	// +default="ThinProvisioned"
+	storageMode?: string @go(StorageMode) @protobuf(7,bytes,opt)
+
+	// volumeName is the name of a volume already created in the ScaleIO system
+	// that is associated with this volume source.
+	volumeName?: string @go(VolumeName) @protobuf(8,bytes,opt)
+
+	// fsType is the filesystem type to mount.
+	// Must be a filesystem type supported by the host operating system.
+	// Ex. "ext4", "xfs", "ntfs".
+	// Default is "xfs".
+	// +optional
+	// +default="xfs"
+	fsType?: string @go(FSType) @protobuf(9,bytes,opt)
+
+	// readOnly Defaults to false (read/write). ReadOnly here will force
+	// the ReadOnly setting in VolumeMounts.
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(10,varint,opt)
+}
+
+// ScaleIOPersistentVolumeSource represents a persistent ScaleIO volume
+#ScaleIOPersistentVolumeSource: {
+	// gateway is the host address of the ScaleIO API Gateway.
+	gateway: string @go(Gateway) @protobuf(1,bytes,opt)
+
+	// system is the name of the storage system as configured in ScaleIO.
+	system: string @go(System) @protobuf(2,bytes,opt)
+
+	// secretRef references to the secret for ScaleIO user and other
+	// sensitive information. If this is not provided, Login operation will fail.
+	secretRef?: #SecretReference @go(SecretRef,*SecretReference) @protobuf(3,bytes,opt)
+
+	// sslEnabled is the flag to enable/disable SSL communication with Gateway, default false
+	// +optional
+	sslEnabled?: bool @go(SSLEnabled) @protobuf(4,varint,opt)
+
+	// protectionDomain is the name of the ScaleIO Protection Domain for the configured storage.
+	// +optional
+	protectionDomain?: string @go(ProtectionDomain) @protobuf(5,bytes,opt)
+
+	// storagePool is the ScaleIO Storage Pool associated with the protection domain.
+	// +optional
+	storagePool?: string @go(StoragePool) @protobuf(6,bytes,opt)
+
+	// storageMode indicates whether the storage for a volume should be ThickProvisioned or ThinProvisioned.
+	// Default is ThinProvisioned.
+	// +optional
+	// +default="ThinProvisioned"
+	storageMode?: string @go(StorageMode) @protobuf(7,bytes,opt)
+
+	// volumeName is the name of a volume already created in the ScaleIO system
+	// that is associated with this volume source.
+	volumeName?: string @go(VolumeName) @protobuf(8,bytes,opt)
+
+	// fsType is the filesystem type to mount.
+	// Must be a filesystem type supported by the host operating system.
+	// Ex. "ext4", "xfs", "ntfs".
+	// Default is "xfs"
+	// +optional
+	// +default="xfs"
+	fsType?: string @go(FSType) @protobuf(9,bytes,opt)
+
+	// readOnly defaults to false (read/write). ReadOnly here will force
+	// the ReadOnly setting in VolumeMounts.
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(10,varint,opt)
+}
+
+// Represents a StorageOS persistent volume resource.
+#StorageOSVolumeSource: {
+	// volumeName is the human-readable name of the StorageOS volume.  Volume
+	// names are only unique within a namespace.
+	volumeName?: string @go(VolumeName) @protobuf(1,bytes,opt)
+
+	// volumeNamespace specifies the scope of the volume within StorageOS.  If no
+	// namespace is specified then the Pod's namespace will be used.  This allows the
+	// Kubernetes name scoping to be mirrored within StorageOS for tighter integration.
+	// Set VolumeName to any name to override the default behaviour.
+	// Set to "default" if you are not using namespaces within StorageOS.
+	// Namespaces that do not pre-exist within StorageOS will be created.
+	// +optional
+	volumeNamespace?: string @go(VolumeNamespace) @protobuf(2,bytes,opt)
+
+	// fsType is the filesystem type to mount.
+	// Must be a filesystem type supported by the host operating system.
+	// Ex. "ext4", "xfs", "ntfs". Implicitly inferred to be "ext4" if unspecified.
+	// +optional
+	fsType?: string @go(FSType) @protobuf(3,bytes,opt)
+
+	// readOnly defaults to false (read/write). ReadOnly here will force
+	// the ReadOnly setting in VolumeMounts.
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(4,varint,opt)
+
+	// secretRef specifies the secret to use for obtaining the StorageOS API
+	// credentials.  If not specified, default values will be attempted.
+	// +optional
+	secretRef?: #LocalObjectReference @go(SecretRef,*LocalObjectReference) @protobuf(5,bytes,opt)
+}
+
+// Represents a StorageOS persistent volume resource.
+#StorageOSPersistentVolumeSource: {
+	// volumeName is the human-readable name of the StorageOS volume.  Volume
+	// names are only unique within a namespace.
+	volumeName?: string @go(VolumeName) @protobuf(1,bytes,opt)
+
+	// volumeNamespace specifies the scope of the volume within StorageOS.  If no
+	// namespace is specified then the Pod's namespace will be used.  This allows the
+	// Kubernetes name scoping to be mirrored within StorageOS for tighter integration.
+	// Set VolumeName to any name to override the default behaviour.
+	// Set to "default" if you are not using namespaces within StorageOS.
+	// Namespaces that do not pre-exist within StorageOS will be created.
+	// +optional
+	volumeNamespace?: string @go(VolumeNamespace) @protobuf(2,bytes,opt)
+
+	// fsType is the filesystem type to mount.
+	// Must be a filesystem type supported by the host operating system.
+	// Ex. "ext4", "xfs", "ntfs". Implicitly inferred to be "ext4" if unspecified.
+	// +optional
+	fsType?: string @go(FSType) @protobuf(3,bytes,opt)
+
+	// readOnly defaults to false (read/write). ReadOnly here will force
+	// the ReadOnly setting in VolumeMounts.
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(4,varint,opt)
+
+	// secretRef specifies the secret to use for obtaining the StorageOS API
+	// credentials.  If not specified, default values will be attempted.
+	// +optional
+	secretRef?: #ObjectReference @go(SecretRef,*ObjectReference) @protobuf(5,bytes,opt)
+}
+
+// Adapts a ConfigMap into a volume.
+//
+// The contents of the target ConfigMap's Data field will be presented in a
+// volume as files using the keys in the Data field as the file names, unless
+// the items element is populated with specific mappings of keys to paths.
+// ConfigMap volumes support ownership management and SELinux relabeling.
+#ConfigMapVolumeSource: {
+	#LocalObjectReference
+
+	// items if unspecified, each key-value pair in the Data field of the referenced
+	// ConfigMap will be projected into the volume as a file whose name is the
+	// key and content is the value. If specified, the listed keys will be
+	// projected into the specified paths, and unlisted keys will not be
+	// present. If a key is specified which is not present in the ConfigMap,
+	// the volume setup will error unless it is marked optional. Paths must be
+	// relative and may not contain the '..' path or start with '..'.
+	// +optional
+	// +listType=atomic
+	items?: [...#KeyToPath] @go(Items,[]KeyToPath) @protobuf(2,bytes,rep)
+
+	// defaultMode is optional: mode bits used to set permissions on created files by default.
+	// Must be an octal value between 0000 and 0777 or a decimal value between 0 and 511.
+	// YAML accepts both octal and decimal values, JSON requires decimal values for mode bits.
+	// Defaults to 0644.
+	// Directories within the path are not affected by this setting.
+	// This might be in conflict with other options that affect the file
+	// mode, like fsGroup, and the result can be other mode bits set.
+	// +optional
+	defaultMode?: int32 @go(DefaultMode,*int32) @protobuf(3,varint,opt)
+
+	// optional specify whether the ConfigMap or its keys must be defined
+	// +optional
+	optional?: bool @go(Optional,*bool) @protobuf(4,varint,opt)
+}
+
+#ConfigMapVolumeSourceDefaultMode: int32 & 0o644
+
+// Adapts a ConfigMap into a projected volume.
+//
+// The contents of the target ConfigMap's Data field will be presented in a
+// projected volume as files using the keys in the Data field as the file names,
+// unless the items element is populated with specific mappings of keys to paths.
+// Note that this is identical to a configmap volume source without the default
+// mode.
+#ConfigMapProjection: {
+	#LocalObjectReference
+
+	// items if unspecified, each key-value pair in the Data field of the referenced
+	// ConfigMap will be projected into the volume as a file whose name is the
+	// key and content is the value. If specified, the listed keys will be
+	// projected into the specified paths, and unlisted keys will not be
+	// present. If a key is specified which is not present in the ConfigMap,
+	// the volume setup will error unless it is marked optional. Paths must be
+	// relative and may not contain the '..' path or start with '..'.
+	// +optional
+	// +listType=atomic
+	items?: [...#KeyToPath] @go(Items,[]KeyToPath) @protobuf(2,bytes,rep)
+
+	// optional specify whether the ConfigMap or its keys must be defined
+	// +optional
+	optional?: bool @go(Optional,*bool) @protobuf(4,varint,opt)
+}
+
+// ServiceAccountTokenProjection represents a projected service account token
+// volume. This projection can be used to insert a service account token into
+// the pods runtime filesystem for use against APIs (Kubernetes API Server or
+// otherwise).
+#ServiceAccountTokenProjection: {
+	// audience is the intended audience of the token. A recipient of a token
+	// must identify itself with an identifier specified in the audience of the
+	// token, and otherwise should reject the token. The audience defaults to the
+	// identifier of the apiserver.
+	// +optional
+	audience?: string @go(Audience) @protobuf(1,bytes,rep)
+
+	// expirationSeconds is the requested duration of validity of the service
+	// account token. As the token approaches expiration, the kubelet volume
+	// plugin will proactively rotate the service account token. The kubelet will
+	// start trying to rotate the token if the token is older than 80 percent of
+	// its time to live or if the token is older than 24 hours.Defaults to 1 hour
+	// and must be at least 10 minutes.
+	// +optional
+	expirationSeconds?: int64 @go(ExpirationSeconds,*int64) @protobuf(2,varint,opt)
+
+	// path is the path relative to the mount point of the file to project the
+	// token into.
+	path: string @go(Path) @protobuf(3,bytes,opt)
+}
+
+// ClusterTrustBundleProjection describes how to select a set of
+// ClusterTrustBundle objects and project their contents into the pod
+// filesystem.
+#ClusterTrustBundleProjection: {
+	// Select a single ClusterTrustBundle by object name.  Mutually-exclusive
+	// with signerName and labelSelector.
+	// +optional
+	name?: string @go(Name,*string) @protobuf(1,bytes,rep)
+
+	// Select all ClusterTrustBundles that match this signer name.
+	// Mutually-exclusive with name.  The contents of all selected
+	// ClusterTrustBundles will be unified and deduplicated.
+	// +optional
+	signerName?: string @go(SignerName,*string) @protobuf(2,bytes,rep)
+
+	// Select all ClusterTrustBundles that match this label selector.  Only has
+	// effect if signerName is set.  Mutually-exclusive with name.  If unset,
+	// interpreted as "match nothing".  If set but empty, interpreted as "match
+	// everything".
+	// +optional
+	labelSelector?: metav1.#LabelSelector @go(LabelSelector,*metav1.LabelSelector) @protobuf(3,bytes,rep)
+
+	// If true, don't block pod startup if the referenced ClusterTrustBundle(s)
+	// aren't available.  If using name, then the named ClusterTrustBundle is
+	// allowed not to exist.  If using signerName, then the combination of
+	// signerName and labelSelector is allowed to match zero
+	// ClusterTrustBundles.
+	// +optional
+	optional?: bool @go(Optional,*bool) @protobuf(5,varint,opt)
+
+	// Relative path from the volume root to write the bundle.
+	path: string @go(Path) @protobuf(4,bytes,rep)
+}
+
+// PodCertificateProjection provides a private key and X.509 certificate in the
+// pod filesystem.
+#PodCertificateProjection: {
+	// Kubelet's generated CSRs will be addressed to this signer.
+	//
+	// +required
+	signerName: string @go(SignerName) @protobuf(1,bytes,rep)
+
+	// The type of keypair Kubelet will generate for the pod.
+	//
+	// Valid values are "RSA3072", "RSA4096", "ECDSAP256", "ECDSAP384",
+	// "ECDSAP521", and "ED25519".
+	//
+	// +required
+	keyType: string @go(KeyType) @protobuf(2,bytes,rep)
+
+	// maxExpirationSeconds is the maximum lifetime permitted for the
+	// certificate.
+	//
+	// Kubelet copies this value verbatim into the PodCertificateRequests it
+	// generates for this projection.
+	//
+	// If omitted, kube-apiserver will set it to 86400(24 hours). kube-apiserver
+	// will reject values shorter than 3600 (1 hour).  The maximum allowable
+	// value is 7862400 (91 days).
+	//
+	// The signer implementation is then free to issue a certificate with any
+	// lifetime *shorter* than MaxExpirationSeconds, but no shorter than 3600
+	// seconds (1 hour).  This constraint is enforced by kube-apiserver.
+	// `kubernetes.io` signers will never issue certificates with a lifetime
+	// longer than 24 hours.
+	//
+	// +optional
+	maxExpirationSeconds?: int32 @go(MaxExpirationSeconds,*int32) @protobuf(3,varint,opt)
+
+	// Write the credential bundle at this path in the projected volume.
+	//
+	// The credential bundle is a single file that contains multiple PEM blocks.
+	// The first PEM block is a PRIVATE KEY block, containing a PKCS#8 private
+	// key.
+	//
+	// The remaining blocks are CERTIFICATE blocks, containing the issued
+	// certificate chain from the signer (leaf and any intermediates).
+	//
+	// Using credentialBundlePath lets your Pod's application code make a single
+	// atomic read that retrieves a consistent key and certificate chain.  If you
+	// project them to separate files, your application code will need to
+	// additionally check that the leaf certificate was issued to the key.
+	//
+	// +optional
+	credentialBundlePath?: string @go(CredentialBundlePath) @protobuf(4,bytes,rep)
+
+	// Write the key at this path in the projected volume.
+	//
+	// Most applications should use credentialBundlePath.  When using keyPath
+	// and certificateChainPath, your application needs to check that the key
+	// and leaf certificate are consistent, because it is possible to read the
+	// files mid-rotation.
+	//
+	// +optional
+	keyPath?: string @go(KeyPath) @protobuf(5,bytes,rep)
+
+	// Write the certificate chain at this path in the projected volume.
+	//
+	// Most applications should use credentialBundlePath.  When using keyPath
+	// and certificateChainPath, your application needs to check that the key
+	// and leaf certificate are consistent, because it is possible to read the
+	// files mid-rotation.
+	//
+	// +optional
+	certificateChainPath?: string @go(CertificateChainPath) @protobuf(6,bytes,rep)
+
+	// userAnnotations allow pod authors to pass additional information to
+	// the signer implementation.  Kubernetes does not restrict or validate this
+	// metadata in any way.
+	//
+	// These values are copied verbatim into the `spec.unverifiedUserAnnotations` field of
+	// the PodCertificateRequest objects that Kubelet creates.
+	//
+	// Entries are subject to the same validation as object metadata annotations,
+	// with the addition that all keys must be domain-prefixed. No restrictions
+	// are placed on values, except an overall size limitation on the entire field.
+	//
+	// Signers should document the keys and values they support. Signers should
+	// deny requests that contain keys they do not recognize.
+	userAnnotations?: {[string]: string} @go(UserAnnotations,map[string]string) @protobuf(7,bytes,rep)
+}
+
+// Represents a projected volume source
+#ProjectedVolumeSource: {
+	// sources is the list of volume projections. Each entry in this list
+	// handles one source.
+	// +optional
+	// +listType=atomic
+	sources?: [...#VolumeProjection] @go(Sources,[]VolumeProjection) @protobuf(1,bytes,rep)
+
+	// defaultMode are the mode bits used to set permissions on created files by default.
+	// Must be an octal value between 0000 and 0777 or a decimal value between 0 and 511.
+	// YAML accepts both octal and decimal values, JSON requires decimal values for mode bits.
+	// Directories within the path are not affected by this setting.
+	// This might be in conflict with other options that affect the file
+	// mode, like fsGroup, and the result can be other mode bits set.
+	// +optional
+	defaultMode?: int32 @go(DefaultMode,*int32) @protobuf(2,varint,opt)
+}
+
+// Projection that may be projected along with other supported volume types.
+// Exactly one of these fields must be set.
+#VolumeProjection: {
+	// secret information about the secret data to project
+	// +optional
+	secret?: #SecretProjection @go(Secret,*SecretProjection) @protobuf(1,bytes,opt)
+
+	// downwardAPI information about the downwardAPI data to project
+	// +optional
+	downwardAPI?: #DownwardAPIProjection @go(DownwardAPI,*DownwardAPIProjection) @protobuf(2,bytes,opt)
+
+	// configMap information about the configMap data to project
+	// +optional
+	configMap?: #ConfigMapProjection @go(ConfigMap,*ConfigMapProjection) @protobuf(3,bytes,opt)
+
+	// serviceAccountToken is information about the serviceAccountToken data to project
+	// +optional
+	serviceAccountToken?: #ServiceAccountTokenProjection @go(ServiceAccountToken,*ServiceAccountTokenProjection) @protobuf(4,bytes,opt)
+
+	// ClusterTrustBundle allows a pod to access the `.spec.trustBundle` field
+	// of ClusterTrustBundle objects in an auto-updating file.
+	//
+	// Alpha, gated by the ClusterTrustBundleProjection feature gate.
+	//
+	// ClusterTrustBundle objects can either be selected by name, or by the
+	// combination of signer name and a label selector.
+	//
+	// Kubelet performs aggressive normalization of the PEM contents written
+	// into the pod filesystem.  Esoteric PEM features such as inter-block
+	// comments and block headers are stripped.  Certificates are deduplicated.
+	// The ordering of certificates within the file is arbitrary, and Kubelet
+	// may change the order over time.
+	//
+	// +featureGate=ClusterTrustBundleProjection
+	// +optional
+	clusterTrustBundle?: #ClusterTrustBundleProjection @go(ClusterTrustBundle,*ClusterTrustBundleProjection) @protobuf(5,bytes,opt)
+
+	// Projects an auto-rotating credential bundle (private key and certificate
+	// chain) that the pod can use either as a TLS client or server.
+	//
+	// Kubelet generates a private key and uses it to send a
+	// PodCertificateRequest to the named signer.  Once the signer approves the
+	// request and issues a certificate chain, Kubelet writes the key and
+	// certificate chain to the pod filesystem.  The pod does not start until
+	// certificates have been issued for each podCertificate projected volume
+	// source in its spec.
+	//
+	// Kubelet will begin trying to rotate the certificate at the time indicated
+	// by the signer using the PodCertificateRequest.Status.BeginRefreshAt
+	// timestamp.
+	//
+	// Kubelet can write a single file, indicated by the credentialBundlePath
+	// field, or separate files, indicated by the keyPath and
+	// certificateChainPath fields.
+	//
+	// The credential bundle is a single file in PEM format.  The first PEM
+	// entry is the private key (in PKCS#8 format), and the remaining PEM
+	// entries are the certificate chain issued by the signer (typically,
+	// signers will return their certificate chain in leaf-to-root order).
+	//
+	// Prefer using the credential bundle format, since your application code
+	// can read it atomically.  If you use keyPath and certificateChainPath,
+	// your application must make two separate file reads. If these coincide
+	// with a certificate rotation, it is possible that the private key and leaf
+	// certificate you read may not correspond to each other.  Your application
+	// will need to check for this condition, and re-read until they are
+	// consistent.
+	//
+	// The named signer controls chooses the format of the certificate it
+	// issues; consult the signer implementation's documentation to learn how to
+	// use the certificates it issues.
+	//
+	// +featureGate=PodCertificateProjection
+	// +optional
+	podCertificate?: #PodCertificateProjection @go(PodCertificate,*PodCertificateProjection) @protobuf(6,bytes,opt)
+}
+
+#ProjectedVolumeSourceDefaultMode: int32 & 0o644
+
+// Maps a string key to a path within a volume.
+#KeyToPath: {
+	// key is the key to project.
+	key: string @go(Key) @protobuf(1,bytes,opt)
+
+	// path is the relative path of the file to map the key to.
+	// May not be an absolute path.
+	// May not contain the path element '..'.
+	// May not start with the string '..'.
+	path: string @go(Path) @protobuf(2,bytes,opt)
+
+	// mode is Optional: mode bits used to set permissions on this file.
+	// Must be an octal value between 0000 and 0777 or a decimal value between 0 and 511.
+	// YAML accepts both octal and decimal values, JSON requires decimal values for mode bits.
+	// If not specified, the volume defaultMode will be used.
+	// This might be in conflict with other options that affect the file
+	// mode, like fsGroup, and the result can be other mode bits set.
+	// +optional
+	mode?: int32 @go(Mode,*int32) @protobuf(3,varint,opt)
+}
+
+// Local represents directly-attached storage with node affinity
+#LocalVolumeSource: {
+	// path of the full path to the volume on the node.
+	// It can be either a directory or block device (disk, partition, ...).
+	path: string @go(Path) @protobuf(1,bytes,opt)
+
+	// fsType is the filesystem type to mount.
+	// It applies only when the Path is a block device.
+	// Must be a filesystem type supported by the host operating system.
+	// Ex. "ext4", "xfs", "ntfs". The default value is to auto-select a filesystem if unspecified.
+	// +optional
+	fsType?: string @go(FSType,*string) @protobuf(2,bytes,opt)
+}
+
+// Represents storage that is managed by an external CSI volume driver
+#CSIPersistentVolumeSource: {
+	// driver is the name of the driver to use for this volume.
+	// Required.
+	driver: string @go(Driver) @protobuf(1,bytes,opt)
+
+	// volumeHandle is the unique volume name returned by the CSI volume
+	// plugin’s CreateVolume to refer to the volume on all subsequent calls.
+	// Required.
+	volumeHandle: string @go(VolumeHandle) @protobuf(2,bytes,opt)
+
+	// readOnly value to pass to ControllerPublishVolumeRequest.
+	// Defaults to false (read/write).
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(3,varint,opt)
+
+	// fsType to mount. Must be a filesystem type supported by the host operating system.
+	// Ex. "ext4", "xfs", "ntfs".
+	// +optional
+	fsType?: string @go(FSType) @protobuf(4,bytes,opt)
+
+	// volumeAttributes of the volume to publish.
+	// +optional
+	volumeAttributes?: {[string]: string} @go(VolumeAttributes,map[string]string) @protobuf(5,bytes,rep)
+
+	// controllerPublishSecretRef is a reference to the secret object containing
+	// sensitive information to pass to the CSI driver to complete the CSI
+	// ControllerPublishVolume and ControllerUnpublishVolume calls.
+	// This field is optional, and may be empty if no secret is required. If the
+	// secret object contains more than one secret, all secrets are passed.
+	// +optional
+	controllerPublishSecretRef?: #SecretReference @go(ControllerPublishSecretRef,*SecretReference) @protobuf(6,bytes,opt)
+
+	// nodeStageSecretRef is a reference to the secret object containing sensitive
+	// information to pass to the CSI driver to complete the CSI NodeStageVolume
+	// and NodeStageVolume and NodeUnstageVolume calls.
+	// This field is optional, and may be empty if no secret is required. If the
+	// secret object contains more than one secret, all secrets are passed.
+	// +optional
+	nodeStageSecretRef?: #SecretReference @go(NodeStageSecretRef,*SecretReference) @protobuf(7,bytes,opt)
+
+	// nodePublishSecretRef is a reference to the secret object containing
+	// sensitive information to pass to the CSI driver to complete the CSI
+	// NodePublishVolume and NodeUnpublishVolume calls.
+	// This field is optional, and may be empty if no secret is required. If the
+	// secret object contains more than one secret, all secrets are passed.
+	// +optional
+	nodePublishSecretRef?: #SecretReference @go(NodePublishSecretRef,*SecretReference) @protobuf(8,bytes,opt)
+
+	// controllerExpandSecretRef is a reference to the secret object containing
+	// sensitive information to pass to the CSI driver to complete the CSI
+	// ControllerExpandVolume call.
+	// This field is optional, and may be empty if no secret is required. If the
+	// secret object contains more than one secret, all secrets are passed.
+	// +optional
+	controllerExpandSecretRef?: #SecretReference @go(ControllerExpandSecretRef,*SecretReference) @protobuf(9,bytes,opt)
+
+	// nodeExpandSecretRef is a reference to the secret object containing
+	// sensitive information to pass to the CSI driver to complete the CSI
+	// NodeExpandVolume call.
+	// This field is optional, may be omitted if no secret is required. If the
+	// secret object contains more than one secret, all secrets are passed.
+	// +optional
+	nodeExpandSecretRef?: #SecretReference @go(NodeExpandSecretRef,*SecretReference) @protobuf(10,bytes,opt)
+}
+
+// Represents a source location of a volume to mount, managed by an external CSI driver
+#CSIVolumeSource: {
+	// driver is the name of the CSI driver that handles this volume.
+	// Consult with your admin for the correct name as registered in the cluster.
+	driver: string @go(Driver) @protobuf(1,bytes,opt)
+
+	// readOnly specifies a read-only configuration for the volume.
+	// Defaults to false (read/write).
+	// +optional
+	readOnly?: bool @go(ReadOnly,*bool) @protobuf(2,varint,opt)
+
+	// fsType to mount. Ex. "ext4", "xfs", "ntfs".
+	// If not provided, the empty value is passed to the associated CSI driver
+	// which will determine the default filesystem to apply.
+	// +optional
+	fsType?: string @go(FSType,*string) @protobuf(3,bytes,opt)
+
+	// volumeAttributes stores driver-specific properties that are passed to the CSI
+	// driver. Consult your driver's documentation for supported values.
+	// +optional
+	volumeAttributes?: {[string]: string} @go(VolumeAttributes,map[string]string) @protobuf(4,bytes,rep)
+
+	// nodePublishSecretRef is a reference to the secret object containing
+	// sensitive information to pass to the CSI driver to complete the CSI
+	// NodePublishVolume and NodeUnpublishVolume calls.
+	// This field is optional, and  may be empty if no secret is required. If the
+	// secret object contains more than one secret, all secret references are passed.
+	// +optional
+	nodePublishSecretRef?: #LocalObjectReference @go(NodePublishSecretRef,*LocalObjectReference) @protobuf(5,bytes,opt)
+}
+
+// Represents an ephemeral volume that is handled by a normal storage driver.
+#EphemeralVolumeSource: {
+	// Will be used to create a stand-alone PVC to provision the volume.
+	// The pod in which this EphemeralVolumeSource is embedded will be the
+	// owner of the PVC, i.e. the PVC will be deleted together with the
+	// pod.  The name of the PVC will be `<pod name>-<volume name>` where
+	// `<volume name>` is the name from the `PodSpec.Volumes` array
+	// entry. Pod validation will reject the pod if the concatenated name
+	// is not valid for a PVC (for example, too long).
+	//
+	// An existing PVC with that name that is not owned by the pod
+	// will *not* be used for the pod to avoid using an unrelated
+	// volume by mistake. Starting the pod is then blocked until
+	// the unrelated PVC is removed. If such a pre-created PVC is
+	// meant to be used by the pod, the PVC has to updated with an
+	// owner reference to the pod once the pod exists. Normally
+	// this should not be necessary, but it may be useful when
+	// manually reconstructing a broken cluster.
+	//
+	// This field is read-only and no changes will be made by Kubernetes
+	// to the PVC after it has been created.
+	//
+	// Required, must not be nil.
+	volumeClaimTemplate?: #PersistentVolumeClaimTemplate @go(VolumeClaimTemplate,*PersistentVolumeClaimTemplate) @protobuf(1,bytes,opt)
+}
+
+// PersistentVolumeClaimTemplate is used to produce
+// PersistentVolumeClaim objects as part of an EphemeralVolumeSource.
+#PersistentVolumeClaimTemplate: {
+	// May contain labels and annotations that will be copied into the PVC
+	// when creating it. No other fields are allowed and will be rejected during
+	// validation.
+	//
+	// +optional
+	metadata?: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// The specification for the PersistentVolumeClaim. The entire content is
+	// copied unchanged into the PVC that gets created from this
+	// template. The same fields as in a PersistentVolumeClaim
+	// are also valid here.
+	spec: #PersistentVolumeClaimSpec @go(Spec) @protobuf(2,bytes)
+}
+
+// ContainerPort represents a network port in a single container.
+#ContainerPort: {
+	// If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
+	// named port in a pod must have a unique name. Name for the port that can be
+	// referred to by services.
+	// +optional
+	name?: string @go(Name) @protobuf(1,bytes,opt)
+
+	// Number of port to expose on the host.
+	// If specified, this must be a valid port number, 0 < x < 65536.
+	// If HostNetwork is specified, this must match ContainerPort.
+	// Most containers do not need this.
+	// +optional
+	hostPort?: int32 @go(HostPort) @protobuf(2,varint,opt)
+
+	// Number of port to expose on the pod's IP address.
+	// This must be a valid port number, 0 < x < 65536.
+	containerPort: int32 @go(ContainerPort) @protobuf(3,varint,opt)
+
+	// Protocol for port. Must be UDP, TCP, or SCTP.
+	// Defaults to "TCP".
+	// +optional
+	// +default="TCP"
+	protocol?: #Protocol @go(Protocol) @protobuf(4,bytes,opt,casttype=Protocol)
+
+	// What host IP to bind the external port to.
+	// +optional
+	hostIP?: string @go(HostIP) @protobuf(5,bytes,opt)
+}
+
+// VolumeMount describes a mounting of a Volume within a container.
+#VolumeMount: {
+	// This must match the Name of a Volume.
+	name: string @go(Name) @protobuf(1,bytes,opt)
+
+	// Mounted read-only if true, read-write otherwise (false or unspecified).
+	// Defaults to false.
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(2,varint,opt)
+
+	// RecursiveReadOnly specifies whether read-only mounts should be handled
+	// recursively.
+	//
+	// If ReadOnly is false, this field has no meaning and must be unspecified.
+	//
+	// If ReadOnly is true, and this field is set to Disabled, the mount is not made
+	// recursively read-only.  If this field is set to IfPossible, the mount is made
+	// recursively read-only, if it is supported by the container runtime.  If this
+	// field is set to Enabled, the mount is made recursively read-only if it is
+	// supported by the container runtime, otherwise the pod will not be started and
+	// an error will be generated to indicate the reason.
+	//
+	// If this field is set to IfPossible or Enabled, MountPropagation must be set to
+	// None (or be unspecified, which defaults to None).
+	//
+	// If this field is not specified, it is treated as an equivalent of Disabled.
+	// +optional
+	recursiveReadOnly?: #RecursiveReadOnlyMode @go(RecursiveReadOnly,*RecursiveReadOnlyMode) @protobuf(7,bytes,opt,casttype=RecursiveReadOnlyMode)
+
+	// Path within the container at which the volume should be mounted.  Must
+	// not contain ':'.
+	mountPath: string @go(MountPath) @protobuf(3,bytes,opt)
+
+	// Path within the volume from which the container's volume should be mounted.
+	// Defaults to "" (volume's root).
+	// +optional
+	subPath?: string @go(SubPath) @protobuf(4,bytes,opt)
+
+	// mountPropagation determines how mounts are propagated from the host
+	// to container and the other way around.
+	// When not set, MountPropagationNone is used.
+	// This field is beta in 1.10.
+	// When RecursiveReadOnly is set to IfPossible or to Enabled, MountPropagation must be None or unspecified
+	// (which defaults to None).
+	// +optional
+	mountPropagation?: #MountPropagationMode @go(MountPropagation,*MountPropagationMode) @protobuf(5,bytes,opt,casttype=MountPropagationMode)
+
+	// Expanded path within the volume from which the container's volume should be mounted.
+	// Behaves similarly to SubPath but environment variable references $(VAR_NAME) are expanded using the container's environment.
+	// Defaults to "" (volume's root).
+	// SubPathExpr and SubPath are mutually exclusive.
+	// +optional
+	subPathExpr?: string @go(SubPathExpr) @protobuf(6,bytes,opt)
+}
+
+// MountPropagationMode describes mount propagation.
+// +enum
+#MountPropagationMode: string // #enumMountPropagationMode
+
+#enumMountPropagationMode:
+	#MountPropagationNone |
+	#MountPropagationHostToContainer |
+	#MountPropagationBidirectional
+
+// MountPropagationNone means that the volume in a container will
+// not receive new mounts from the host or other containers, and filesystems
+// mounted inside the container won't be propagated to the host or other
+// containers.
+// Note that this mode corresponds to "private" in Linux terminology.
+#MountPropagationNone: #MountPropagationMode & "None"
+
+// MountPropagationHostToContainer means that the volume in a container will
+// receive new mounts from the host or other containers, but filesystems
+// mounted inside the container won't be propagated to the host or other
+// containers.
+// Note that this mode is recursively applied to all mounts in the volume
+// ("rslave" in Linux terminology).
+#MountPropagationHostToContainer: #MountPropagationMode & "HostToContainer"
+
+// MountPropagationBidirectional means that the volume in a container will
+// receive new mounts from the host or other containers, and its own mounts
+// will be propagated from the container to the host or other containers.
+// Note that this mode is recursively applied to all mounts in the volume
+// ("rshared" in Linux terminology).
+#MountPropagationBidirectional: #MountPropagationMode & "Bidirectional"
+
+// RecursiveReadOnlyMode describes recursive-readonly mode.
+#RecursiveReadOnlyMode: string // #enumRecursiveReadOnlyMode
+
+#enumRecursiveReadOnlyMode:
+	#RecursiveReadOnlyDisabled |
+	#RecursiveReadOnlyIfPossible |
+	#RecursiveReadOnlyEnabled
+
+// RecursiveReadOnlyDisabled disables recursive-readonly mode.
+#RecursiveReadOnlyDisabled: #RecursiveReadOnlyMode & "Disabled"
+
+// RecursiveReadOnlyIfPossible enables recursive-readonly mode if possible.
+#RecursiveReadOnlyIfPossible: #RecursiveReadOnlyMode & "IfPossible"
+
+// RecursiveReadOnlyEnabled enables recursive-readonly mode, or raise an error.
+#RecursiveReadOnlyEnabled: #RecursiveReadOnlyMode & "Enabled"
+
+// volumeDevice describes a mapping of a raw block device within a container.
+#VolumeDevice: {
+	// name must match the name of a persistentVolumeClaim in the pod
+	name: string @go(Name) @protobuf(1,bytes,opt)
+
+	// devicePath is the path inside of the container that the device will be mapped to.
+	devicePath: string @go(DevicePath) @protobuf(2,bytes,opt)
+}
+
+// EnvVar represents an environment variable present in a Container.
+#EnvVar: {
+	// Name of the environment variable.
+	// May consist of any printable ASCII characters except '='.
+	name: string @go(Name) @protobuf(1,bytes,opt)
+
+	// Variable references $(VAR_NAME) are expanded
+	// using the previously defined environment variables in the container and
+	// any service environment variables. If a variable cannot be resolved,
+	// the reference in the input string will be unchanged. Double $$ are reduced
+	// to a single $, which allows for escaping the $(VAR_NAME) syntax: i.e.
+	// "$$(VAR_NAME)" will produce the string literal "$(VAR_NAME)".
+	// Escaped references will never be expanded, regardless of whether the variable
+	// exists or not.
+	// Defaults to "".
+	// +optional
+	value?: string @go(Value) @protobuf(2,bytes,opt)
+
+	// Source for the environment variable's value. Cannot be used if value is not empty.
+	// +optional
+	valueFrom?: #EnvVarSource @go(ValueFrom,*EnvVarSource) @protobuf(3,bytes,opt)
+}
+
+// EnvVarSource represents a source for the value of an EnvVar.
+#EnvVarSource: {
+	// Selects a field of the pod: supports metadata.name, metadata.namespace, `metadata.labels['<KEY>']`, `metadata.annotations['<KEY>']`,
+	// spec.nodeName, spec.serviceAccountName, status.hostIP, status.podIP, status.podIPs.
+	// +optional
+	fieldRef?: #ObjectFieldSelector @go(FieldRef,*ObjectFieldSelector) @protobuf(1,bytes,opt)
+
+	// Selects a resource of the container: only resources limits and requests
+	// (limits.cpu, limits.memory, limits.ephemeral-storage, requests.cpu, requests.memory and requests.ephemeral-storage) are currently supported.
+	// +optional
+	resourceFieldRef?: #ResourceFieldSelector @go(ResourceFieldRef,*ResourceFieldSelector) @protobuf(2,bytes,opt)
+
+	// Selects a key of a ConfigMap.
+	// +optional
+	configMapKeyRef?: #ConfigMapKeySelector @go(ConfigMapKeyRef,*ConfigMapKeySelector) @protobuf(3,bytes,opt)
+
+	// Selects a key of a secret in the pod's namespace
+	// +optional
+	secretKeyRef?: #SecretKeySelector @go(SecretKeyRef,*SecretKeySelector) @protobuf(4,bytes,opt)
+
+	// FileKeyRef selects a key of the env file.
+	// Requires the EnvFiles feature gate to be enabled.
+	//
+	// +featureGate=EnvFiles
+	// +optional
+	fileKeyRef?: #FileKeySelector @go(FileKeyRef,*FileKeySelector) @protobuf(5,bytes,opt)
+}
+
+// FileKeySelector selects a key of the env file.
+// +structType=atomic
+#FileKeySelector: {
+	// The name of the volume mount containing the env file.
+	// +required
+	volumeName: string @go(VolumeName) @protobuf(1,bytes,opt)
+
+	// The path within the volume from which to select the file.
+	// Must be relative and may not contain the '..' path or start with '..'.
+	// +required
+	path: string @go(Path) @protobuf(2,bytes,opt)
+
+	// The key within the env file. An invalid key will prevent the pod from starting.
+	// The keys defined within a source may consist of any printable ASCII characters except '='.
+	// During Alpha stage of the EnvFiles feature gate, the key size is limited to 128 characters.
+	// +required
+	key: string @go(Key) @protobuf(3,bytes,opt)
+
+	// Specify whether the file or its key must be defined. If the file or key
+	// does not exist, then the env var is not published.
+	// If optional is set to true and the specified key does not exist,
+	// the environment variable will not be set in the Pod's containers.
+	//
+	// If optional is set to false and the specified key does not exist,
+	// an error will be returned during Pod creation.
+	// +optional
+	// +default=false
+	optional?: bool @go(Optional,*bool) @protobuf(4,varint,opt)
+}
+
+// ObjectFieldSelector selects an APIVersioned field of an object.
+// +structType=atomic
+#ObjectFieldSelector: {
+	// Version of the schema the FieldPath is written in terms of, defaults to "v1".
+	// +optional
+	apiVersion?: string @go(APIVersion) @protobuf(1,bytes,opt)
+
+	// Path of the field to select in the specified API version.
+	fieldPath: string @go(FieldPath) @protobuf(2,bytes,opt)
+}
+
+// ResourceFieldSelector represents container resources (cpu, memory) and their output format
+// +structType=atomic
+#ResourceFieldSelector: {
+	// Container name: required for volumes, optional for env vars
+	// +optional
+	containerName?: string @go(ContainerName) @protobuf(1,bytes,opt)
+
+	// Required: resource to select
+	resource: string @go(Resource) @protobuf(2,bytes,opt)
+
+	// Specifies the output format of the exposed resources, defaults to "1"
+	// +optional
+	divisor?: resource_9.#Quantity @go(Divisor) @protobuf(3,bytes,opt)
+}
+
+// Selects a key from a ConfigMap.
+// +structType=atomic
+#ConfigMapKeySelector: {
+	#LocalObjectReference
+
+	// The key to select.
+	key: string @go(Key) @protobuf(2,bytes,opt)
+
+	// Specify whether the ConfigMap or its key must be defined
+	// +optional
+	optional?: bool @go(Optional,*bool) @protobuf(3,varint,opt)
+}
+
+// SecretKeySelector selects a key of a Secret.
+// +structType=atomic
+#SecretKeySelector: {
+	#LocalObjectReference
+
+	// The key of the secret to select from.  Must be a valid secret key.
+	key: string @go(Key) @protobuf(2,bytes,opt)
+
+	// Specify whether the Secret or its key must be defined
+	// +optional
+	optional?: bool @go(Optional,*bool) @protobuf(3,varint,opt)
+}
+
+// EnvFromSource represents the source of a set of ConfigMaps or Secrets
+#EnvFromSource: {
+	// Optional text to prepend to the name of each environment variable.
+	// May consist of any printable ASCII characters except '='.
+	// +optional
+	prefix?: string @go(Prefix) @protobuf(1,bytes,opt)
+
+	// The ConfigMap to select from
+	// +optional
+	configMapRef?: #ConfigMapEnvSource @go(ConfigMapRef,*ConfigMapEnvSource) @protobuf(2,bytes,opt)
+
+	// The Secret to select from
+	// +optional
+	secretRef?: #SecretEnvSource @go(SecretRef,*SecretEnvSource) @protobuf(3,bytes,opt)
+}
+
+// ConfigMapEnvSource selects a ConfigMap to populate the environment
+// variables with.
+//
+// The contents of the target ConfigMap's Data field will represent the
+// key-value pairs as environment variables.
+#ConfigMapEnvSource: {
+	#LocalObjectReference
+
+	// Specify whether the ConfigMap must be defined
+	// +optional
+	optional?: bool @go(Optional,*bool) @protobuf(2,varint,opt)
+}
+
+// SecretEnvSource selects a Secret to populate the environment
+// variables with.
+//
+// The contents of the target Secret's Data field will represent the
+// key-value pairs as environment variables.
+#SecretEnvSource: {
+	#LocalObjectReference
+
+	// Specify whether the Secret must be defined
+	// +optional
+	optional?: bool @go(Optional,*bool) @protobuf(2,varint,opt)
+}
+
+// HTTPHeader describes a custom header to be used in HTTP probes
+#HTTPHeader: {
+	// The header field name.
+	// This will be canonicalized upon output, so case-variant names will be understood as the same header.
+	name: string @go(Name) @protobuf(1,bytes,opt)
+
+	// The header field value
+	value: string @go(Value) @protobuf(2,bytes,opt)
+}
+
+// HTTPGetAction describes an action based on HTTP Get requests.
+#HTTPGetAction: {
+	// Path to access on the HTTP server.
+	// +optional
+	path?: string @go(Path) @protobuf(1,bytes,opt)
+
+	// Name or number of the port to access on the container.
+	// Number must be in the range 1 to 65535.
+	// Name must be an IANA_SVC_NAME.
+	port: intstr.#IntOrString @go(Port) @protobuf(2,bytes,opt)
+
+	// Host name to connect to, defaults to the pod IP. You probably want to set
+	// "Host" in httpHeaders instead.
+	// +optional
+	host?: string @go(Host) @protobuf(3,bytes,opt)
+
+	// Scheme to use for connecting to the host.
+	// Defaults to HTTP.
+	// +optional
+	scheme?: #URIScheme @go(Scheme) @protobuf(4,bytes,opt,casttype=URIScheme)
+
+	// Custom headers to set in the request. HTTP allows repeated headers.
+	// +optional
+	// +listType=atomic
+	httpHeaders?: [...#HTTPHeader] @go(HTTPHeaders,[]HTTPHeader) @protobuf(5,bytes,rep)
+}
+
+// URIScheme identifies the scheme used for connection to a host for Get actions
+// +enum
+#URIScheme: string // #enumURIScheme
+
+#enumURIScheme:
+	#URISchemeHTTP |
+	#URISchemeHTTPS
+
+// URISchemeHTTP means that the scheme used will be http://
+#URISchemeHTTP: #URIScheme & "HTTP"
+
+// URISchemeHTTPS means that the scheme used will be https://
+#URISchemeHTTPS: #URIScheme & "HTTPS"
+
+// TCPSocketAction describes an action based on opening a socket
+#TCPSocketAction: {
+	// Number or name of the port to access on the container.
+	// Number must be in the range 1 to 65535.
+	// Name must be an IANA_SVC_NAME.
+	port: intstr.#IntOrString @go(Port) @protobuf(1,bytes,opt)
+
+	// Optional: Host name to connect to, defaults to the pod IP.
+	// +optional
+	host?: string @go(Host) @protobuf(2,bytes,opt)
+}
+
+// GRPCAction specifies an action involving a GRPC service.
+#GRPCAction: {
+	// Port number of the gRPC service. Number must be in the range 1 to 65535.
+	port: int32 @go(Port) @protobuf(1,bytes,opt)
+
+	// Service is the name of the service to place in the gRPC HealthCheckRequest
+	// (see https://github.com/grpc/grpc/blob/master/doc/health-checking.md).
+	//
+	// If this is not specified, the default behavior is defined by gRPC.
+	// +optional
+	// +default=""
+	service?: string @go(Service,*string) @protobuf(2,bytes,opt)
+}
+
+// ExecAction describes a "run in container" action.
+#ExecAction: {
+	// Command is the command line to execute inside the container, the working directory for the
+	// command  is root ('/') in the container's filesystem. The command is simply exec'd, it is
+	// not run inside a shell, so traditional shell instructions ('|', etc) won't work. To use
+	// a shell, you need to explicitly call out to that shell.
+	// Exit status of 0 is treated as live/healthy and non-zero is unhealthy.
+	// +optional
+	// +listType=atomic
+	command?: [...string] @go(Command,[]string) @protobuf(1,bytes,rep)
+}
+
+// SleepAction describes a "sleep" action.
+#SleepAction: {
+	// Seconds is the number of seconds to sleep.
+	seconds: int64 @go(Seconds) @protobuf(1,bytes,opt)
+}
+
+// Probe describes a health check to be performed against a container to determine whether it is
+// alive or ready to receive traffic.
+#Probe: {
+	#ProbeHandler
+
+	// Number of seconds after the container has started before liveness probes are initiated.
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+	// +optional
+	initialDelaySeconds?: int32 @go(InitialDelaySeconds) @protobuf(2,varint,opt)
+
+	// Number of seconds after which the probe times out.
+	// Defaults to 1 second. Minimum value is 1.
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+	// +optional
+	timeoutSeconds?: int32 @go(TimeoutSeconds) @protobuf(3,varint,opt)
+
+	// How often (in seconds) to perform the probe.
+	// Default to 10 seconds. Minimum value is 1.
+	// +optional
+	periodSeconds?: int32 @go(PeriodSeconds) @protobuf(4,varint,opt)
+
+	// Minimum consecutive successes for the probe to be considered successful after having failed.
+	// Defaults to 1. Must be 1 for liveness and startup. Minimum value is 1.
+	// +optional
+	successThreshold?: int32 @go(SuccessThreshold) @protobuf(5,varint,opt)
+
+	// Minimum consecutive failures for the probe to be considered failed after having succeeded.
+	// Defaults to 3. Minimum value is 1.
+	// +optional
+	failureThreshold?: int32 @go(FailureThreshold) @protobuf(6,varint,opt)
+
+	// Optional duration in seconds the pod needs to terminate gracefully upon probe failure.
+	// The grace period is the duration in seconds after the processes running in the pod are sent
+	// a termination signal and the time when the processes are forcibly halted with a kill signal.
+	// Set this value longer than the expected cleanup time for your process.
+	// If this value is nil, the pod's terminationGracePeriodSeconds will be used. Otherwise, this
+	// value overrides the value provided by the pod spec.
+	// Value must be non-negative integer. The value zero indicates stop immediately via
+	// the kill signal (no opportunity to shut down).
+	// This is a beta field and requires enabling ProbeTerminationGracePeriod feature gate.
+	// Minimum value is 1. spec.terminationGracePeriodSeconds is used if unset.
+	// +optional
+	terminationGracePeriodSeconds?: int64 @go(TerminationGracePeriodSeconds,*int64) @protobuf(7,varint,opt)
+}
+
+// PullPolicy describes a policy for if/when to pull a container image
+// +enum
+#PullPolicy: string // #enumPullPolicy
+
+#enumPullPolicy:
+	#PullAlways |
+	#PullNever |
+	#PullIfNotPresent
+
+// PullAlways means that kubelet always attempts to pull the latest image. Container will fail If the pull fails.
+#PullAlways: #PullPolicy & "Always"
+
+// PullNever means that kubelet never pulls an image, but only uses a local image. Container will fail if the image isn't present
+#PullNever: #PullPolicy & "Never"
+
+// PullIfNotPresent means that kubelet pulls if the image isn't present on disk. Container will fail if the image isn't present and the pull fails.
+#PullIfNotPresent: #PullPolicy & "IfNotPresent"
+
+// ResourceResizeRestartPolicy specifies how to handle container resource resize.
+#ResourceResizeRestartPolicy: string // #enumResourceResizeRestartPolicy
+
+#enumResourceResizeRestartPolicy:
+	#NotRequired |
+	#RestartContainer
+
+// 'NotRequired' means Kubernetes will try to resize the container
+// without restarting it, if possible. Kubernetes may however choose to
+// restart the container if it is unable to actuate resize without a
+// restart. For e.g. the runtime doesn't support restart-free resizing.
+#NotRequired: #ResourceResizeRestartPolicy & "NotRequired"
+
+// 'RestartContainer' means Kubernetes will resize the container in-place
+// by stopping and starting the container when new resources are applied.
+// This is needed for legacy applications. For e.g. java apps using the
+// -xmxN flag which are unable to use resized memory without restarting.
+#RestartContainer: #ResourceResizeRestartPolicy & "RestartContainer"
+
+// ContainerResizePolicy represents resource resize policy for the container.
+#ContainerResizePolicy: {
+	// Name of the resource to which this resource resize policy applies.
+	// Supported values: cpu, memory.
+	resourceName: #ResourceName @go(ResourceName) @protobuf(1,bytes,opt,casttype=ResourceName)
+
+	// Restart policy to apply when specified resource is resized.
+	// If not specified, it defaults to NotRequired.
+	restartPolicy: #ResourceResizeRestartPolicy @go(RestartPolicy) @protobuf(2,bytes,opt,casttype=ResourceResizeRestartPolicy)
+}
+
+// PreemptionPolicy describes a policy for if/when to preempt a pod.
+// +enum
+#PreemptionPolicy: string // #enumPreemptionPolicy
+
+#enumPreemptionPolicy:
+	#PreemptLowerPriority |
+	#PreemptNever
+
+// PreemptLowerPriority means that pod can preempt other pods with lower priority.
+#PreemptLowerPriority: #PreemptionPolicy & "PreemptLowerPriority"
+
+// PreemptNever means that pod never preempts other pods with lower priority.
+#PreemptNever: #PreemptionPolicy & "Never"
+
+// TerminationMessagePolicy describes how termination messages are retrieved from a container.
+// +enum
+#TerminationMessagePolicy: string // #enumTerminationMessagePolicy
+
+#enumTerminationMessagePolicy:
+	#TerminationMessageReadFile |
+	#TerminationMessageFallbackToLogsOnError
+
+// TerminationMessageReadFile is the default behavior and will set the container status message to
+// the contents of the container's terminationMessagePath when the container exits.
+#TerminationMessageReadFile: #TerminationMessagePolicy & "File"
+
+// TerminationMessageFallbackToLogsOnError will read the most recent contents of the container logs
+// for the container status message when the container exits with an error and the
+// terminationMessagePath has no contents.
+#TerminationMessageFallbackToLogsOnError: #TerminationMessagePolicy & "FallbackToLogsOnError"
+
+// Capability represent POSIX capabilities type
+#Capability: string
+
+// Adds and removes POSIX capabilities from running containers.
+#Capabilities: {
+	// Added capabilities
+	// +optional
+	// +listType=atomic
+	add?: [...#Capability] @go(Add,[]Capability) @protobuf(1,bytes,rep,casttype=Capability)
+
+	// Removed capabilities
+	// +optional
+	// +listType=atomic
+	drop?: [...#Capability] @go(Drop,[]Capability) @protobuf(2,bytes,rep,casttype=Capability)
+}
+
+// ResourceRequirements describes the compute resource requirements.
+#ResourceRequirements: {
+	// Limits describes the maximum amount of compute resources allowed.
+	// More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+	// +optional
+	limits?: #ResourceList @go(Limits) @protobuf(1,bytes,rep,casttype=ResourceList,castkey=ResourceName)
+
+	// Requests describes the minimum amount of compute resources required.
+	// If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+	// otherwise to an implementation-defined value. Requests cannot exceed Limits.
+	// More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+	// +optional
+	requests?: #ResourceList @go(Requests) @protobuf(2,bytes,rep,casttype=ResourceList,castkey=ResourceName)
+
+	// Claims lists the names of resources, defined in spec.resourceClaims,
+	// that are used by this container.
+	//
+	// This field depends on the
+	// DynamicResourceAllocation feature gate.
+	//
+	// This field is immutable. It can only be set for containers.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +featureGate=DynamicResourceAllocation
+	// +optional
+	claims?: [...#ResourceClaim] @go(Claims,[]ResourceClaim) @protobuf(3,bytes,opt)
+}
+
+// VolumeResourceRequirements describes the storage resource requirements for a volume.
+#VolumeResourceRequirements: {
+	// Limits describes the maximum amount of compute resources allowed.
+	// More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+	// +optional
+	limits?: #ResourceList @go(Limits) @protobuf(1,bytes,rep,casttype=ResourceList,castkey=ResourceName)
+
+	// Requests describes the minimum amount of compute resources required.
+	// If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+	// otherwise to an implementation-defined value. Requests cannot exceed Limits.
+	// More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+	// +optional
+	requests?: #ResourceList @go(Requests) @protobuf(2,bytes,rep,casttype=ResourceList,castkey=ResourceName)
+}
+
+// ResourceClaim references one entry in PodSpec.ResourceClaims.
+#ResourceClaim: {
+	// Name must match the name of one entry in pod.spec.resourceClaims of
+	// the Pod where this field is used. It makes that resource available
+	// inside a container.
+	name: string @go(Name) @protobuf(1,bytes,opt)
+
+	// Request is the name chosen for a request in the referenced claim.
+	// If empty, everything from the claim is made available, otherwise
+	// only the result of this request.
+	//
+	// +optional
+	request?: string @go(Request) @protobuf(2,bytes,opt)
+}
+
+// TerminationMessagePathDefault means the default path to capture the application termination message running in a container
+#TerminationMessagePathDefault: "/dev/termination-log"
+
+// A single application container that you want to run within a pod.
+#Container: {
+	// Name of the container specified as a DNS_LABEL.
+	// Each container in a pod must have a unique name (DNS_LABEL).
+	// Cannot be updated.
+	name: string @go(Name) @protobuf(1,bytes,opt)
+
+	// Container image name.
+	// More info: https://kubernetes.io/docs/concepts/containers/images
+	// This field is optional to allow higher level config management to default or override
+	// container images in workload controllers like Deployments and StatefulSets.
+	// +optional
+	image?: string @go(Image) @protobuf(2,bytes,opt)
+
+	// Entrypoint array. Not executed within a shell.
+	// The container image's ENTRYPOINT is used if this is not provided.
+	// Variable references $(VAR_NAME) are expanded using the container's environment. If a variable
+	// cannot be resolved, the reference in the input string will be unchanged. Double $$ are reduced
+	// to a single $, which allows for escaping the $(VAR_NAME) syntax: i.e. "$$(VAR_NAME)" will
+	// produce the string literal "$(VAR_NAME)". Escaped references will never be expanded, regardless
+	// of whether the variable exists or not. Cannot be updated.
+	// More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell
+	// +optional
+	// +listType=atomic
+	command?: [...string] @go(Command,[]string) @protobuf(3,bytes,rep)
+
+	// Arguments to the entrypoint.
+	// The container image's CMD is used if this is not provided.
+	// Variable references $(VAR_NAME) are expanded using the container's environment. If a variable
+	// cannot be resolved, the reference in the input string will be unchanged. Double $$ are reduced
+	// to a single $, which allows for escaping the $(VAR_NAME) syntax: i.e. "$$(VAR_NAME)" will
+	// produce the string literal "$(VAR_NAME)". Escaped references will never be expanded, regardless
+	// of whether the variable exists or not. Cannot be updated.
+	// More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell
+	// +optional
+	// +listType=atomic
+	args?: [...string] @go(Args,[]string) @protobuf(4,bytes,rep)
+
+	// Container's working directory.
+	// If not specified, the container runtime's default will be used, which
+	// might be configured in the container image.
+	// Cannot be updated.
+	// +optional
+	workingDir?: string @go(WorkingDir) @protobuf(5,bytes,opt)
+
+	// List of ports to expose from the container. Not specifying a port here
+	// DOES NOT prevent that port from being exposed. Any port which is
+	// listening on the default "0.0.0.0" address inside a container will be
+	// accessible from the network.
+	// Modifying this array with strategic merge patch may corrupt the data.
+	// For more information See https://github.com/kubernetes/kubernetes/issues/108255.
+	// Cannot be updated.
+	// +optional
+	// +patchMergeKey=containerPort
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=containerPort
+	// +listMapKey=protocol
+	ports?: [...#ContainerPort] @go(Ports,[]ContainerPort) @protobuf(6,bytes,rep)
+
+	// List of sources to populate environment variables in the container.
+	// The keys defined within a source may consist of any printable ASCII characters except '='.
+	// When a key exists in multiple
+	// sources, the value associated with the last source will take precedence.
+	// Values defined by an Env with a duplicate key will take precedence.
+	// Cannot be updated.
+	// +optional
+	// +listType=atomic
+	envFrom?: [...#EnvFromSource] @go(EnvFrom,[]EnvFromSource) @protobuf(19,bytes,rep)
+
+	// List of environment variables to set in the container.
+	// Cannot be updated.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=name
+	env?: [...#EnvVar] @go(Env,[]EnvVar) @protobuf(7,bytes,rep)
+
+	// Compute Resources required by this container.
+	// Cannot be updated.
+	// More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+	// +optional
+	resources?: #ResourceRequirements @go(Resources) @protobuf(8,bytes,opt)
+
+	// Resources resize policy for the container.
+	// This field cannot be set on ephemeral containers.
+	// +featureGate=InPlacePodVerticalScaling
+	// +optional
+	// +listType=atomic
+	resizePolicy?: [...#ContainerResizePolicy] @go(ResizePolicy,[]ContainerResizePolicy) @protobuf(23,bytes,rep)
+
+	// RestartPolicy defines the restart behavior of individual containers in a pod.
+	// This overrides the pod-level restart policy. When this field is not specified,
+	// the restart behavior is defined by the Pod's restart policy and the container type.
+	// Additionally, setting the RestartPolicy as "Always" for the init container will
+	// have the following effect:
+	// this init container will be continually restarted on
+	// exit until all regular containers have terminated. Once all regular
+	// containers have completed, all init containers with restartPolicy "Always"
+	// will be shut down. This lifecycle differs from normal init containers and
+	// is often referred to as a "sidecar" container. Although this init
+	// container still starts in the init container sequence, it does not wait
+	// for the container to complete before proceeding to the next init
+	// container. Instead, the next init container starts immediately after this
+	// init container is started, or after any startupProbe has successfully
+	// completed.
+	// +optional
+	restartPolicy?: #ContainerRestartPolicy @go(RestartPolicy,*ContainerRestartPolicy) @protobuf(24,bytes,opt,casttype=ContainerRestartPolicy)
+
+	// Represents a list of rules to be checked to determine if the
+	// container should be restarted on exit. The rules are evaluated in
+	// order. Once a rule matches a container exit condition, the remaining
+	// rules are ignored. If no rule matches the container exit condition,
+	// the Container-level restart policy determines the whether the container
+	// is restarted or not. Constraints on the rules:
+	// - At most 20 rules are allowed.
+	// - Rules can have the same action.
+	// - Identical rules are not forbidden in validations.
+	// When rules are specified, container MUST set RestartPolicy explicitly
+	// even it if matches the Pod's RestartPolicy.
+	// +featureGate=ContainerRestartRules
+	// +optional
+	// +listType=atomic
+	restartPolicyRules?: [...#ContainerRestartRule] @go(RestartPolicyRules,[]ContainerRestartRule) @protobuf(25,bytes,rep)
+
+	// Pod volumes to mount into the container's filesystem.
+	// Cannot be updated.
+	// +optional
+	// +patchMergeKey=mountPath
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=mountPath
+	volumeMounts?: [...#VolumeMount] @go(VolumeMounts,[]VolumeMount) @protobuf(9,bytes,rep)
+
+	// volumeDevices is the list of block devices to be used by the container.
+	// +patchMergeKey=devicePath
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=devicePath
+	// +optional
+	volumeDevices?: [...#VolumeDevice] @go(VolumeDevices,[]VolumeDevice) @protobuf(21,bytes,rep)
+
+	// Periodic probe of container liveness.
+	// Container will be restarted if the probe fails.
+	// Cannot be updated.
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+	// +optional
+	livenessProbe?: #Probe @go(LivenessProbe,*Probe) @protobuf(10,bytes,opt)
+
+	// Periodic probe of container service readiness.
+	// Container will be removed from service endpoints if the probe fails.
+	// Cannot be updated.
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+	// +optional
+	readinessProbe?: #Probe @go(ReadinessProbe,*Probe) @protobuf(11,bytes,opt)
+
+	// StartupProbe indicates that the Pod has successfully initialized.
+	// If specified, no other probes are executed until this completes successfully.
+	// If this probe fails, the Pod will be restarted, just as if the livenessProbe failed.
+	// This can be used to provide different probe parameters at the beginning of a Pod's lifecycle,
+	// when it might take a long time to load data or warm a cache, than during steady-state operation.
+	// This cannot be updated.
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+	// +optional
+	startupProbe?: #Probe @go(StartupProbe,*Probe) @protobuf(22,bytes,opt)
+
+	// Actions that the management system should take in response to container lifecycle events.
+	// Cannot be updated.
+	// +optional
+	lifecycle?: #Lifecycle @go(Lifecycle,*Lifecycle) @protobuf(12,bytes,opt)
+
+	// Optional: Path at which the file to which the container's termination message
+	// will be written is mounted into the container's filesystem.
+	// Message written is intended to be brief final status, such as an assertion failure message.
+	// Will be truncated by the node if greater than 4096 bytes. The total message length across
+	// all containers will be limited to 12kb.
+	// Defaults to /dev/termination-log.
+	// Cannot be updated.
+	// +optional
+	terminationMessagePath?: string @go(TerminationMessagePath) @protobuf(13,bytes,opt)
+
+	// Indicate how the termination message should be populated. File will use the contents of
+	// terminationMessagePath to populate the container status message on both success and failure.
+	// FallbackToLogsOnError will use the last chunk of container log output if the termination
+	// message file is empty and the container exited with an error.
+	// The log output is limited to 2048 bytes or 80 lines, whichever is smaller.
+	// Defaults to File.
+	// Cannot be updated.
+	// +optional
+	terminationMessagePolicy?: #TerminationMessagePolicy @go(TerminationMessagePolicy) @protobuf(20,bytes,opt,casttype=TerminationMessagePolicy)
+
+	// Image pull policy.
+	// One of Always, Never, IfNotPresent.
+	// Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
+	// Cannot be updated.
+	// More info: https://kubernetes.io/docs/concepts/containers/images#updating-images
+	// +optional
+	imagePullPolicy?: #PullPolicy @go(ImagePullPolicy) @protobuf(14,bytes,opt,casttype=PullPolicy)
+
+	// SecurityContext defines the security options the container should be run with.
+	// If set, the fields of SecurityContext override the equivalent fields of PodSecurityContext.
+	// More info: https://kubernetes.io/docs/tasks/configure-pod-container/security-context/
+	// +optional
+	securityContext?: #SecurityContext @go(SecurityContext,*SecurityContext) @protobuf(15,bytes,opt)
+
+	// Whether this container should allocate a buffer for stdin in the container runtime. If this
+	// is not set, reads from stdin in the container will always result in EOF.
+	// Default is false.
+	// +optional
+	stdin?: bool @go(Stdin) @protobuf(16,varint,opt)
+
+	// Whether the container runtime should close the stdin channel after it has been opened by
+	// a single attach. When stdin is true the stdin stream will remain open across multiple attach
+	// sessions. If stdinOnce is set to true, stdin is opened on container start, is empty until the
+	// first client attaches to stdin, and then remains open and accepts data until the client disconnects,
+	// at which time stdin is closed and remains closed until the container is restarted. If this
+	// flag is false, a container processes that reads from stdin will never receive an EOF.
+	// Default is false
+	// +optional
+	stdinOnce?: bool @go(StdinOnce) @protobuf(17,varint,opt)
+
+	// Whether this container should allocate a TTY for itself, also requires 'stdin' to be true.
+	// Default is false.
+	// +optional
+	tty?: bool @go(TTY) @protobuf(18,varint,opt)
+}
+
+// ProbeHandler defines a specific action that should be taken in a probe.
+// One and only one of the fields must be specified.
+#ProbeHandler: {
+	// Exec specifies a command to execute in the container.
+	// +optional
+	exec?: #ExecAction @go(Exec,*ExecAction) @protobuf(1,bytes,opt)
+
+	// HTTPGet specifies an HTTP GET request to perform.
+	// +optional
+	httpGet?: #HTTPGetAction @go(HTTPGet,*HTTPGetAction) @protobuf(2,bytes,opt)
+
+	// TCPSocket specifies a connection to a TCP port.
+	// +optional
+	tcpSocket?: #TCPSocketAction @go(TCPSocket,*TCPSocketAction) @protobuf(3,bytes,opt)
+
+	// GRPC specifies a GRPC HealthCheckRequest.
+	// +optional
+	grpc?: #GRPCAction @go(GRPC,*GRPCAction) @protobuf(4,bytes,opt)
+}
+
+// LifecycleHandler defines a specific action that should be taken in a lifecycle
+// hook. One and only one of the fields, except TCPSocket must be specified.
+#LifecycleHandler: {
+	// Exec specifies a command to execute in the container.
+	// +optional
+	exec?: #ExecAction @go(Exec,*ExecAction) @protobuf(1,bytes,opt)
+
+	// HTTPGet specifies an HTTP GET request to perform.
+	// +optional
+	httpGet?: #HTTPGetAction @go(HTTPGet,*HTTPGetAction) @protobuf(2,bytes,opt)
+
+	// Deprecated. TCPSocket is NOT supported as a LifecycleHandler and kept
+	// for backward compatibility. There is no validation of this field and
+	// lifecycle hooks will fail at runtime when it is specified.
+	// +optional
+	tcpSocket?: #TCPSocketAction @go(TCPSocket,*TCPSocketAction) @protobuf(3,bytes,opt)
+
+	// Sleep represents a duration that the container should sleep.
+	// +optional
+	sleep?: #SleepAction @go(Sleep,*SleepAction) @protobuf(4,bytes,opt)
+}
+
+// Signal defines the stop signal of containers
+// +enum
+#Signal: string // #enumSignal
+
+#enumSignal:
+	#SIGABRT |
+	#SIGALRM |
+	#SIGBUS |
+	#SIGCHLD |
+	#SIGCLD |
+	#SIGCONT |
+	#SIGFPE |
+	#SIGHUP |
+	#SIGILL |
+	#SIGINT |
+	#SIGIO |
+	#SIGIOT |
+	#SIGKILL |
+	#SIGPIPE |
+	#SIGPOLL |
+	#SIGPROF |
+	#SIGPWR |
+	#SIGQUIT |
+	#SIGSEGV |
+	#SIGSTKFLT |
+	#SIGSTOP |
+	#SIGSYS |
+	#SIGTERM |
+	#SIGTRAP |
+	#SIGTSTP |
+	#SIGTTIN |
+	#SIGTTOU |
+	#SIGURG |
+	#SIGUSR1 |
+	#SIGUSR2 |
+	#SIGVTALRM |
+	#SIGWINCH |
+	#SIGXCPU |
+	#SIGXFSZ |
+	#SIGRTMIN |
+	#SIGRTMINPLUS1 |
+	#SIGRTMINPLUS2 |
+	#SIGRTMINPLUS3 |
+	#SIGRTMINPLUS4 |
+	#SIGRTMINPLUS5 |
+	#SIGRTMINPLUS6 |
+	#SIGRTMINPLUS7 |
+	#SIGRTMINPLUS8 |
+	#SIGRTMINPLUS9 |
+	#SIGRTMINPLUS10 |
+	#SIGRTMINPLUS11 |
+	#SIGRTMINPLUS12 |
+	#SIGRTMINPLUS13 |
+	#SIGRTMINPLUS14 |
+	#SIGRTMINPLUS15 |
+	#SIGRTMAXMINUS14 |
+	#SIGRTMAXMINUS13 |
+	#SIGRTMAXMINUS12 |
+	#SIGRTMAXMINUS11 |
+	#SIGRTMAXMINUS10 |
+	#SIGRTMAXMINUS9 |
+	#SIGRTMAXMINUS8 |
+	#SIGRTMAXMINUS7 |
+	#SIGRTMAXMINUS6 |
+	#SIGRTMAXMINUS5 |
+	#SIGRTMAXMINUS4 |
+	#SIGRTMAXMINUS3 |
+	#SIGRTMAXMINUS2 |
+	#SIGRTMAXMINUS1 |
+	#SIGRTMAX
+
+#SIGABRT:         #Signal & "SIGABRT"
+#SIGALRM:         #Signal & "SIGALRM"
+#SIGBUS:          #Signal & "SIGBUS"
+#SIGCHLD:         #Signal & "SIGCHLD"
+#SIGCLD:          #Signal & "SIGCLD"
+#SIGCONT:         #Signal & "SIGCONT"
+#SIGFPE:          #Signal & "SIGFPE"
+#SIGHUP:          #Signal & "SIGHUP"
+#SIGILL:          #Signal & "SIGILL"
+#SIGINT:          #Signal & "SIGINT"
+#SIGIO:           #Signal & "SIGIO"
+#SIGIOT:          #Signal & "SIGIOT"
+#SIGKILL:         #Signal & "SIGKILL"
+#SIGPIPE:         #Signal & "SIGPIPE"
+#SIGPOLL:         #Signal & "SIGPOLL"
+#SIGPROF:         #Signal & "SIGPROF"
+#SIGPWR:          #Signal & "SIGPWR"
+#SIGQUIT:         #Signal & "SIGQUIT"
+#SIGSEGV:         #Signal & "SIGSEGV"
+#SIGSTKFLT:       #Signal & "SIGSTKFLT"
+#SIGSTOP:         #Signal & "SIGSTOP"
+#SIGSYS:          #Signal & "SIGSYS"
+#SIGTERM:         #Signal & "SIGTERM"
+#SIGTRAP:         #Signal & "SIGTRAP"
+#SIGTSTP:         #Signal & "SIGTSTP"
+#SIGTTIN:         #Signal & "SIGTTIN"
+#SIGTTOU:         #Signal & "SIGTTOU"
+#SIGURG:          #Signal & "SIGURG"
+#SIGUSR1:         #Signal & "SIGUSR1"
+#SIGUSR2:         #Signal & "SIGUSR2"
+#SIGVTALRM:       #Signal & "SIGVTALRM"
+#SIGWINCH:        #Signal & "SIGWINCH"
+#SIGXCPU:         #Signal & "SIGXCPU"
+#SIGXFSZ:         #Signal & "SIGXFSZ"
+#SIGRTMIN:        #Signal & "SIGRTMIN"
+#SIGRTMINPLUS1:   #Signal & "SIGRTMIN+1"
+#SIGRTMINPLUS2:   #Signal & "SIGRTMIN+2"
+#SIGRTMINPLUS3:   #Signal & "SIGRTMIN+3"
+#SIGRTMINPLUS4:   #Signal & "SIGRTMIN+4"
+#SIGRTMINPLUS5:   #Signal & "SIGRTMIN+5"
+#SIGRTMINPLUS6:   #Signal & "SIGRTMIN+6"
+#SIGRTMINPLUS7:   #Signal & "SIGRTMIN+7"
+#SIGRTMINPLUS8:   #Signal & "SIGRTMIN+8"
+#SIGRTMINPLUS9:   #Signal & "SIGRTMIN+9"
+#SIGRTMINPLUS10:  #Signal & "SIGRTMIN+10"
+#SIGRTMINPLUS11:  #Signal & "SIGRTMIN+11"
+#SIGRTMINPLUS12:  #Signal & "SIGRTMIN+12"
+#SIGRTMINPLUS13:  #Signal & "SIGRTMIN+13"
+#SIGRTMINPLUS14:  #Signal & "SIGRTMIN+14"
+#SIGRTMINPLUS15:  #Signal & "SIGRTMIN+15"
+#SIGRTMAXMINUS14: #Signal & "SIGRTMAX-14"
+#SIGRTMAXMINUS13: #Signal & "SIGRTMAX-13"
+#SIGRTMAXMINUS12: #Signal & "SIGRTMAX-12"
+#SIGRTMAXMINUS11: #Signal & "SIGRTMAX-11"
+#SIGRTMAXMINUS10: #Signal & "SIGRTMAX-10"
+#SIGRTMAXMINUS9:  #Signal & "SIGRTMAX-9"
+#SIGRTMAXMINUS8:  #Signal & "SIGRTMAX-8"
+#SIGRTMAXMINUS7:  #Signal & "SIGRTMAX-7"
+#SIGRTMAXMINUS6:  #Signal & "SIGRTMAX-6"
+#SIGRTMAXMINUS5:  #Signal & "SIGRTMAX-5"
+#SIGRTMAXMINUS4:  #Signal & "SIGRTMAX-4"
+#SIGRTMAXMINUS3:  #Signal & "SIGRTMAX-3"
+#SIGRTMAXMINUS2:  #Signal & "SIGRTMAX-2"
+#SIGRTMAXMINUS1:  #Signal & "SIGRTMAX-1"
+#SIGRTMAX:        #Signal & "SIGRTMAX"
+
+// Lifecycle describes actions that the management system should take in response to container lifecycle
+// events. For the PostStart and PreStop lifecycle handlers, management of the container blocks
+// until the action is complete, unless the container process fails, in which case the handler is aborted.
+#Lifecycle: {
+	// PostStart is called immediately after a container is created. If the handler fails,
+	// the container is terminated and restarted according to its restart policy.
+	// Other management of the container blocks until the hook completes.
+	// More info: https://kubernetes.io/docs/concepts/containers/container-lifecycle-hooks/#container-hooks
+	// +optional
+	postStart?: #LifecycleHandler @go(PostStart,*LifecycleHandler) @protobuf(1,bytes,opt)
+
+	// PreStop is called immediately before a container is terminated due to an
+	// API request or management event such as liveness/startup probe failure,
+	// preemption, resource contention, etc. The handler is not called if the
+	// container crashes or exits. The Pod's termination grace period countdown begins before the
+	// PreStop hook is executed. Regardless of the outcome of the handler, the
+	// container will eventually terminate within the Pod's termination grace
+	// period (unless delayed by finalizers). Other management of the container blocks until the hook completes
+	// or until the termination grace period is reached.
+	// More info: https://kubernetes.io/docs/concepts/containers/container-lifecycle-hooks/#container-hooks
+	// +optional
+	preStop?: #LifecycleHandler @go(PreStop,*LifecycleHandler) @protobuf(2,bytes,opt)
+
+	// StopSignal defines which signal will be sent to a container when it is being stopped.
+	// If not specified, the default is defined by the container runtime in use.
+	// StopSignal can only be set for Pods with a non-empty .spec.os.name
+	// +optional
+	stopSignal?: #Signal @go(StopSignal,*Signal) @protobuf(3,bytes,opt)
+}
+
+#ConditionStatus: string // #enumConditionStatus
+
+#enumConditionStatus:
+	#ConditionTrue |
+	#ConditionFalse |
+	#ConditionUnknown
+
+#ConditionTrue:    #ConditionStatus & "True"
+#ConditionFalse:   #ConditionStatus & "False"
+#ConditionUnknown: #ConditionStatus & "Unknown"
+
+// ContainerStateWaiting is a waiting state of a container.
+#ContainerStateWaiting: {
+	// (brief) reason the container is not yet running.
+	// +optional
+	reason?: string @go(Reason) @protobuf(1,bytes,opt)
+
+	// Message regarding why the container is not yet running.
+	// +optional
+	message?: string @go(Message) @protobuf(2,bytes,opt)
+}
+
+// ContainerStateRunning is a running state of a container.
+#ContainerStateRunning: {
+	// Time at which the container was last (re-)started
+	// +optional
+	startedAt?: metav1.#Time @go(StartedAt) @protobuf(1,bytes,opt)
+}
+
+// ContainerStateTerminated is a terminated state of a container.
+#ContainerStateTerminated: {
+	// Exit status from the last termination of the container
+	exitCode: int32 @go(ExitCode) @protobuf(1,varint,opt)
+
+	// Signal from the last termination of the container
+	// +optional
+	signal?: int32 @go(Signal) @protobuf(2,varint,opt)
+
+	// (brief) reason from the last termination of the container
+	// +optional
+	reason?: string @go(Reason) @protobuf(3,bytes,opt)
+
+	// Message regarding the last termination of the container
+	// +optional
+	message?: string @go(Message) @protobuf(4,bytes,opt)
+
+	// Time at which previous execution of the container started
+	// +optional
+	startedAt?: metav1.#Time @go(StartedAt) @protobuf(5,bytes,opt)
+
+	// Time at which the container last terminated
+	// +optional
+	finishedAt?: metav1.#Time @go(FinishedAt) @protobuf(6,bytes,opt)
+
+	// Container's ID in the format '<type>://<container_id>'
+	// +optional
+	containerID?: string @go(ContainerID) @protobuf(7,bytes,opt)
+}
+
+// ContainerState holds a possible state of container.
+// Only one of its members may be specified.
+// If none of them is specified, the default one is ContainerStateWaiting.
+#ContainerState: {
+	// Details about a waiting container
+	// +optional
+	waiting?: #ContainerStateWaiting @go(Waiting,*ContainerStateWaiting) @protobuf(1,bytes,opt)
+
+	// Details about a running container
+	// +optional
+	running?: #ContainerStateRunning @go(Running,*ContainerStateRunning) @protobuf(2,bytes,opt)
+
+	// Details about a terminated container
+	// +optional
+	terminated?: #ContainerStateTerminated @go(Terminated,*ContainerStateTerminated) @protobuf(3,bytes,opt)
+}
+
+// ContainerStatus contains details for the current status of this container.
+#ContainerStatus: {
+	// Name is a DNS_LABEL representing the unique name of the container.
+	// Each container in a pod must have a unique name across all container types.
+	// Cannot be updated.
+	name: string @go(Name) @protobuf(1,bytes,opt)
+
+	// State holds details about the container's current condition.
+	// +optional
+	state?: #ContainerState @go(State) @protobuf(2,bytes,opt)
+
+	// LastTerminationState holds the last termination state of the container to
+	// help debug container crashes and restarts. This field is not
+	// populated if the container is still running and RestartCount is 0.
+	// +optional
+	lastState?: #ContainerState @go(LastTerminationState) @protobuf(3,bytes,opt)
+
+	// Ready specifies whether the container is currently passing its readiness check.
+	// The value will change as readiness probes keep executing. If no readiness
+	// probes are specified, this field defaults to true once the container is
+	// fully started (see Started field).
+	//
+	// The value is typically used to determine whether a container is ready to
+	// accept traffic.
+	ready: bool @go(Ready) @protobuf(4,varint,opt)
+
+	// RestartCount holds the number of times the container has been restarted.
+	// Kubelet makes an effort to always increment the value, but there
+	// are cases when the state may be lost due to node restarts and then the value
+	// may be reset to 0. The value is never negative.
+	restartCount: int32 @go(RestartCount) @protobuf(5,varint,opt)
+
+	// Image is the name of container image that the container is running.
+	// The container image may not match the image used in the PodSpec,
+	// as it may have been resolved by the runtime.
+	// More info: https://kubernetes.io/docs/concepts/containers/images.
+	image: string @go(Image) @protobuf(6,bytes,opt)
+
+	// ImageID is the image ID of the container's image. The image ID may not
+	// match the image ID of the image used in the PodSpec, as it may have been
+	// resolved by the runtime.
+	imageID: string @go(ImageID) @protobuf(7,bytes,opt)
+
+	// ContainerID is the ID of the container in the format '<type>://<container_id>'.
+	// Where type is a container runtime identifier, returned from Version call of CRI API
+	// (for example "containerd").
+	// +optional
+	containerID?: string @go(ContainerID) @protobuf(8,bytes,opt)
+
+	// Started indicates whether the container has finished its postStart lifecycle hook
+	// and passed its startup probe.
+	// Initialized as false, becomes true after startupProbe is considered
+	// successful. Resets to false when the container is restarted, or if kubelet
+	// loses state temporarily. In both cases, startup probes will run again.
+	// Is always true when no startupProbe is defined and container is running and
+	// has passed the postStart lifecycle hook. The null value must be treated the
+	// same as false.
+	// +optional
+	started?: bool @go(Started,*bool) @protobuf(9,varint,opt)
+
+	// AllocatedResources represents the compute resources allocated for this container by the
+	// node. Kubelet sets this value to Container.Resources.Requests upon successful pod admission
+	// and after successfully admitting desired pod resize.
+	// +optional
+	allocatedResources?: #ResourceList @go(AllocatedResources) @protobuf(10,bytes,rep,casttype=ResourceList,castkey=ResourceName)
+
+	// Resources represents the compute resource requests and limits that have been successfully
+	// enacted on the running container after it has been started or has been successfully resized.
+	// +featureGate=InPlacePodVerticalScaling
+	// +optional
+	resources?: #ResourceRequirements @go(Resources,*ResourceRequirements) @protobuf(11,bytes,opt)
+
+	// Status of volume mounts.
+	// +optional
+	// +patchMergeKey=mountPath
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=mountPath
+	volumeMounts?: [...#VolumeMountStatus] @go(VolumeMounts,[]VolumeMountStatus) @protobuf(12,bytes,rep)
+
+	// User represents user identity information initially attached to the first process of the container
+	// +featureGate=SupplementalGroupsPolicy
+	// +optional
+	user?: #ContainerUser @go(User,*ContainerUser) @protobuf(13,bytes,opt,casttype=ContainerUser)
+
+	// AllocatedResourcesStatus represents the status of various resources
+	// allocated for this Pod.
+	// +featureGate=ResourceHealthStatus
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=name
+	allocatedResourcesStatus?: [...#ResourceStatus] @go(AllocatedResourcesStatus,[]ResourceStatus) @protobuf(14,bytes,rep)
+
+	// StopSignal reports the effective stop signal for this container
+	// +featureGate=ContainerStopSignals
+	// +optional
+	stopSignal?: #Signal @go(StopSignal,*Signal) @protobuf(15,bytes,opt)
+}
+
+// ResourceStatus represents the status of a single resource allocated to a Pod.
+#ResourceStatus: {
+	// Name of the resource. Must be unique within the pod and in case of non-DRA resource, match one of the resources from the pod spec.
+	// For DRA resources, the value must be "claim:<claim_name>/<request>".
+	// When this status is reported about a container, the "claim_name" and "request" must match one of the claims of this container.
+	// +required
+	name: #ResourceName @go(Name) @protobuf(1,bytes,opt)
+
+	// List of unique resources health. Each element in the list contains an unique resource ID and its health.
+	// At a minimum, for the lifetime of a Pod, resource ID must uniquely identify the resource allocated to the Pod on the Node.
+	// If other Pod on the same Node reports the status with the same resource ID, it must be the same resource they share.
+	// See ResourceID type definition for a specific format it has in various use cases.
+	// +listType=map
+	// +listMapKey=resourceID
+	resources?: [...#ResourceHealth] @go(Resources,[]ResourceHealth) @protobuf(2,bytes,rep)
+}
+
+#ResourceHealthStatus: string // #enumResourceHealthStatus
+
+#enumResourceHealthStatus:
+	#ResourceHealthStatusHealthy |
+	#ResourceHealthStatusUnhealthy |
+	#ResourceHealthStatusUnknown
+
+#ResourceHealthStatusHealthy:   #ResourceHealthStatus & "Healthy"
+#ResourceHealthStatusUnhealthy: #ResourceHealthStatus & "Unhealthy"
+#ResourceHealthStatusUnknown:   #ResourceHealthStatus & "Unknown"
+
+#ResourceHealthMessageMaxLength: 1024
+
+// ResourceID is calculated based on the source of this resource health information.
+// For DevicePlugin:
+//
+//	DeviceID, where DeviceID is from the Device structure of DevicePlugin's ListAndWatchResponse type: https://github.com/kubernetes/kubernetes/blob/eda1c780543a27c078450e2f17d674471e00f494/staging/src/k8s.io/kubelet/pkg/apis/deviceplugin/v1alpha/api.proto#L61-L73
+//
+// DevicePlugin ID is usually a constant for the lifetime of a Node and typically can be used to uniquely identify the device on the node.
+// For DRA:
+//
+//	<driver name>/<pool name>/<device name>: such a device can be looked up in the information published by that DRA driver to learn more about it. It is designed to be globally unique in a cluster.
+#ResourceID: string
+
+// ResourceHealth represents the health of a resource. It has the latest device health information.
+// This is a part of KEP https://kep.k8s.io/4680.
+#ResourceHealth: {
+	// ResourceID is the unique identifier of the resource. See the ResourceID type for more information.
+	resourceID: #ResourceID @go(ResourceID) @protobuf(1,bytes,opt)
+
+	// Health of the resource.
+	// can be one of:
+	//  - Healthy: operates as normal
+	//  - Unhealthy: reported unhealthy. We consider this a temporary health issue
+	//               since we do not have a mechanism today to distinguish
+	//               temporary and permanent issues.
+	//  - Unknown: The status cannot be determined.
+	//             For example, Device Plugin got unregistered and hasn't been re-registered since.
+	//
+	// In future we may want to introduce the PermanentlyUnhealthy Status.
+	health?: #ResourceHealthStatus @go(Health) @protobuf(2,bytes)
+
+	// Message provides human-readable context for Health (e.g. "ECC error count exceeded threshold").
+	// This field is populated by the kubelet when ResourceHealthStatusMessage is enabled if the DRA plugin returns a message, and is null otherwise.
+	// +featureGate=ResourceHealthStatusMessage
+	// +optional
+	message?: string @go(Message,*string) @protobuf(6,bytes,opt)
+}
+
+// ContainerUser represents user identity information
+#ContainerUser: {
+	// Linux holds user identity information initially attached to the first process of the containers in Linux.
+	// Note that the actual running identity can be changed if the process has enough privilege to do so.
+	// +optional
+	linux?: #LinuxContainerUser @go(Linux,*LinuxContainerUser) @protobuf(1,bytes,opt,casttype=LinuxContainerUser)
+}
+
+// LinuxContainerUser represents user identity information in Linux containers
+#LinuxContainerUser: {
+	// UID is the primary uid initially attached to the first process in the container
+	uid: int64 @go(UID) @protobuf(1,varint)
+
+	// GID is the primary gid initially attached to the first process in the container
+	gid: int64 @go(GID) @protobuf(2,varint)
+
+	// SupplementalGroups are the supplemental groups initially attached to the first process in the container
+	// +optional
+	// +listType=atomic
+	supplementalGroups?: [...int64] @go(SupplementalGroups,[]int64) @protobuf(3,varint,rep)
+}
+
+// PodPhase is a label for the condition of a pod at the current time.
+// +enum
+#PodPhase: string // #enumPodPhase
+
+#enumPodPhase:
+	#PodPending |
+	#PodRunning |
+	#PodSucceeded |
+	#PodFailed |
+	#PodUnknown
+
+// PodPending means the pod has been accepted by the system, but one or more of the containers
+// has not been started. This includes time before being bound to a node, as well as time spent
+// pulling images onto the host.
+#PodPending: #PodPhase & "Pending"
+
+// PodRunning means the pod has been bound to a node and all of the containers have been started.
+// At least one container is still running or is in the process of being restarted.
+#PodRunning: #PodPhase & "Running"
+
+// PodSucceeded means that all containers in the pod have voluntarily terminated
+// with a container exit code of 0, and the system is not going to restart any of these containers.
+#PodSucceeded: #PodPhase & "Succeeded"
+
+// PodFailed means that all containers in the pod have terminated, and at least one container has
+// terminated in a failure (exited with a non-zero exit code or was stopped by the system).
+#PodFailed: #PodPhase & "Failed"
+
+// PodUnknown means that for some reason the state of the pod could not be obtained, typically due
+// to an error in communicating with the host of the pod.
+// Deprecated: It isn't being set since 2015 (74da3b14b0c0f658b3bb8d2def5094686d0e9095)
+#PodUnknown: #PodPhase & "Unknown"
+
+// PodConditionType is a valid value for PodCondition.Type
+#PodConditionType: string // #enumPodConditionType
+
+#enumPodConditionType:
+	#ContainersReady |
+	#PodInitialized |
+	#PodReady |
+	#PodScheduled |
+	#DisruptionTarget |
+	#PodReadyToStartContainers |
+	#PodResizePending |
+	#PodResizeInProgress |
+	#AllContainersRestarting
+
+// ContainersReady indicates whether all containers in the pod are ready.
+#ContainersReady: #PodConditionType & "ContainersReady"
+
+// PodInitialized means that all init containers in the pod have started successfully.
+#PodInitialized: #PodConditionType & "Initialized"
+
+// PodReady means the pod is able to service requests and should be added to the
+// load balancing pools of all matching services.
+#PodReady: #PodConditionType & "Ready"
+
+// PodScheduled represents status of the scheduling process for this pod.
+#PodScheduled: #PodConditionType & "PodScheduled"
+
+// DisruptionTarget indicates the pod is about to be terminated due to a
+// disruption (such as preemption, eviction API or garbage-collection).
+#DisruptionTarget: #PodConditionType & "DisruptionTarget"
+
+// PodReadyToStartContainers pod sandbox is successfully configured and
+// the pod is ready to launch containers.
+#PodReadyToStartContainers: #PodConditionType & "PodReadyToStartContainers"
+
+// PodResizePending indicates that the pod has been resized, but kubelet has not
+// yet allocated the resources. If both PodResizePending and PodResizeInProgress
+// are set, it means that a new resize was requested in the middle of a previous
+// pod resize that is still in progress.
+#PodResizePending: #PodConditionType & "PodResizePending"
+
+// PodResizeInProgress indicates that a resize is in progress, and is present whenever
+// the Kubelet has allocated resources for the resize, but has not yet actuated all of
+// the required changes.
+// If both PodResizePending and PodResizeInProgress are set, it means that a new resize was
+// requested in the middle of a previous pod resize that is still in progress.
+#PodResizeInProgress: #PodConditionType & "PodResizeInProgress"
+
+// AllContainersRestarting indicates that all containers of the pod is being restarted.
+#AllContainersRestarting: #PodConditionType & "AllContainersRestarting"
+
+// PodReasonUnschedulable reason in PodScheduled PodCondition means that the scheduler
+// can't schedule the pod right now, for example due to insufficient resources in the cluster.
+#PodReasonUnschedulable: "Unschedulable"
+
+// PodReasonSchedulingGated reason in PodScheduled PodCondition means that the scheduler
+// skips scheduling the pod because one or more scheduling gates are still present.
+#PodReasonSchedulingGated: "SchedulingGated"
+
+// PodReasonSchedulerError reason in PodScheduled PodCondition means that some internal error happens
+// during scheduling, for example due to nodeAffinity parsing errors.
+#PodReasonSchedulerError: "SchedulerError"
+
+// PodReasonTerminationByKubelet reason in DisruptionTarget pod condition indicates that the termination
+// is initiated by kubelet
+#PodReasonTerminationByKubelet: "TerminationByKubelet"
+
+// PodReasonPreemptionByScheduler reason in DisruptionTarget pod condition indicates that the
+// disruption was initiated by scheduler's preemption.
+#PodReasonPreemptionByScheduler: "PreemptionByScheduler"
+
+// PodReasonDeferred reason in PodResizePending pod condition indicates the proposed resize is feasible in
+// theory (it fits on this node) but is not possible right now.
+#PodReasonDeferred: "Deferred"
+
+// PodReasonInfeasible reason in PodResizePending pod condition indicates the proposed resize is not
+// feasible and is rejected; it may not be re-evaluated
+#PodReasonInfeasible: "Infeasible"
+
+// PodReasonError reason in PodResizeInProgress pod condition indicates that an error occurred while
+// actuating the resize.
+#PodReasonError: "Error"
+
+// PodCondition contains details for the current condition of this pod.
+#PodCondition: {
+	// Type is the type of the condition.
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#pod-conditions
+	type: #PodConditionType @go(Type) @protobuf(1,bytes,opt,casttype=PodConditionType)
+
+	// If set, this represents the .metadata.generation that the pod condition was set based upon.
+	// +optional
+	observedGeneration?: int64 @go(ObservedGeneration) @protobuf(7,varint,opt)
+
+	// Status is the status of the condition.
+	// Can be True, False, Unknown.
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#pod-conditions
+	status: #ConditionStatus @go(Status) @protobuf(2,bytes,opt,casttype=ConditionStatus)
+
+	// Last time we probed the condition.
+	// +optional
+	lastProbeTime?: metav1.#Time @go(LastProbeTime) @protobuf(3,bytes,opt)
+
+	// Last time the condition transitioned from one status to another.
+	// +optional
+	lastTransitionTime?: metav1.#Time @go(LastTransitionTime) @protobuf(4,bytes,opt)
+
+	// Unique, one-word, CamelCase reason for the condition's last transition.
+	// +optional
+	reason?: string @go(Reason) @protobuf(5,bytes,opt)
+
+	// Human-readable message indicating details about last transition.
+	// +optional
+	message?: string @go(Message) @protobuf(6,bytes,opt)
+}
+
+// Deprecated: PodResizeStatus shows status of desired resize of a pod's containers.
+#PodResizeStatus: string // #enumPodResizeStatus
+
+#enumPodResizeStatus:
+	#PodResizeStatusInProgress |
+	#PodResizeStatusDeferred |
+	#PodResizeStatusInfeasible
+
+// Pod resources resize has been accepted by node and is being actuated.
+#PodResizeStatusInProgress: #PodResizeStatus & "InProgress"
+
+// Node cannot resize the pod at this time and will keep retrying.
+#PodResizeStatusDeferred: #PodResizeStatus & "Deferred"
+
+// Requested pod resize is not feasible and will not be re-evaluated.
+#PodResizeStatusInfeasible: #PodResizeStatus & "Infeasible"
+
+// VolumeMountStatus shows status of volume mounts.
+#VolumeMountStatus: {
+	// Name corresponds to the name of the original VolumeMount.
+	name: string @go(Name) @protobuf(1,bytes,opt)
+
+	// MountPath corresponds to the original VolumeMount.
+	mountPath: string @go(MountPath) @protobuf(2,bytes,opt)
+
+	// ReadOnly corresponds to the original VolumeMount.
+	// +optional
+	readOnly?: bool @go(ReadOnly) @protobuf(3,varint,opt)
+
+	// RecursiveReadOnly must be set to Disabled, Enabled, or unspecified (for non-readonly mounts).
+	// An IfPossible value in the original VolumeMount must be translated to Disabled or Enabled,
+	// depending on the mount result.
+	// +optional
+	recursiveReadOnly?: #RecursiveReadOnlyMode @go(RecursiveReadOnly,*RecursiveReadOnlyMode) @protobuf(4,bytes,opt,casttype=RecursiveReadOnlyMode)
+
+	// volumeStatus represents volume-type-specific status about the mounted
+	// volume.
+	// +optional
+	volumeStatus?: #VolumeStatus @go(VolumeStatus,*VolumeStatus) @protobuf(5,bytes,opt)
+}
+
+// VolumeStatus represents the status of a mounted volume.
+// At most one of its members must be specified.
+#VolumeStatus: {
+	// image represents an OCI object (a container image or artifact) pulled and mounted on the kubelet's host machine.
+	// +featureGate=ImageVolumeWithDigest
+	// +optional
+	image?: #ImageVolumeStatus @go(Image,*ImageVolumeStatus) @protobuf(1,bytes,opt)
+}
+
+// ImageVolumeStatus represents the image-based volume status.
+#ImageVolumeStatus: {
+	// ImageRef is the digest of the image used for this volume.
+	// It should have a value that's similar to the pod's status.containerStatuses[i].imageID.
+	// The ImageRef length should not exceed 256 characters.
+	// +kubebuilder:validation:MaxLength=256
+	// +required
+	imageRef: string @go(ImageRef) @protobuf(1,bytes,opt)
+}
+
+// RestartPolicy describes how the container should be restarted.
+// Only one of the following restart policies may be specified.
+// If none of the following policies is specified, the default one
+// is RestartPolicyAlways.
+// +enum
+#RestartPolicy: string // #enumRestartPolicy
+
+#enumRestartPolicy:
+	#RestartPolicyAlways |
+	#RestartPolicyOnFailure |
+	#RestartPolicyNever
+
+#RestartPolicyAlways:    #RestartPolicy & "Always"
+#RestartPolicyOnFailure: #RestartPolicy & "OnFailure"
+#RestartPolicyNever:     #RestartPolicy & "Never"
+
+// ContainerRestartPolicy is the restart policy for a single container.
+// The only allowed values are "Always", "Never", and "OnFailure".
+#ContainerRestartPolicy: string // #enumContainerRestartPolicy
+
+#enumContainerRestartPolicy:
+	#ContainerRestartPolicyAlways |
+	#ContainerRestartPolicyNever |
+	#ContainerRestartPolicyOnFailure
+
+#ContainerRestartPolicyAlways:    #ContainerRestartPolicy & "Always"
+#ContainerRestartPolicyNever:     #ContainerRestartPolicy & "Never"
+#ContainerRestartPolicyOnFailure: #ContainerRestartPolicy & "OnFailure"
+
+// ContainerRestartRule describes how a container exit is handled.
+#ContainerRestartRule: {
+	// Specifies the action taken on a container exit if the requirements
+	// are satisfied. The only possible value is "Restart" to restart the
+	// container.
+	// +required
+	action: #ContainerRestartRuleAction @go(Action) @protobuf(1,bytes,opt,casttype=ContainerRestartRuleAction)
+
+	// Represents the exit codes to check on container exits.
+	// +optional
+	// +oneOf=when
+	exitCodes?: #ContainerRestartRuleOnExitCodes @go(ExitCodes,*ContainerRestartRuleOnExitCodes) @protobuf(2,bytes,opt)
+}
+
+// ContainerRestartRuleAction describes the action to take when the
+// container exits.
+#ContainerRestartRuleAction: string // #enumContainerRestartRuleAction
+
+#enumContainerRestartRuleAction:
+	#ContainerRestartRuleActionRestart |
+	#ContainerRestartRuleActionRestartAllContainers
+
+#ContainerRestartRuleActionRestart:              #ContainerRestartRuleAction & "Restart"
+#ContainerRestartRuleActionRestartAllContainers: #ContainerRestartRuleAction & "RestartAllContainers"
+
+// ContainerRestartRuleOnExitCodes describes the condition
+// for handling an exited container based on its exit codes.
+#ContainerRestartRuleOnExitCodes: {
+	// Represents the relationship between the container exit code(s) and the
+	// specified values. Possible values are:
+	// - In: the requirement is satisfied if the container exit code is in the
+	//   set of specified values.
+	// - NotIn: the requirement is satisfied if the container exit code is
+	//   not in the set of specified values.
+	// +required
+	operator: #ContainerRestartRuleOnExitCodesOperator @go(Operator) @protobuf(1,bytes,opt,casttype=ContainerRestartRuleOnExitCodesOperator)
+
+	// Specifies the set of values to check for container exit codes.
+	// At most 255 elements are allowed.
+	// +optional
+	// +listType=set
+	values?: [...int32] @go(Values,[]int32) @protobuf(2,varint,rep)
+}
+
+// ContainerRestartRuleOnExitCodesOperator describes the operator
+// to take for the exit codes.
+#ContainerRestartRuleOnExitCodesOperator: string // #enumContainerRestartRuleOnExitCodesOperator
+
+#enumContainerRestartRuleOnExitCodesOperator:
+	#ContainerRestartRuleOnExitCodesOpIn |
+	#ContainerRestartRuleOnExitCodesOpNotIn
+
+#ContainerRestartRuleOnExitCodesOpIn:    #ContainerRestartRuleOnExitCodesOperator & "In"
+#ContainerRestartRuleOnExitCodesOpNotIn: #ContainerRestartRuleOnExitCodesOperator & "NotIn"
+
+// DNSPolicy defines how a pod's DNS will be configured.
+// +enum
+#DNSPolicy: string // #enumDNSPolicy
+
+#enumDNSPolicy:
+	#DNSClusterFirstWithHostNet |
+	#DNSClusterFirst |
+	#DNSDefault |
+	#DNSNone
+
+// DNSClusterFirstWithHostNet indicates that the pod should use cluster DNS
+// first, if it is available, then fall back on the default
+// (as determined by kubelet) DNS settings.
+#DNSClusterFirstWithHostNet: #DNSPolicy & "ClusterFirstWithHostNet"
+
+// DNSClusterFirst indicates that the pod should use cluster DNS
+// first unless hostNetwork is true, if it is available, then
+// fall back on the default (as determined by kubelet) DNS settings.
+#DNSClusterFirst: #DNSPolicy & "ClusterFirst"
+
+// DNSDefault indicates that the pod should use the default (as
+// determined by kubelet) DNS settings.
+#DNSDefault: #DNSPolicy & "Default"
+
+// DNSNone indicates that the pod should use empty DNS settings. DNS
+// parameters such as nameservers and search paths should be defined via
+// DNSConfig.
+#DNSNone: #DNSPolicy & "None"
+
+// DefaultTerminationGracePeriodSeconds indicates the default duration in
+// seconds a pod needs to terminate gracefully.
+#DefaultTerminationGracePeriodSeconds: 30
+
+// A node selector represents the union of the results of one or more label queries
+// over a set of nodes; that is, it represents the OR of the selectors represented
+// by the node selector terms.
+// +structType=atomic
+#NodeSelector: {
+	// Required. A list of node selector terms. The terms are ORed.
+	// +listType=atomic
+	nodeSelectorTerms: [...#NodeSelectorTerm] @go(NodeSelectorTerms,[]NodeSelectorTerm) @protobuf(1,bytes,rep)
+}
+
+// A null or empty node selector term matches no objects. The requirements of
+// them are ANDed.
+// The TopologySelectorTerm type implements a subset of the NodeSelectorTerm.
+// +structType=atomic
+#NodeSelectorTerm: {
+	// A list of node selector requirements by node's labels.
+	// +optional
+	// +listType=atomic
+	matchExpressions?: [...#NodeSelectorRequirement] @go(MatchExpressions,[]NodeSelectorRequirement) @protobuf(1,bytes,rep)
+
+	// A list of node selector requirements by node's fields.
+	// +optional
+	// +listType=atomic
+	matchFields?: [...#NodeSelectorRequirement] @go(MatchFields,[]NodeSelectorRequirement) @protobuf(2,bytes,rep)
+}
+
+// A node selector requirement is a selector that contains values, a key, and an operator
+// that relates the key and values.
+#NodeSelectorRequirement: {
+	// The label key that the selector applies to.
+	key: string @go(Key) @protobuf(1,bytes,opt)
+
+	// Represents a key's relationship to a set of values.
+	// Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+	operator: #NodeSelectorOperator @go(Operator) @protobuf(2,bytes,opt,casttype=NodeSelectorOperator)
+
+	// An array of string values. If the operator is In or NotIn,
+	// the values array must be non-empty. If the operator is Exists or DoesNotExist,
+	// the values array must be empty. If the operator is Gt or Lt, the values
+	// array must have a single element, which will be interpreted as an integer.
+	// This array is replaced during a strategic merge patch.
+	// +optional
+	// +listType=atomic
+	values?: [...string] @go(Values,[]string) @protobuf(3,bytes,rep)
+}
+
+// A node selector operator is the set of operators that can be used in
+// a node selector requirement.
+// +enum
+#NodeSelectorOperator: string // #enumNodeSelectorOperator
+
+#enumNodeSelectorOperator:
+	#NodeSelectorOpIn |
+	#NodeSelectorOpNotIn |
+	#NodeSelectorOpExists |
+	#NodeSelectorOpDoesNotExist |
+	#NodeSelectorOpGt |
+	#NodeSelectorOpLt
+
+#NodeSelectorOpIn:           #NodeSelectorOperator & "In"
+#NodeSelectorOpNotIn:        #NodeSelectorOperator & "NotIn"
+#NodeSelectorOpExists:       #NodeSelectorOperator & "Exists"
+#NodeSelectorOpDoesNotExist: #NodeSelectorOperator & "DoesNotExist"
+#NodeSelectorOpGt:           #NodeSelectorOperator & "Gt"
+#NodeSelectorOpLt:           #NodeSelectorOperator & "Lt"
+
+// A topology selector term represents the result of label queries.
+// A null or empty topology selector term matches no objects.
+// The requirements of them are ANDed.
+// It provides a subset of functionality as NodeSelectorTerm.
+// This is an alpha feature and may change in the future.
+// +structType=atomic
+#TopologySelectorTerm: {
+	// A list of topology selector requirements by labels.
+	// +optional
+	// +listType=atomic
+	matchLabelExpressions?: [...#TopologySelectorLabelRequirement] @go(MatchLabelExpressions,[]TopologySelectorLabelRequirement) @protobuf(1,bytes,rep)
+}
+
+// A topology selector requirement is a selector that matches given label.
+// This is an alpha feature and may change in the future.
+#TopologySelectorLabelRequirement: {
+	// The label key that the selector applies to.
+	key: string @go(Key) @protobuf(1,bytes,opt)
+
+	// An array of string values. One value must match the label to be selected.
+	// Each entry in Values is ORed.
+	// +listType=atomic
+	values: [...string] @go(Values,[]string) @protobuf(2,bytes,rep)
+}
+
+// Affinity is a group of affinity scheduling rules.
+#Affinity: {
+	// Describes node affinity scheduling rules for the pod.
+	// +optional
+	nodeAffinity?: #NodeAffinity @go(NodeAffinity,*NodeAffinity) @protobuf(1,bytes,opt)
+
+	// Describes pod affinity scheduling rules (e.g. co-locate this pod in the same node, zone, etc. as some other pod(s)).
+	// +optional
+	podAffinity?: #PodAffinity @go(PodAffinity,*PodAffinity) @protobuf(2,bytes,opt)
+
+	// Describes pod anti-affinity scheduling rules (e.g. avoid putting this pod in the same node, zone, etc. as some other pod(s)).
+	// +optional
+	podAntiAffinity?: #PodAntiAffinity @go(PodAntiAffinity,*PodAntiAffinity) @protobuf(3,bytes,opt)
+}
+
+// Pod affinity is a group of inter pod affinity scheduling rules.
+#PodAffinity: {
+	// If the affinity requirements specified by this field are not met at
+	// scheduling time, the pod will not be scheduled onto the node.
+	// If the affinity requirements specified by this field cease to be met
+	// at some point during pod execution (e.g. due to a pod label update), the
+	// system may or may not try to eventually evict the pod from its node.
+	// When there are multiple elements, the lists of nodes corresponding to each
+	// podAffinityTerm are intersected, i.e. all terms must be satisfied.
+	// +optional
+	// +listType=atomic
+	requiredDuringSchedulingIgnoredDuringExecution?: [...#PodAffinityTerm] @go(RequiredDuringSchedulingIgnoredDuringExecution,[]PodAffinityTerm) @protobuf(1,bytes,rep)
+
+	// The scheduler will prefer to schedule pods to nodes that satisfy
+	// the affinity expressions specified by this field, but it may choose
+	// a node that violates one or more of the expressions. The node that is
+	// most preferred is the one with the greatest sum of weights, i.e.
+	// for each node that meets all of the scheduling requirements (resource
+	// request, requiredDuringScheduling affinity expressions, etc.),
+	// compute a sum by iterating through the elements of this field and adding
+	// "weight" to the sum if the node has pods which matches the corresponding podAffinityTerm; the
+	// node(s) with the highest sum are the most preferred.
+	// +optional
+	// +listType=atomic
+	preferredDuringSchedulingIgnoredDuringExecution?: [...#WeightedPodAffinityTerm] @go(PreferredDuringSchedulingIgnoredDuringExecution,[]WeightedPodAffinityTerm) @protobuf(2,bytes,rep)
+}
+
+// Pod anti affinity is a group of inter pod anti affinity scheduling rules.
+#PodAntiAffinity: {
+	// If the anti-affinity requirements specified by this field are not met at
+	// scheduling time, the pod will not be scheduled onto the node.
+	// If the anti-affinity requirements specified by this field cease to be met
+	// at some point during pod execution (e.g. due to a pod label update), the
+	// system may or may not try to eventually evict the pod from its node.
+	// When there are multiple elements, the lists of nodes corresponding to each
+	// podAffinityTerm are intersected, i.e. all terms must be satisfied.
+	// +optional
+	// +listType=atomic
+	requiredDuringSchedulingIgnoredDuringExecution?: [...#PodAffinityTerm] @go(RequiredDuringSchedulingIgnoredDuringExecution,[]PodAffinityTerm) @protobuf(1,bytes,rep)
+
+	// The scheduler will prefer to schedule pods to nodes that satisfy
+	// the anti-affinity expressions specified by this field, but it may choose
+	// a node that violates one or more of the expressions. The node that is
+	// most preferred is the one with the greatest sum of weights, i.e.
+	// for each node that meets all of the scheduling requirements (resource
+	// request, requiredDuringScheduling anti-affinity expressions, etc.),
+	// compute a sum by iterating through the elements of this field and subtracting
+	// "weight" from the sum if the node has pods which matches the corresponding podAffinityTerm; the
+	// node(s) with the highest sum are the most preferred.
+	// +optional
+	// +listType=atomic
+	preferredDuringSchedulingIgnoredDuringExecution?: [...#WeightedPodAffinityTerm] @go(PreferredDuringSchedulingIgnoredDuringExecution,[]WeightedPodAffinityTerm) @protobuf(2,bytes,rep)
+}
+
+// The weights of all of the matched WeightedPodAffinityTerm fields are added per-node to find the most preferred node(s)
+#WeightedPodAffinityTerm: {
+	// weight associated with matching the corresponding podAffinityTerm,
+	// in the range 1-100.
+	weight: int32 @go(Weight) @protobuf(1,varint,opt)
+
+	// Required. A pod affinity term, associated with the corresponding weight.
+	podAffinityTerm: #PodAffinityTerm @go(PodAffinityTerm) @protobuf(2,bytes,opt)
+}
+
+// Defines a set of pods (namely those matching the labelSelector
+// relative to the given namespace(s)) that this pod should be
+// co-located (affinity) or not co-located (anti-affinity) with,
+// where co-located is defined as running on a node whose value of
+// the label with key <topologyKey> matches that of any node on which
+// a pod of the set of pods is running
+#PodAffinityTerm: {
+	// A label query over a set of resources, in this case pods.
+	// If it's null, this PodAffinityTerm matches with no Pods.
+	// +optional
+	labelSelector?: metav1.#LabelSelector @go(LabelSelector,*metav1.LabelSelector) @protobuf(1,bytes,opt)
+
+	// namespaces specifies a static list of namespace names that the term applies to.
+	// The term is applied to the union of the namespaces listed in this field
+	// and the ones selected by namespaceSelector.
+	// null or empty namespaces list and null namespaceSelector means "this pod's namespace".
+	// +optional
+	// +listType=atomic
+	namespaces?: [...string] @go(Namespaces,[]string) @protobuf(2,bytes,rep)
+
+	// This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+	// the labelSelector in the specified namespaces, where co-located is defined as running on a node
+	// whose value of the label with key topologyKey matches that of any node on which any of the
+	// selected pods is running.
+	// Empty topologyKey is not allowed.
+	topologyKey: string @go(TopologyKey) @protobuf(3,bytes,opt)
+
+	// A label query over the set of namespaces that the term applies to.
+	// The term is applied to the union of the namespaces selected by this field
+	// and the ones listed in the namespaces field.
+	// null selector and null or empty namespaces list means "this pod's namespace".
+	// An empty selector ({}) matches all namespaces.
+	// +optional
+	namespaceSelector?: metav1.#LabelSelector @go(NamespaceSelector,*metav1.LabelSelector) @protobuf(4,bytes,opt)
+
+	// MatchLabelKeys is a set of pod label keys to select which pods will
+	// be taken into consideration. The keys are used to lookup values from the
+	// incoming pod labels, those key-value labels are merged with `labelSelector` as `key in (value)`
+	// to select the group of existing pods which pods will be taken into consideration
+	// for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+	// pod labels will be ignored. The default value is empty.
+	// The same key is forbidden to exist in both matchLabelKeys and labelSelector.
+	// Also, matchLabelKeys cannot be set when labelSelector isn't set.
+	//
+	// +listType=atomic
+	// +optional
+	matchLabelKeys?: [...string] @go(MatchLabelKeys,[]string) @protobuf(5,bytes,opt)
+
+	// MismatchLabelKeys is a set of pod label keys to select which pods will
+	// be taken into consideration. The keys are used to lookup values from the
+	// incoming pod labels, those key-value labels are merged with `labelSelector` as `key notin (value)`
+	// to select the group of existing pods which pods will be taken into consideration
+	// for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+	// pod labels will be ignored. The default value is empty.
+	// The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
+	// Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
+	//
+	// +listType=atomic
+	// +optional
+	mismatchLabelKeys?: [...string] @go(MismatchLabelKeys,[]string) @protobuf(6,bytes,opt)
+}
+
+// Node affinity is a group of node affinity scheduling rules.
+#NodeAffinity: {
+	// If the affinity requirements specified by this field are not met at
+	// scheduling time, the pod will not be scheduled onto the node.
+	// If the affinity requirements specified by this field cease to be met
+	// at some point during pod execution (e.g. due to an update), the system
+	// may or may not try to eventually evict the pod from its node.
+	// +optional
+	requiredDuringSchedulingIgnoredDuringExecution?: #NodeSelector @go(RequiredDuringSchedulingIgnoredDuringExecution,*NodeSelector) @protobuf(1,bytes,opt)
+
+	// The scheduler will prefer to schedule pods to nodes that satisfy
+	// the affinity expressions specified by this field, but it may choose
+	// a node that violates one or more of the expressions. The node that is
+	// most preferred is the one with the greatest sum of weights, i.e.
+	// for each node that meets all of the scheduling requirements (resource
+	// request, requiredDuringScheduling affinity expressions, etc.),
+	// compute a sum by iterating through the elements of this field and adding
+	// "weight" to the sum if the node matches the corresponding matchExpressions; the
+	// node(s) with the highest sum are the most preferred.
+	// +optional
+	// +listType=atomic
+	preferredDuringSchedulingIgnoredDuringExecution?: [...#PreferredSchedulingTerm] @go(PreferredDuringSchedulingIgnoredDuringExecution,[]PreferredSchedulingTerm) @protobuf(2,bytes,rep)
+}
+
+// An empty preferred scheduling term matches all objects with implicit weight 0
+// (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).
+#PreferredSchedulingTerm: {
+	// Weight associated with matching the corresponding nodeSelectorTerm, in the range 1-100.
+	weight: int32 @go(Weight) @protobuf(1,varint,opt)
+
+	// A node selector term, associated with the corresponding weight.
+	preference: #NodeSelectorTerm @go(Preference) @protobuf(2,bytes,opt)
+}
+
+// The node this Taint is attached to has the "effect" on
+// any pod that does not tolerate the Taint.
+#Taint: {
+	// Required. The taint key to be applied to a node.
+	key: string @go(Key) @protobuf(1,bytes,opt)
+
+	// The taint value corresponding to the taint key.
+	// +optional
+	value?: string @go(Value) @protobuf(2,bytes,opt)
+
+	// Required. The effect of the taint on pods
+	// that do not tolerate the taint.
+	// Valid effects are NoSchedule, PreferNoSchedule and NoExecute.
+	effect: #TaintEffect @go(Effect) @protobuf(3,bytes,opt,casttype=TaintEffect)
+
+	// TimeAdded represents the time at which the taint was added.
+	// +optional
+	timeAdded?: metav1.#Time @go(TimeAdded,*metav1.Time) @protobuf(4,bytes,opt)
+}
+
+// +enum
+#TaintEffect: string // #enumTaintEffect
+
+#enumTaintEffect:
+	#TaintEffectNoSchedule |
+	#TaintEffectPreferNoSchedule |
+	#TaintEffectNoExecute
+
+// Do not allow new pods to schedule onto the node unless they tolerate the taint,
+// but allow all pods submitted to Kubelet without going through the scheduler
+// to start, and allow all already-running pods to continue running.
+// Enforced by the scheduler.
+#TaintEffectNoSchedule: #TaintEffect & "NoSchedule"
+
+// Like TaintEffectNoSchedule, but the scheduler tries not to schedule
+// new pods onto the node, rather than prohibiting new pods from scheduling
+// onto the node entirely. Enforced by the scheduler.
+#TaintEffectPreferNoSchedule: #TaintEffect & "PreferNoSchedule"
+
+// Evict any already-running pods that do not tolerate the taint.
+// Currently enforced by NodeController.
+#TaintEffectNoExecute: #TaintEffect & "NoExecute"
+
+// The pod this Toleration is attached to tolerates any taint that matches
+// the triple <key,value,effect> using the matching operator <operator>.
+#Toleration: {
+	// Key is the taint key that the toleration applies to. Empty means match all taint keys.
+	// If the key is empty, operator must be Exists; this combination means to match all values and all keys.
+	// +optional
+	key?: string @go(Key) @protobuf(1,bytes,opt)
+
+	// Operator represents a key's relationship to the value.
+	// Valid operators are Exists, Equal, Lt, and Gt. Defaults to Equal.
+	// Exists is equivalent to wildcard for value, so that a pod can
+	// tolerate all taints of a particular category.
+	// Lt and Gt perform numeric comparisons (requires feature gate TaintTolerationComparisonOperators).
+	// +optional
+	operator?: #TolerationOperator @go(Operator) @protobuf(2,bytes,opt,casttype=TolerationOperator)
+
+	// Value is the taint value the toleration matches to.
+	// If the operator is Exists, the value should be empty, otherwise just a regular string.
+	// +optional
+	value?: string @go(Value) @protobuf(3,bytes,opt)
+
+	// Effect indicates the taint effect to match. Empty means match all taint effects.
+	// When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.
+	// +optional
+	effect?: #TaintEffect @go(Effect) @protobuf(4,bytes,opt,casttype=TaintEffect)
+
+	// TolerationSeconds represents the period of time the toleration (which must be
+	// of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default,
+	// it is not set, which means tolerate the taint forever (do not evict). Zero and
+	// negative values will be treated as 0 (evict immediately) by the system.
+	// +optional
+	tolerationSeconds?: int64 @go(TolerationSeconds,*int64) @protobuf(5,varint,opt)
+}
+
+// A toleration operator is the set of operators that can be used in a toleration.
+// +enum
+#TolerationOperator: string // #enumTolerationOperator
+
+#enumTolerationOperator:
+	#TolerationOpExists |
+	#TolerationOpEqual |
+	#TolerationOpLt |
+	#TolerationOpGt
+
+#TolerationOpExists: #TolerationOperator & "Exists"
+#TolerationOpEqual:  #TolerationOperator & "Equal"
+#TolerationOpLt:     #TolerationOperator & "Lt"
+#TolerationOpGt:     #TolerationOperator & "Gt"
+
+// PodReadinessGate contains the reference to a pod condition
+#PodReadinessGate: {
+	// ConditionType refers to a condition in the pod's condition list with matching type.
+	conditionType: #PodConditionType @go(ConditionType) @protobuf(1,bytes,opt,casttype=PodConditionType)
+}
+
+// PodSpec is a description of a pod.
+#PodSpec: {
+	// List of volumes that can be mounted by containers belonging to the pod.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge,retainKeys
+	// +listType=map
+	// +listMapKey=name
+	volumes?: [...#Volume] @go(Volumes,[]Volume) @protobuf(1,bytes,rep)
+
+	// List of initialization containers belonging to the pod.
+	// Init containers are executed in order prior to containers being started. If any
+	// init container fails, the pod is considered to have failed and is handled according
+	// to its restartPolicy. The name for an init container or normal container must be
+	// unique among all containers.
+	// Init containers may not have Lifecycle actions, Readiness probes, Liveness probes, or Startup probes.
+	// The resourceRequirements of an init container are taken into account during scheduling
+	// by finding the highest request/limit for each resource type, and then using the max of
+	// that value or the sum of the normal containers. Limits are applied to init containers
+	// in a similar fashion.
+	// Init containers cannot currently be added or removed.
+	// Cannot be updated.
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/init-containers/
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=name
+	initContainers?: [...#Container] @go(InitContainers,[]Container) @protobuf(20,bytes,rep)
+
+	// List of containers belonging to the pod.
+	// Containers cannot currently be added or removed.
+	// There must be at least one container in a Pod.
+	// Cannot be updated.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=name
+	containers: [...#Container] @go(Containers,[]Container) @protobuf(2,bytes,rep)
+
+	// List of ephemeral containers run in this pod. Ephemeral containers may be run in an existing
+	// pod to perform user-initiated actions such as debugging. This list cannot be specified when
+	// creating a pod, and it cannot be modified by updating the pod spec. In order to add an
+	// ephemeral container to an existing pod, use the pod's ephemeralcontainers subresource.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=name
+	ephemeralContainers?: [...#EphemeralContainer] @go(EphemeralContainers,[]EphemeralContainer) @protobuf(34,bytes,rep)
+
+	// Restart policy for all containers within the pod.
+	// One of Always, OnFailure, Never. In some contexts, only a subset of those values may be permitted.
+	// Default to Always.
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#restart-policy
+	// +optional
+	restartPolicy?: #RestartPolicy @go(RestartPolicy) @protobuf(3,bytes,opt,casttype=RestartPolicy)
+
+	// Optional duration in seconds the pod needs to terminate gracefully. May be decreased in delete request.
+	// Value must be non-negative integer. The value zero indicates stop immediately via
+	// the kill signal (no opportunity to shut down).
+	// If this value is nil, the default grace period will be used instead.
+	// The grace period is the duration in seconds after the processes running in the pod are sent
+	// a termination signal and the time when the processes are forcibly halted with a kill signal.
+	// Set this value longer than the expected cleanup time for your process.
+	// Defaults to 30 seconds.
+	// +optional
+	terminationGracePeriodSeconds?: int64 @go(TerminationGracePeriodSeconds,*int64) @protobuf(4,varint,opt)
+
+	// Optional duration in seconds the pod may be active on the node relative to
+	// StartTime before the system will actively try to mark it failed and kill associated containers.
+	// Value must be a positive integer.
+	// +optional
+	activeDeadlineSeconds?: int64 @go(ActiveDeadlineSeconds,*int64) @protobuf(5,varint,opt)
+
+	// Set DNS policy for the pod.
+	// Defaults to "ClusterFirst".
+	// Valid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'.
+	// DNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy.
+	// To have DNS options set along with hostNetwork, you have to specify DNS policy
+	// explicitly to 'ClusterFirstWithHostNet'.
+	// +optional
+	dnsPolicy?: #DNSPolicy @go(DNSPolicy) @protobuf(6,bytes,opt,casttype=DNSPolicy)
+
+	// NodeSelector is a selector which must be true for the pod to fit on a node.
+	// Selector which must match a node's labels for the pod to be scheduled on that node.
+	// More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
+	// +optional
+	// +mapType=atomic
+	nodeSelector?: {[string]: string} @go(NodeSelector,map[string]string) @protobuf(7,bytes,rep)
+
+	// ServiceAccountName is the name of the ServiceAccount to use to run this pod.
+	// More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/
+	// +optional
+	serviceAccountName?: string @go(ServiceAccountName) @protobuf(8,bytes,opt)
+
+	// DeprecatedServiceAccount is a deprecated alias for ServiceAccountName.
+	// Deprecated: Use serviceAccountName instead.
+	// +k8s:conversion-gen=false
+	// +optional
+	serviceAccount?: string @go(DeprecatedServiceAccount) @protobuf(9,bytes,opt)
+
+	// AutomountServiceAccountToken indicates whether a service account token should be automatically mounted.
+	// +optional
+	automountServiceAccountToken?: bool @go(AutomountServiceAccountToken,*bool) @protobuf(21,varint,opt)
+
+	// NodeName indicates in which node this pod is scheduled.
+	// If empty, this pod is a candidate for scheduling by the scheduler defined in schedulerName.
+	// Once this field is set, the kubelet for this node becomes responsible for the lifecycle of this pod.
+	// This field should not be used to express a desire for the pod to be scheduled on a specific node.
+	// https://kubernetes.io/docs/concepts/scheduling-eviction/assign-pod-node/#nodename
+	// +optional
+	nodeName?: string @go(NodeName) @protobuf(10,bytes,opt)
+
+	// Host networking requested for this pod. Use the host's network namespace.
+	// When using HostNetwork you should specify ports so the scheduler is aware.
+	// When `hostNetwork` is true, specified `hostPort` fields in port definitions must match `containerPort`,
+	// and unspecified `hostPort` fields in port definitions are defaulted to match `containerPort`.
+	// Default to false.
+	// +k8s:conversion-gen=false
+	// +optional
+	hostNetwork?: bool @go(HostNetwork) @protobuf(11,varint,opt)
+
+	// Use the host's pid namespace.
+	// Optional: Default to false.
+	// +k8s:conversion-gen=false
+	// +optional
+	hostPID?: bool @go(HostPID) @protobuf(12,varint,opt)
+
+	// Use the host's ipc namespace.
+	// Optional: Default to false.
+	// +k8s:conversion-gen=false
+	// +optional
+	hostIPC?: bool @go(HostIPC) @protobuf(13,varint,opt)
+
+	// Share a single process namespace between all of the containers in a pod.
+	// When this is set containers will be able to view and signal processes from other containers
+	// in the same pod, and the first process in each container will not be assigned PID 1.
+	// HostPID and ShareProcessNamespace cannot both be set.
+	// Optional: Default to false.
+	// +k8s:conversion-gen=false
+	// +optional
+	shareProcessNamespace?: bool @go(ShareProcessNamespace,*bool) @protobuf(27,varint,opt)
+
+	// SecurityContext holds pod-level security attributes and common container settings.
+	// Optional: Defaults to empty.  See type description for default values of each field.
+	// +optional
+	securityContext?: #PodSecurityContext @go(SecurityContext,*PodSecurityContext) @protobuf(14,bytes,opt)
+
+	// ImagePullSecrets is an optional list of references to secrets in the same namespace to use for pulling any of the images used by this PodSpec.
+	// If specified, these secrets will be passed to individual puller implementations for them to use.
+	// More info: https://kubernetes.io/docs/concepts/containers/images#specifying-imagepullsecrets-on-a-pod
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=name
+	imagePullSecrets?: [...#LocalObjectReference] @go(ImagePullSecrets,[]LocalObjectReference) @protobuf(15,bytes,rep)
+
+	// Specifies the hostname of the Pod
+	// If not specified, the pod's hostname will be set to a system-defined value.
+	// +optional
+	hostname?: string @go(Hostname) @protobuf(16,bytes,opt)
+
+	// If specified, the fully qualified Pod hostname will be "<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>".
+	// If not specified, the pod will not have a domainname at all.
+	// +optional
+	subdomain?: string @go(Subdomain) @protobuf(17,bytes,opt)
+
+	// If specified, the pod's scheduling constraints
+	// +optional
+	affinity?: #Affinity @go(Affinity,*Affinity) @protobuf(18,bytes,opt)
+
+	// If specified, the pod will be dispatched by specified scheduler.
+	// If not specified, the pod will be dispatched by default scheduler.
+	// +optional
+	schedulerName?: string @go(SchedulerName) @protobuf(19,bytes,opt)
+
+	// If specified, the pod's tolerations.
+	// +optional
+	// +listType=atomic
+	tolerations?: [...#Toleration] @go(Tolerations,[]Toleration) @protobuf(22,bytes,opt)
+
+	// HostAliases is an optional list of hosts and IPs that will be injected into the pod's hosts
+	// file if specified.
+	// +optional
+	// +patchMergeKey=ip
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=ip
+	hostAliases?: [...#HostAlias] @go(HostAliases,[]HostAlias) @protobuf(23,bytes,rep)
+
+	// If specified, indicates the pod's priority. "system-node-critical" and
+	// "system-cluster-critical" are two special keywords which indicate the
+	// highest priorities with the former being the highest priority. Any other
+	// name must be defined by creating a PriorityClass object with that name.
+	// If not specified, the pod priority will be default or zero if there is no
+	// default.
+	// +optional
+	priorityClassName?: string @go(PriorityClassName) @protobuf(24,bytes,opt)
+
+	// The priority value. Various system components use this field to find the
+	// priority of the pod. When Priority Admission Controller is enabled, it
+	// prevents users from setting this field. The admission controller populates
+	// this field from PriorityClassName.
+	// The higher the value, the higher the priority.
+	// +optional
+	priority?: int32 @go(Priority,*int32) @protobuf(25,bytes,opt)
+
+	// Specifies the DNS parameters of a pod.
+	// Parameters specified here will be merged to the generated DNS
+	// configuration based on DNSPolicy.
+	// +optional
+	dnsConfig?: #PodDNSConfig @go(DNSConfig,*PodDNSConfig) @protobuf(26,bytes,opt)
+
+	// If specified, all readiness gates will be evaluated for pod readiness.
+	// A pod is ready when all its containers are ready AND
+	// all conditions specified in the readiness gates have status equal to "True"
+	// More info: https://git.k8s.io/enhancements/keps/sig-network/580-pod-readiness-gates
+	// +optional
+	// +listType=atomic
+	readinessGates?: [...#PodReadinessGate] @go(ReadinessGates,[]PodReadinessGate) @protobuf(28,bytes,opt)
+
+	// RuntimeClassName refers to a RuntimeClass object in the node.k8s.io group, which should be used
+	// to run this pod.  If no RuntimeClass resource matches the named class, the pod will not be run.
+	// If unset or empty, the "legacy" RuntimeClass will be used, which is an implicit class with an
+	// empty definition that uses the default runtime handler.
+	// More info: https://git.k8s.io/enhancements/keps/sig-node/585-runtime-class
+	// +optional
+	runtimeClassName?: string @go(RuntimeClassName,*string) @protobuf(29,bytes,opt)
+
+	// EnableServiceLinks indicates whether information about services should be injected into pod's
+	// environment variables, matching the syntax of Docker links.
+	// Optional: Defaults to true.
+	// +optional
+	enableServiceLinks?: bool @go(EnableServiceLinks,*bool) @protobuf(30,varint,opt)
+
+	// PreemptionPolicy is the Policy for preempting pods with lower priority.
+	// One of Never, PreemptLowerPriority.
+	// Defaults to PreemptLowerPriority if unset.
+	// +optional
+	preemptionPolicy?: #PreemptionPolicy @go(PreemptionPolicy,*PreemptionPolicy) @protobuf(31,bytes,opt)
+
+	// Overhead represents the resource overhead associated with running a pod for a given RuntimeClass.
+	// This field will be autopopulated at admission time by the RuntimeClass admission controller. If
+	// the RuntimeClass admission controller is enabled, overhead must not be set in Pod create requests.
+	// The RuntimeClass admission controller will reject Pod create requests which have the overhead already
+	// set. If RuntimeClass is configured and selected in the PodSpec, Overhead will be set to the value
+	// defined in the corresponding RuntimeClass, otherwise it will remain unset and treated as zero.
+	// More info: https://git.k8s.io/enhancements/keps/sig-node/688-pod-overhead/README.md
+	// +optional
+	overhead?: #ResourceList @go(Overhead) @protobuf(32,bytes,opt)
+
+	// TopologySpreadConstraints describes how a group of pods ought to spread across topology
+	// domains. Scheduler will schedule pods in a way which abides by the constraints.
+	// All topologySpreadConstraints are ANDed.
+	// +optional
+	// +patchMergeKey=topologyKey
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=topologyKey
+	// +listMapKey=whenUnsatisfiable
+	topologySpreadConstraints?: [...#TopologySpreadConstraint] @go(TopologySpreadConstraints,[]TopologySpreadConstraint) @protobuf(33,bytes,opt)
+
+	// If true the pod's hostname will be configured as the pod's FQDN, rather than the leaf name (the default).
+	// In Linux containers, this means setting the FQDN in the hostname field of the kernel (the nodename field of struct utsname).
+	// In Windows containers, this means setting the registry value of hostname for the registry key HKEY_LOCAL_MACHINE\\SYSTEM\\CurrentControlSet\\Services\\Tcpip\\Parameters to FQDN.
+	// If a pod does not have FQDN, this has no effect.
+	// Default to false.
+	// +optional
+	setHostnameAsFQDN?: bool @go(SetHostnameAsFQDN,*bool) @protobuf(35,varint,opt)
+
+	// Specifies the OS of the containers in the pod.
+	// Some pod and container fields are restricted if this is set.
+	//
+	// If the OS field is set to linux, the following fields must be unset:
+	// -securityContext.windowsOptions
+	//
+	// If the OS field is set to windows, following fields must be unset:
+	// - spec.hostPID
+	// - spec.hostIPC
+	// - spec.hostUsers
+	// - spec.resources
+	// - spec.securityContext.appArmorProfile
+	// - spec.securityContext.seLinuxOptions
+	// - spec.securityContext.seccompProfile
+	// - spec.securityContext.fsGroup
+	// - spec.securityContext.fsGroupChangePolicy
+	// - spec.securityContext.sysctls
+	// - spec.shareProcessNamespace
+	// - spec.securityContext.runAsUser
+	// - spec.securityContext.runAsGroup
+	// - spec.securityContext.supplementalGroups
+	// - spec.securityContext.supplementalGroupsPolicy
+	// - spec.containers[*].securityContext.appArmorProfile
+	// - spec.containers[*].securityContext.seLinuxOptions
+	// - spec.containers[*].securityContext.seccompProfile
+	// - spec.containers[*].securityContext.capabilities
+	// - spec.containers[*].securityContext.readOnlyRootFilesystem
+	// - spec.containers[*].securityContext.privileged
+	// - spec.containers[*].securityContext.allowPrivilegeEscalation
+	// - spec.containers[*].securityContext.procMount
+	// - spec.containers[*].securityContext.runAsUser
+	// - spec.containers[*].securityContext.runAsGroup
+	// +optional
+	os?: #PodOS @go(OS,*PodOS) @protobuf(36,bytes,opt)
+
+	// Use the host's user namespace.
+	// Optional: Default to true.
+	// If set to true or not present, the pod will be run in the host user namespace, useful
+	// for when the pod needs a feature only available to the host user namespace, such as
+	// loading a kernel module with CAP_SYS_MODULE.
+	// When set to false, a new userns is created for the pod. Setting false is useful for
+	// mitigating container breakout vulnerabilities even allowing users to run their
+	// containers as root without actually having root privileges on the host.
+	// +k8s:conversion-gen=false
+	// +optional
+	hostUsers?: bool @go(HostUsers,*bool) @protobuf(37,bytes,opt)
+
+	// SchedulingGates is an opaque list of values that if specified will block scheduling the pod.
+	// If schedulingGates is not empty, the pod will stay in the SchedulingGated state and the
+	// scheduler will not attempt to schedule the pod.
+	//
+	// SchedulingGates can only be set at pod creation time, and be removed only afterwards.
+	//
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	schedulingGates?: [...#PodSchedulingGate] @go(SchedulingGates,[]PodSchedulingGate) @protobuf(38,bytes,opt)
+
+	// ResourceClaims defines which ResourceClaims must be allocated
+	// and reserved before the Pod is allowed to start. The resources
+	// will be made available to those containers which consume them
+	// by name.
+	//
+	// This is a stable field but requires that the
+	// DynamicResourceAllocation feature gate is enabled.
+	//
+	// This field is immutable.
+	//
+	// +patchMergeKey=name
+	// +patchStrategy=merge,retainKeys
+	// +listType=map
+	// +listMapKey=name
+	// +featureGate=DynamicResourceAllocation
+	// +optional
+	resourceClaims?: [...#PodResourceClaim] @go(ResourceClaims,[]PodResourceClaim) @protobuf(39,bytes,rep)
+
+	// Resources is the total amount of CPU and Memory resources required by all
+	// containers in the pod. It supports specifying Requests and Limits for
+	// "cpu", "memory" and "hugepages-" resource names only. ResourceClaims are not supported.
+	//
+	// This field enables fine-grained control over resource allocation for the
+	// entire pod, allowing resource sharing among containers in a pod.
+	// TODO: For beta graduation, expand this comment with a detailed explanation.
+	//
+	// This is an alpha field and requires enabling the PodLevelResources feature
+	// gate.
+	//
+	// +featureGate=PodLevelResources
+	// +optional
+	resources?: #ResourceRequirements @go(Resources,*ResourceRequirements) @protobuf(40,bytes,opt)
+
+	// HostnameOverride specifies an explicit override for the pod's hostname as perceived by the pod.
+	// This field only specifies the pod's hostname and does not affect its DNS records.
+	// When this field is set to a non-empty string:
+	// - It takes precedence over the values set in `hostname` and `subdomain`.
+	// - The Pod's hostname will be set to this value.
+	// - `setHostnameAsFQDN` must be nil or set to false.
+	// - `hostNetwork` must be set to false.
+	//
+	// This field must be a valid DNS subdomain as defined in RFC 1123 and contain at most 64 characters.
+	// Requires the HostnameOverride feature gate to be enabled.
+	//
+	// +featureGate=HostnameOverride
+	// +optional
+	hostnameOverride?: string @go(HostnameOverride,*string) @protobuf(41,bytes,opt)
+
+	// SchedulingGroup provides a reference to the immediate scheduling runtime
+	// grouping object that this Pod belongs to.
+	// This field is used by the scheduler to identify the group and apply the
+	// correct group scheduling policies. The association with a group also
+	// impacts other lifecycle aspects of a Pod that are relevant in a wider context
+	// of scheduling like preemption, resource attachment, etc. If not specified,
+	// the Pod is treated as a single unit in all of these aspects.
+	// The group object referenced by this field may not exist at the time the
+	// Pod is created.
+	// This field is immutable, but a group object with the same name may be
+	// recreated with different policies. Doing this during pod scheduling
+	// may result in the placement not conforming to the expected policies.
+	//
+	// +featureGate=GenericWorkload
+	// +optional
+	schedulingGroup?: #PodSchedulingGroup @go(SchedulingGroup,*PodSchedulingGroup) @protobuf(43,bytes,opt)
+}
+
+// PodResourceClaim references exactly one ResourceClaim, either directly
+// or by naming a ResourceClaimTemplate which is then turned into a ResourceClaim
+// for the pod.
+//
+// It adds a name to it that uniquely identifies the ResourceClaim inside the Pod.
+// Containers that need access to the ResourceClaim reference it with this name.
+//
+// When the DRAWorkloadResourceClaims feature gate is enabled and this Pod
+// belongs to a PodGroup, a PodResourceClaim is matched to a
+// PodGroupResourceClaim if all of their fields are equal (Name,
+// ResourceClaimName, and ResourceClaimTemplateName). A matched claim references
+// a single ResourceClaim shared across all Pods in the PodGroup, reserved for
+// the PodGroup in ResourceClaimStatus.ReservedFor rather than for individual
+// Pods.
+#PodResourceClaim: {
+	// Name uniquely identifies this resource claim inside the pod.
+	// This must be a DNS_LABEL.
+	name: string @go(Name) @protobuf(1,bytes)
+
+	// ResourceClaimName is the name of a ResourceClaim object in the same
+	// namespace as this pod.
+	//
+	// Exactly one of ResourceClaimName and ResourceClaimTemplateName must
+	// be set.
+	resourceClaimName?: string @go(ResourceClaimName,*string) @protobuf(3,bytes,opt)
+
+	// ResourceClaimTemplateName is the name of a ResourceClaimTemplate
+	// object in the same namespace as this pod.
+	//
+	// The template will be used to create a new ResourceClaim, which will
+	// be bound to this pod. When this pod is deleted, the ResourceClaim
+	// will also be deleted. The pod name and resource name, along with a
+	// generated component, will be used to form a unique name for the
+	// ResourceClaim, which will be recorded in pod.status.resourceClaimStatuses.
+	//
+	// When the DRAWorkloadResourceClaims feature gate is enabled and the pod
+	// belongs to a PodGroup that defines a PodGroupResourceClaim with the same
+	// Name and ResourceClaimTemplateName, this PodResourceClaim resolves to the
+	// ResourceClaim generated for the PodGroup. All pods in the group that
+	// define an equivalent PodResourceClaim matching the
+	// PodGroupResourceClaim's Name and ResourceClaimTemplateName share the same
+	// generated ResourceClaim. ResourceClaims generated for a PodGroup are
+	// owned by the PodGroup and their lifecycles are tied to the PodGroup
+	// instead of any individual pod.
+	//
+	// This field is immutable and no changes will be made to the
+	// corresponding ResourceClaim by the control plane after creating the
+	// ResourceClaim.
+	//
+	// Exactly one of ResourceClaimName and ResourceClaimTemplateName must
+	// be set.
+	resourceClaimTemplateName?: string @go(ResourceClaimTemplateName,*string) @protobuf(4,bytes,opt)
+}
+
+// PodResourceClaimStatus is stored in the PodStatus for each PodResourceClaim
+// which references a ResourceClaimTemplate. It stores the generated name for
+// the corresponding ResourceClaim.
+#PodResourceClaimStatus: {
+	// Name uniquely identifies this resource claim inside the pod.
+	// This must match the name of an entry in pod.spec.resourceClaims,
+	// which implies that the string must be a DNS_LABEL.
+	name: string @go(Name) @protobuf(1,bytes)
+
+	// ResourceClaimName is the name of the ResourceClaim that was
+	// generated for the Pod in the namespace of the Pod.
+	//
+	// When the DRAWorkloadResourceClaims feature is enabled and the
+	// corresponding PodResourceClaim matches a PodGroupResourceClaim
+	// made by the Pod's PodGroup, then this is the name of the
+	// ResourceClaim generated and reserved for the PodGroup.
+	//
+	// If this is unset, then generating a ResourceClaim was not
+	// necessary. The pod.spec.resourceClaims entry can be ignored in
+	// this case.
+	//
+	// +optional
+	resourceClaimName?: string @go(ResourceClaimName,*string) @protobuf(2,bytes,opt)
+}
+
+// PodExtendedResourceClaimStatus is stored in the PodStatus for the extended
+// resource requests backed by DRA. It stores the generated name for
+// the corresponding special ResourceClaim created by the scheduler.
+#PodExtendedResourceClaimStatus: {
+	// RequestMappings identifies the mapping of <container, extended resource backed by DRA> to  device request
+	// in the generated ResourceClaim.
+	// +listType=atomic
+	requestMappings: [...#ContainerExtendedResourceRequest] @go(RequestMappings,[]ContainerExtendedResourceRequest) @protobuf(1,bytes,rep)
+
+	// ResourceClaimName is the name of the ResourceClaim that was
+	// generated for the Pod in the namespace of the Pod.
+	resourceClaimName: string @go(ResourceClaimName) @protobuf(2,bytes)
+}
+
+// ContainerExtendedResourceRequest has the mapping of container name,
+// extended resource name to the device request name.
+#ContainerExtendedResourceRequest: {
+	// The name of the container requesting resources.
+	containerName: string @go(ContainerName) @protobuf(1,bytes)
+
+	// The name of the extended resource in that container which gets backed by DRA.
+	resourceName: string @go(ResourceName) @protobuf(2,bytes)
+
+	// The name of the request in the special ResourceClaim which corresponds to the extended resource.
+	requestName: string @go(RequestName) @protobuf(3,bytes)
+}
+
+// OSName is the set of OS'es that can be used in OS.
+#OSName: string // #enumOSName
+
+#enumOSName:
+	#Linux |
+	#Windows
+
+#Linux:   #OSName & "linux"
+#Windows: #OSName & "windows"
+
+// PodOS defines the OS parameters of a pod.
+#PodOS: {
+	// Name is the name of the operating system. The currently supported values are linux and windows.
+	// Additional value may be defined in future and can be one of:
+	// https://github.com/opencontainers/runtime-spec/blob/master/config.md#platform-specific-configuration
+	// Clients should expect to handle additional values and treat unrecognized values in this field as os: null
+	name: #OSName @go(Name) @protobuf(1,bytes,opt)
+}
+
+// PodSchedulingGate is associated to a Pod to guard its scheduling.
+#PodSchedulingGate: {
+	// Name of the scheduling gate.
+	// Each scheduling gate must have a unique name field.
+	name: string @go(Name) @protobuf(1,bytes,opt)
+}
+
+// PodSchedulingGroup identifies the runtime scheduling group instance that a Pod belongs to.
+// The scheduler uses this information to apply workload-aware scheduling semantics.
+// Exactly one field must be specified.
+// +union
+#PodSchedulingGroup: {
+	// PodGroupName specifies the name of the standalone PodGroup object
+	// that represents the runtime instance of this group.
+	// Must be a DNS subdomain.
+	//
+	// +optional
+	// +oneOf=GroupSelection
+	podGroupName?: string @go(PodGroupName,*string) @protobuf(1,bytes,opt)
+}
+
+// +enum
+#UnsatisfiableConstraintAction: string // #enumUnsatisfiableConstraintAction
+
+#enumUnsatisfiableConstraintAction:
+	#DoNotSchedule |
+	#ScheduleAnyway
+
+// DoNotSchedule instructs the scheduler not to schedule the pod
+// when constraints are not satisfied.
+#DoNotSchedule: #UnsatisfiableConstraintAction & "DoNotSchedule"
+
+// ScheduleAnyway instructs the scheduler to schedule the pod
+// even if constraints are not satisfied.
+#ScheduleAnyway: #UnsatisfiableConstraintAction & "ScheduleAnyway"
+
+// NodeInclusionPolicy defines the type of node inclusion policy
+// +enum
+#NodeInclusionPolicy: string // #enumNodeInclusionPolicy
+
+#enumNodeInclusionPolicy:
+	#NodeInclusionPolicyIgnore |
+	#NodeInclusionPolicyHonor
+
+// NodeInclusionPolicyIgnore means ignore this scheduling directive when calculating pod topology spread skew.
+#NodeInclusionPolicyIgnore: #NodeInclusionPolicy & "Ignore"
+
+// NodeInclusionPolicyHonor means use this scheduling directive when calculating pod topology spread skew.
+#NodeInclusionPolicyHonor: #NodeInclusionPolicy & "Honor"
+
+// TopologySpreadConstraint specifies how to spread matching pods among the given topology.
+#TopologySpreadConstraint: {
+	// MaxSkew describes the degree to which pods may be unevenly distributed.
+	// When `whenUnsatisfiable=DoNotSchedule`, it is the maximum permitted difference
+	// between the number of matching pods in the target topology and the global minimum.
+	// The global minimum is the minimum number of matching pods in an eligible domain
+	// or zero if the number of eligible domains is less than MinDomains.
+	// For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+	// labelSelector spread as 2/2/1:
+	// In this case, the global minimum is 1.
+	// +-------+-------+-------+
+	// | zone1 | zone2 | zone3 |
+	// +-------+-------+-------+
+	// |  P P  |  P P  |   P   |
+	// +-------+-------+-------+
+	// - if MaxSkew is 1, incoming pod can only be scheduled to zone3 to become 2/2/2;
+	// scheduling it onto zone1(zone2) would make the ActualSkew(3-1) on zone1(zone2)
+	// violate MaxSkew(1).
+	// - if MaxSkew is 2, incoming pod can be scheduled onto any zone.
+	// When `whenUnsatisfiable=ScheduleAnyway`, it is used to give higher precedence
+	// to topologies that satisfy it.
+	// It's a required field. Default value is 1 and 0 is not allowed.
+	maxSkew: int32 @go(MaxSkew) @protobuf(1,varint,opt)
+
+	// TopologyKey is the key of node labels. Nodes that have a label with this key
+	// and identical values are considered to be in the same topology.
+	// We consider each <key, value> as a "bucket", and try to put balanced number
+	// of pods into each bucket.
+	// We define a domain as a particular instance of a topology.
+	// Also, we define an eligible domain as a domain whose nodes meet the requirements of
+	// nodeAffinityPolicy and nodeTaintsPolicy.
+	// e.g. If TopologyKey is "kubernetes.io/hostname", each Node is a domain of that topology.
+	// And, if TopologyKey is "topology.kubernetes.io/zone", each zone is a domain of that topology.
+	// It's a required field.
+	topologyKey: string @go(TopologyKey) @protobuf(2,bytes,opt)
+
+	// WhenUnsatisfiable indicates how to deal with a pod if it doesn't satisfy
+	// the spread constraint.
+	// - DoNotSchedule (default) tells the scheduler not to schedule it.
+	// - ScheduleAnyway tells the scheduler to schedule the pod in any location,
+	//   but giving higher precedence to topologies that would help reduce the
+	//   skew.
+	// A constraint is considered "Unsatisfiable" for an incoming pod
+	// if and only if every possible node assignment for that pod would violate
+	// "MaxSkew" on some topology.
+	// For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+	// labelSelector spread as 3/1/1:
+	// +-------+-------+-------+
+	// | zone1 | zone2 | zone3 |
+	// +-------+-------+-------+
+	// | P P P |   P   |   P   |
+	// +-------+-------+-------+
+	// If WhenUnsatisfiable is set to DoNotSchedule, incoming pod can only be scheduled
+	// to zone2(zone3) to become 3/2/1(3/1/2) as ActualSkew(2-1) on zone2(zone3) satisfies
+	// MaxSkew(1). In other words, the cluster can still be imbalanced, but scheduler
+	// won't make it *more* imbalanced.
+	// It's a required field.
+	whenUnsatisfiable: #UnsatisfiableConstraintAction @go(WhenUnsatisfiable) @protobuf(3,bytes,opt,casttype=UnsatisfiableConstraintAction)
+
+	// LabelSelector is used to find matching pods.
+	// Pods that match this label selector are counted to determine the number of pods
+	// in their corresponding topology domain.
+	// +optional
+	labelSelector?: metav1.#LabelSelector @go(LabelSelector,*metav1.LabelSelector) @protobuf(4,bytes,opt)
+
+	// MinDomains indicates a minimum number of eligible domains.
+	// When the number of eligible domains with matching topology keys is less than minDomains,
+	// Pod Topology Spread treats "global minimum" as 0, and then the calculation of Skew is performed.
+	// And when the number of eligible domains with matching topology keys equals or greater than minDomains,
+	// this value has no effect on scheduling.
+	// As a result, when the number of eligible domains is less than minDomains,
+	// scheduler won't schedule more than maxSkew Pods to those domains.
+	// If value is nil, the constraint behaves as if MinDomains is equal to 1.
+	// Valid values are integers greater than 0.
+	// When value is not nil, WhenUnsatisfiable must be DoNotSchedule.
+	//
+	// For example, in a 3-zone cluster, MaxSkew is set to 2, MinDomains is set to 5 and pods with the same
+	// labelSelector spread as 2/2/2:
+	// +-------+-------+-------+
+	// | zone1 | zone2 | zone3 |
+	// +-------+-------+-------+
+	// |  P P  |  P P  |  P P  |
+	// +-------+-------+-------+
+	// The number of domains is less than 5(MinDomains), so "global minimum" is treated as 0.
+	// In this situation, new pod with the same labelSelector cannot be scheduled,
+	// because computed skew will be 3(3 - 0) if new Pod is scheduled to any of the three zones,
+	// it will violate MaxSkew.
+	// +optional
+	minDomains?: int32 @go(MinDomains,*int32) @protobuf(5,varint,opt)
+
+	// NodeAffinityPolicy indicates how we will treat Pod's nodeAffinity/nodeSelector
+	// when calculating pod topology spread skew. Options are:
+	// - Honor: only nodes matching nodeAffinity/nodeSelector are included in the calculations.
+	// - Ignore: nodeAffinity/nodeSelector are ignored. All nodes are included in the calculations.
+	//
+	// If this value is nil, the behavior is equivalent to the Honor policy.
+	// +optional
+	nodeAffinityPolicy?: #NodeInclusionPolicy @go(NodeAffinityPolicy,*NodeInclusionPolicy) @protobuf(6,bytes,opt)
+
+	// NodeTaintsPolicy indicates how we will treat node taints when calculating
+	// pod topology spread skew. Options are:
+	// - Honor: nodes without taints, along with tainted nodes for which the incoming pod
+	// has a toleration, are included.
+	// - Ignore: node taints are ignored. All nodes are included.
+	//
+	// If this value is nil, the behavior is equivalent to the Ignore policy.
+	// +optional
+	nodeTaintsPolicy?: #NodeInclusionPolicy @go(NodeTaintsPolicy,*NodeInclusionPolicy) @protobuf(7,bytes,opt)
+
+	// MatchLabelKeys is a set of pod label keys to select the pods over which
+	// spreading will be calculated. The keys are used to lookup values from the
+	// incoming pod labels, those key-value labels are ANDed with labelSelector
+	// to select the group of existing pods over which spreading will be calculated
+	// for the incoming pod. The same key is forbidden to exist in both MatchLabelKeys and LabelSelector.
+	// MatchLabelKeys cannot be set when LabelSelector isn't set.
+	// Keys that don't exist in the incoming pod labels will
+	// be ignored. A null or empty list means only match against labelSelector.
+	//
+	// This is a beta field and requires the MatchLabelKeysInPodTopologySpread feature gate to be enabled (enabled by default).
+	// +listType=atomic
+	// +optional
+	matchLabelKeys?: [...string] @go(MatchLabelKeys,[]string) @protobuf(8,bytes,opt)
+}
+
+// The default value for enableServiceLinks attribute.
+#DefaultEnableServiceLinks: true
+
+// HostAlias holds the mapping between IP and hostnames that will be injected as an entry in the
+// pod's hosts file.
+#HostAlias: {
+	// IP address of the host file entry.
+	// +required
+	ip: string @go(IP) @protobuf(1,bytes,opt)
+
+	// Hostnames for the above IP address.
+	// +listType=atomic
+	hostnames?: [...string] @go(Hostnames,[]string) @protobuf(2,bytes,rep)
+}
+
+// PodFSGroupChangePolicy holds policies that will be used for applying fsGroup to a volume
+// when volume is mounted.
+// +enum
+#PodFSGroupChangePolicy: string // #enumPodFSGroupChangePolicy
+
+#enumPodFSGroupChangePolicy:
+	#FSGroupChangeOnRootMismatch |
+	#FSGroupChangeAlways
+
+// FSGroupChangeOnRootMismatch indicates that volume's ownership and permissions will be changed
+// only when permission and ownership of root directory does not match with expected
+// permissions on the volume. This can help shorten the time it takes to change
+// ownership and permissions of a volume.
+#FSGroupChangeOnRootMismatch: #PodFSGroupChangePolicy & "OnRootMismatch"
+
+// FSGroupChangeAlways indicates that volume's ownership and permissions
+// should always be changed whenever volume is mounted inside a Pod. This the default
+// behavior.
+#FSGroupChangeAlways: #PodFSGroupChangePolicy & "Always"
+
+// SupplementalGroupsPolicy defines how supplemental groups
+// of the first container processes are calculated.
+// +enum
+#SupplementalGroupsPolicy: string // #enumSupplementalGroupsPolicy
+
+#enumSupplementalGroupsPolicy:
+	#SupplementalGroupsPolicyMerge |
+	#SupplementalGroupsPolicyStrict
+
+// SupplementalGroupsPolicyMerge means that the container's provided
+// SupplementalGroups and FsGroup (specified in SecurityContext) will be
+// merged with the primary user's groups as defined in the container image
+// (in /etc/group).
+#SupplementalGroupsPolicyMerge: #SupplementalGroupsPolicy & "Merge"
+
+// SupplementalGroupsPolicyStrict means that the container's provided
+// SupplementalGroups and FsGroup (specified in SecurityContext) will be
+// used instead of any groups defined in the container image.
+#SupplementalGroupsPolicyStrict: #SupplementalGroupsPolicy & "Strict"
+
+// PodSELinuxChangePolicy defines how the container's SELinux label is applied to all volumes used by the Pod.
+#PodSELinuxChangePolicy: string // #enumPodSELinuxChangePolicy
+
+#enumPodSELinuxChangePolicy:
+	#SELinuxChangePolicyRecursive |
+	#SELinuxChangePolicyMountOption
+
+// Recursive relabeling of all Pod volumes by the container runtime.
+// This may be slow for large volumes, but allows mixing privileged and unprivileged Pods sharing the same volume on the same node.
+#SELinuxChangePolicyRecursive: #PodSELinuxChangePolicy & "Recursive"
+
+// MountOption mounts all eligible Pod volumes with `-o context` mount option.
+// This requires all Pods that share the same volume to use the same SELinux label.
+// It is not possible to share the same volume among privileged and unprivileged Pods.
+// Eligible volumes are in-tree FibreChannel and iSCSI volumes, and all CSI volumes
+// whose CSI driver announces SELinux support by setting spec.seLinuxMount: true in their
+// CSIDriver instance. Other volumes are always re-labelled recursively.
+#SELinuxChangePolicyMountOption: #PodSELinuxChangePolicy & "MountOption"
+
+// PodSecurityContext holds pod-level security attributes and common container settings.
+// Some fields are also present in container.securityContext.  Field values of
+// container.securityContext take precedence over field values of PodSecurityContext.
+#PodSecurityContext: {
+	// The SELinux context to be applied to all containers.
+	// If unspecified, the container runtime will allocate a random SELinux context for each
+	// container.  May also be set in SecurityContext.  If set in
+	// both SecurityContext and PodSecurityContext, the value specified in SecurityContext
+	// takes precedence for that container.
+	// Note that this field cannot be set when spec.os.name is windows.
+	// +optional
+	seLinuxOptions?: #SELinuxOptions @go(SELinuxOptions,*SELinuxOptions) @protobuf(1,bytes,opt)
+
+	// The Windows specific settings applied to all containers.
+	// If unspecified, the options within a container's SecurityContext will be used.
+	// If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence.
+	// Note that this field cannot be set when spec.os.name is linux.
+	// +optional
+	windowsOptions?: #WindowsSecurityContextOptions @go(WindowsOptions,*WindowsSecurityContextOptions) @protobuf(8,bytes,opt)
+
+	// The UID to run the entrypoint of the container process.
+	// Defaults to user specified in image metadata if unspecified.
+	// May also be set in SecurityContext.  If set in both SecurityContext and
+	// PodSecurityContext, the value specified in SecurityContext takes precedence
+	// for that container.
+	// Note that this field cannot be set when spec.os.name is windows.
+	// +optional
+	runAsUser?: int64 @go(RunAsUser,*int64) @protobuf(2,varint,opt)
+
+	// The GID to run the entrypoint of the container process.
+	// Uses runtime default if unset.
+	// May also be set in SecurityContext.  If set in both SecurityContext and
+	// PodSecurityContext, the value specified in SecurityContext takes precedence
+	// for that container.
+	// Note that this field cannot be set when spec.os.name is windows.
+	// +optional
+	runAsGroup?: int64 @go(RunAsGroup,*int64) @protobuf(6,varint,opt)
+
+	// Indicates that the container must run as a non-root user.
+	// If true, the Kubelet will validate the image at runtime to ensure that it
+	// does not run as UID 0 (root) and fail to start the container if it does.
+	// If unset or false, no such validation will be performed.
+	// May also be set in SecurityContext.  If set in both SecurityContext and
+	// PodSecurityContext, the value specified in SecurityContext takes precedence.
+	// +optional
+	runAsNonRoot?: bool @go(RunAsNonRoot,*bool) @protobuf(3,varint,opt)
+
+	// A list of groups applied to the first process run in each container, in
+	// addition to the container's primary GID and fsGroup (if specified).  If
+	// the SupplementalGroupsPolicy feature is enabled, the
+	// supplementalGroupsPolicy field determines whether these are in addition
+	// to or instead of any group memberships defined in the container image.
+	// If unspecified, no additional groups are added, though group memberships
+	// defined in the container image may still be used, depending on the
+	// supplementalGroupsPolicy field.
+	// Note that this field cannot be set when spec.os.name is windows.
+	// +optional
+	// +listType=atomic
+	supplementalGroups?: [...int64] @go(SupplementalGroups,[]int64) @protobuf(4,varint,rep)
+
+	// Defines how supplemental groups of the first container processes are calculated.
+	// Valid values are "Merge" and "Strict". If not specified, "Merge" is used.
+	// (Alpha) Using the field requires the SupplementalGroupsPolicy feature gate to be enabled
+	// and the container runtime must implement support for this feature.
+	// Note that this field cannot be set when spec.os.name is windows.
+	// TODO: update the default value to "Merge" when spec.os.name is not windows in v1.34
+	// +featureGate=SupplementalGroupsPolicy
+	// +optional
+	supplementalGroupsPolicy?: #SupplementalGroupsPolicy @go(SupplementalGroupsPolicy,*SupplementalGroupsPolicy) @protobuf(12,bytes,opt)
+
+	// A special supplemental group that applies to all containers in a pod.
+	// Some volume types allow the Kubelet to change the ownership of that volume
+	// to be owned by the pod:
+	//
+	// 1. The owning GID will be the FSGroup
+	// 2. The setgid bit is set (new files created in the volume will be owned by FSGroup)
+	// 3. The permission bits are OR'd with rw-rw----
+	//
+	// If unset, the Kubelet will not modify the ownership and permissions of any volume.
+	// Note that this field cannot be set when spec.os.name is windows.
+	// +optional
+	fsGroup?: int64 @go(FSGroup,*int64) @protobuf(5,varint,opt)
+
+	// Sysctls hold a list of namespaced sysctls used for the pod. Pods with unsupported
+	// sysctls (by the container runtime) might fail to launch.
+	// Note that this field cannot be set when spec.os.name is windows.
+	// +optional
+	// +listType=atomic
+	sysctls?: [...#Sysctl] @go(Sysctls,[]Sysctl) @protobuf(7,bytes,rep)
+
+	// fsGroupChangePolicy defines behavior of changing ownership and permission of the volume
+	// before being exposed inside Pod. This field will only apply to
+	// volume types which support fsGroup based ownership(and permissions).
+	// It will have no effect on ephemeral volume types such as: secret, configmaps
+	// and emptydir.
+	// Valid values are "OnRootMismatch" and "Always". If not specified, "Always" is used.
+	// Note that this field cannot be set when spec.os.name is windows.
+	// +optional
+	fsGroupChangePolicy?: #PodFSGroupChangePolicy @go(FSGroupChangePolicy,*PodFSGroupChangePolicy) @protobuf(9,bytes,opt)
+
+	// The seccomp options to use by the containers in this pod.
+	// Note that this field cannot be set when spec.os.name is windows.
+	// +optional
+	seccompProfile?: #SeccompProfile @go(SeccompProfile,*SeccompProfile) @protobuf(10,bytes,opt)
+
+	// appArmorProfile is the AppArmor options to use by the containers in this pod.
+	// Note that this field cannot be set when spec.os.name is windows.
+	// +optional
+	appArmorProfile?: #AppArmorProfile @go(AppArmorProfile,*AppArmorProfile) @protobuf(11,bytes,opt)
+
+	// seLinuxChangePolicy defines how the container's SELinux label is applied to all volumes used by the Pod.
+	// It has no effect on nodes that do not support SELinux or to volumes does not support SELinux.
+	// Valid values are "MountOption" and "Recursive".
+	//
+	// "Recursive" means relabeling of all files on all Pod volumes by the container runtime.
+	// This may be slow for large volumes, but allows mixing privileged and unprivileged Pods sharing the same volume on the same node.
+	//
+	// "MountOption" mounts all eligible Pod volumes with `-o context` mount option.
+	// This requires all Pods that share the same volume to use the same SELinux label.
+	// It is not possible to share the same volume among privileged and unprivileged Pods.
+	// Eligible volumes are in-tree FibreChannel and iSCSI volumes, and all CSI volumes
+	// whose CSI driver announces SELinux support by setting spec.seLinuxMount: true in their
+	// CSIDriver instance. Other volumes are always re-labelled recursively.
+	// "MountOption" value is allowed only when SELinuxMount feature gate is enabled.
+	//
+	// If not specified and SELinuxMount feature gate is enabled, "MountOption" is used.
+	// If not specified and SELinuxMount feature gate is disabled, "MountOption" is used for ReadWriteOncePod volumes
+	// and "Recursive" for all other volumes.
+	//
+	// This field affects only Pods that have SELinux label set, either in PodSecurityContext or in SecurityContext of all containers.
+	//
+	// All Pods that use the same volume should use the same seLinuxChangePolicy, otherwise some pods can get stuck in ContainerCreating state.
+	// Note that this field cannot be set when spec.os.name is windows.
+	// +featureGate=SELinuxChangePolicy
+	// +optional
+	seLinuxChangePolicy?: #PodSELinuxChangePolicy @go(SELinuxChangePolicy,*PodSELinuxChangePolicy) @protobuf(13,bytes,opt)
+}
+
+// SeccompProfile defines a pod/container's seccomp profile settings.
+// Only one profile source may be set.
+// +union
+#SeccompProfile: {
+	// type indicates which kind of seccomp profile will be applied.
+	// Valid options are:
+	//
+	// Localhost - a profile defined in a file on the node should be used.
+	// RuntimeDefault - the container runtime default profile should be used.
+	// Unconfined - no profile should be applied.
+	// +unionDiscriminator
+	type: #SeccompProfileType @go(Type) @protobuf(1,bytes,opt,casttype=SeccompProfileType)
+
+	// localhostProfile indicates a profile defined in a file on the node should be used.
+	// The profile must be preconfigured on the node to work.
+	// Must be a descending path, relative to the kubelet's configured seccomp profile location.
+	// Must be set if type is "Localhost". Must NOT be set for any other type.
+	// +optional
+	localhostProfile?: string @go(LocalhostProfile,*string) @protobuf(2,bytes,opt)
+}
+
+// SeccompProfileType defines the supported seccomp profile types.
+// +enum
+#SeccompProfileType: string // #enumSeccompProfileType
+
+#enumSeccompProfileType:
+	#SeccompProfileTypeUnconfined |
+	#SeccompProfileTypeRuntimeDefault |
+	#SeccompProfileTypeLocalhost
+
+// SeccompProfileTypeUnconfined indicates no seccomp profile is applied (A.K.A. unconfined).
+#SeccompProfileTypeUnconfined: #SeccompProfileType & "Unconfined"
+
+// SeccompProfileTypeRuntimeDefault represents the default container runtime seccomp profile.
+#SeccompProfileTypeRuntimeDefault: #SeccompProfileType & "RuntimeDefault"
+
+// SeccompProfileTypeLocalhost indicates a profile defined in a file on the node should be used.
+// The file's location relative to <kubelet-root-dir>/seccomp.
+#SeccompProfileTypeLocalhost: #SeccompProfileType & "Localhost"
+
+// AppArmorProfile defines a pod or container's AppArmor settings.
+// +union
+#AppArmorProfile: {
+	// type indicates which kind of AppArmor profile will be applied.
+	// Valid options are:
+	//   Localhost - a profile pre-loaded on the node.
+	//   RuntimeDefault - the container runtime's default profile.
+	//   Unconfined - no AppArmor enforcement.
+	// +unionDiscriminator
+	type: #AppArmorProfileType @go(Type) @protobuf(1,bytes,opt,casttype=AppArmorProfileType)
+
+	// localhostProfile indicates a profile loaded on the node that should be used.
+	// The profile must be preconfigured on the node to work.
+	// Must match the loaded name of the profile.
+	// Must be set if and only if type is "Localhost".
+	// +optional
+	localhostProfile?: string @go(LocalhostProfile,*string) @protobuf(2,bytes,opt)
+}
+
+// +enum
+#AppArmorProfileType: string // #enumAppArmorProfileType
+
+#enumAppArmorProfileType:
+	#AppArmorProfileTypeUnconfined |
+	#AppArmorProfileTypeRuntimeDefault |
+	#AppArmorProfileTypeLocalhost
+
+// AppArmorProfileTypeUnconfined indicates that no AppArmor profile should be enforced.
+#AppArmorProfileTypeUnconfined: #AppArmorProfileType & "Unconfined"
+
+// AppArmorProfileTypeRuntimeDefault indicates that the container runtime's default AppArmor
+// profile should be used.
+#AppArmorProfileTypeRuntimeDefault: #AppArmorProfileType & "RuntimeDefault"
+
+// AppArmorProfileTypeLocalhost indicates that a profile pre-loaded on the node should be used.
+#AppArmorProfileTypeLocalhost: #AppArmorProfileType & "Localhost"
+
+// PodQOSClass defines the supported qos classes of Pods.
+// +enum
+#PodQOSClass: string // #enumPodQOSClass
+
+#enumPodQOSClass:
+	#PodQOSGuaranteed |
+	#PodQOSBurstable |
+	#PodQOSBestEffort
+
+// PodQOSGuaranteed is the Guaranteed qos class.
+#PodQOSGuaranteed: #PodQOSClass & "Guaranteed"
+
+// PodQOSBurstable is the Burstable qos class.
+#PodQOSBurstable: #PodQOSClass & "Burstable"
+
+// PodQOSBestEffort is the BestEffort qos class.
+#PodQOSBestEffort: #PodQOSClass & "BestEffort"
+
+// PodDNSConfig defines the DNS parameters of a pod in addition to
+// those generated from DNSPolicy.
+#PodDNSConfig: {
+	// A list of DNS name server IP addresses.
+	// This will be appended to the base nameservers generated from DNSPolicy.
+	// Duplicated nameservers will be removed.
+	// +optional
+	// +listType=atomic
+	nameservers?: [...string] @go(Nameservers,[]string) @protobuf(1,bytes,rep)
+
+	// A list of DNS search domains for host-name lookup.
+	// This will be appended to the base search paths generated from DNSPolicy.
+	// Duplicated search paths will be removed.
+	// +optional
+	// +listType=atomic
+	searches?: [...string] @go(Searches,[]string) @protobuf(2,bytes,rep)
+
+	// A list of DNS resolver options.
+	// This will be merged with the base options generated from DNSPolicy.
+	// Duplicated entries will be removed. Resolution options given in Options
+	// will override those that appear in the base DNSPolicy.
+	// +optional
+	// +listType=atomic
+	options?: [...#PodDNSConfigOption] @go(Options,[]PodDNSConfigOption) @protobuf(3,bytes,rep)
+}
+
+// PodDNSConfigOption defines DNS resolver options of a pod.
+#PodDNSConfigOption: {
+	// Name is this DNS resolver option's name.
+	// Required.
+	name?: string @go(Name) @protobuf(1,bytes,opt)
+
+	// Value is this DNS resolver option's value.
+	// +optional
+	value?: string @go(Value,*string) @protobuf(2,bytes,opt)
+}
+
+// PodIP represents a single IP address allocated to the pod.
+#PodIP: {
+	// IP is the IP address assigned to the pod
+	// +required
+	ip: string @go(IP) @protobuf(1,bytes,opt)
+}
+
+// HostIP represents a single IP address allocated to the host.
+#HostIP: {
+	// IP is the IP address assigned to the host
+	// +required
+	ip: string @go(IP) @protobuf(1,bytes,opt)
+}
+
+// EphemeralContainerCommon is a copy of all fields in Container to be inlined in
+// EphemeralContainer. This separate type allows easy conversion from EphemeralContainer
+// to Container and allows separate documentation for the fields of EphemeralContainer.
+// When a new field is added to Container it must be added here as well.
+#EphemeralContainerCommon: {
+	// Name of the ephemeral container specified as a DNS_LABEL.
+	// This name must be unique among all containers, init containers and ephemeral containers.
+	name: string @go(Name) @protobuf(1,bytes,opt)
+
+	// Container image name.
+	// More info: https://kubernetes.io/docs/concepts/containers/images
+	image?: string @go(Image) @protobuf(2,bytes,opt)
+
+	// Entrypoint array. Not executed within a shell.
+	// The image's ENTRYPOINT is used if this is not provided.
+	// Variable references $(VAR_NAME) are expanded using the container's environment. If a variable
+	// cannot be resolved, the reference in the input string will be unchanged. Double $$ are reduced
+	// to a single $, which allows for escaping the $(VAR_NAME) syntax: i.e. "$$(VAR_NAME)" will
+	// produce the string literal "$(VAR_NAME)". Escaped references will never be expanded, regardless
+	// of whether the variable exists or not. Cannot be updated.
+	// More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell
+	// +optional
+	// +listType=atomic
+	command?: [...string] @go(Command,[]string) @protobuf(3,bytes,rep)
+
+	// Arguments to the entrypoint.
+	// The image's CMD is used if this is not provided.
+	// Variable references $(VAR_NAME) are expanded using the container's environment. If a variable
+	// cannot be resolved, the reference in the input string will be unchanged. Double $$ are reduced
+	// to a single $, which allows for escaping the $(VAR_NAME) syntax: i.e. "$$(VAR_NAME)" will
+	// produce the string literal "$(VAR_NAME)". Escaped references will never be expanded, regardless
+	// of whether the variable exists or not. Cannot be updated.
+	// More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell
+	// +optional
+	// +listType=atomic
+	args?: [...string] @go(Args,[]string) @protobuf(4,bytes,rep)
+
+	// Container's working directory.
+	// If not specified, the container runtime's default will be used, which
+	// might be configured in the container image.
+	// Cannot be updated.
+	// +optional
+	workingDir?: string @go(WorkingDir) @protobuf(5,bytes,opt)
+
+	// Ports are not allowed for ephemeral containers.
+	// +optional
+	// +patchMergeKey=containerPort
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=containerPort
+	// +listMapKey=protocol
+	ports?: [...#ContainerPort] @go(Ports,[]ContainerPort) @protobuf(6,bytes,rep)
+
+	// List of sources to populate environment variables in the container.
+	// The keys defined within a source may consist of any printable ASCII characters except '='.
+	// When a key exists in multiple
+	// sources, the value associated with the last source will take precedence.
+	// Values defined by an Env with a duplicate key will take precedence.
+	// Cannot be updated.
+	// +optional
+	// +listType=atomic
+	envFrom?: [...#EnvFromSource] @go(EnvFrom,[]EnvFromSource) @protobuf(19,bytes,rep)
+
+	// List of environment variables to set in the container.
+	// Cannot be updated.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=name
+	env?: [...#EnvVar] @go(Env,[]EnvVar) @protobuf(7,bytes,rep)
+
+	// Resources are not allowed for ephemeral containers. Ephemeral containers use spare resources
+	// already allocated to the pod.
+	// +optional
+	resources?: #ResourceRequirements @go(Resources) @protobuf(8,bytes,opt)
+
+	// Resources resize policy for the container.
+	// +featureGate=InPlacePodVerticalScaling
+	// +optional
+	// +listType=atomic
+	resizePolicy?: [...#ContainerResizePolicy] @go(ResizePolicy,[]ContainerResizePolicy) @protobuf(23,bytes,rep)
+
+	// Restart policy for the container to manage the restart behavior of each
+	// container within a pod.
+	// You cannot set this field on ephemeral containers.
+	// +optional
+	restartPolicy?: #ContainerRestartPolicy @go(RestartPolicy,*ContainerRestartPolicy) @protobuf(24,bytes,opt,casttype=ContainerRestartPolicy)
+
+	// Represents a list of rules to be checked to determine if the
+	// container should be restarted on exit. You cannot set this field on
+	// ephemeral containers.
+	// +featureGate=ContainerRestartRules
+	// +optional
+	// +listType=atomic
+	restartPolicyRules?: [...#ContainerRestartRule] @go(RestartPolicyRules,[]ContainerRestartRule) @protobuf(25,bytes,rep)
+
+	// Pod volumes to mount into the container's filesystem. Subpath mounts are not allowed for ephemeral containers.
+	// Cannot be updated.
+	// +optional
+	// +patchMergeKey=mountPath
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=mountPath
+	volumeMounts?: [...#VolumeMount] @go(VolumeMounts,[]VolumeMount) @protobuf(9,bytes,rep)
+
+	// volumeDevices is the list of block devices to be used by the container.
+	// +patchMergeKey=devicePath
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=devicePath
+	// +optional
+	volumeDevices?: [...#VolumeDevice] @go(VolumeDevices,[]VolumeDevice) @protobuf(21,bytes,rep)
+
+	// Probes are not allowed for ephemeral containers.
+	// +optional
+	livenessProbe?: #Probe @go(LivenessProbe,*Probe) @protobuf(10,bytes,opt)
+
+	// Probes are not allowed for ephemeral containers.
+	// +optional
+	readinessProbe?: #Probe @go(ReadinessProbe,*Probe) @protobuf(11,bytes,opt)
+
+	// Probes are not allowed for ephemeral containers.
+	// +optional
+	startupProbe?: #Probe @go(StartupProbe,*Probe) @protobuf(22,bytes,opt)
+
+	// Lifecycle is not allowed for ephemeral containers.
+	// +optional
+	lifecycle?: #Lifecycle @go(Lifecycle,*Lifecycle) @protobuf(12,bytes,opt)
+
+	// Optional: Path at which the file to which the container's termination message
+	// will be written is mounted into the container's filesystem.
+	// Message written is intended to be brief final status, such as an assertion failure message.
+	// Will be truncated by the node if greater than 4096 bytes. The total message length across
+	// all containers will be limited to 12kb.
+	// Defaults to /dev/termination-log.
+	// Cannot be updated.
+	// +optional
+	terminationMessagePath?: string @go(TerminationMessagePath) @protobuf(13,bytes,opt)
+
+	// Indicate how the termination message should be populated. File will use the contents of
+	// terminationMessagePath to populate the container status message on both success and failure.
+	// FallbackToLogsOnError will use the last chunk of container log output if the termination
+	// message file is empty and the container exited with an error.
+	// The log output is limited to 2048 bytes or 80 lines, whichever is smaller.
+	// Defaults to File.
+	// Cannot be updated.
+	// +optional
+	terminationMessagePolicy?: #TerminationMessagePolicy @go(TerminationMessagePolicy) @protobuf(20,bytes,opt,casttype=TerminationMessagePolicy)
+
+	// Image pull policy.
+	// One of Always, Never, IfNotPresent.
+	// Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
+	// Cannot be updated.
+	// More info: https://kubernetes.io/docs/concepts/containers/images#updating-images
+	// +optional
+	imagePullPolicy?: #PullPolicy @go(ImagePullPolicy) @protobuf(14,bytes,opt,casttype=PullPolicy)
+
+	// Optional: SecurityContext defines the security options the ephemeral container should be run with.
+	// If set, the fields of SecurityContext override the equivalent fields of PodSecurityContext.
+	// +optional
+	securityContext?: #SecurityContext @go(SecurityContext,*SecurityContext) @protobuf(15,bytes,opt)
+
+	// Whether this container should allocate a buffer for stdin in the container runtime. If this
+	// is not set, reads from stdin in the container will always result in EOF.
+	// Default is false.
+	// +optional
+	stdin?: bool @go(Stdin) @protobuf(16,varint,opt)
+
+	// Whether the container runtime should close the stdin channel after it has been opened by
+	// a single attach. When stdin is true the stdin stream will remain open across multiple attach
+	// sessions. If stdinOnce is set to true, stdin is opened on container start, is empty until the
+	// first client attaches to stdin, and then remains open and accepts data until the client disconnects,
+	// at which time stdin is closed and remains closed until the container is restarted. If this
+	// flag is false, a container processes that reads from stdin will never receive an EOF.
+	// Default is false
+	// +optional
+	stdinOnce?: bool @go(StdinOnce) @protobuf(17,varint,opt)
+
+	// Whether this container should allocate a TTY for itself, also requires 'stdin' to be true.
+	// Default is false.
+	// +optional
+	tty?: bool @go(TTY) @protobuf(18,varint,opt)
+}
+
+// An EphemeralContainer is a temporary container that you may add to an existing Pod for
+// user-initiated activities such as debugging. Ephemeral containers have no resource or
+// scheduling guarantees, and they will not be restarted when they exit or when a Pod is
+// removed or restarted. The kubelet may evict a Pod if an ephemeral container causes the
+// Pod to exceed its resource allocation.
+//
+// To add an ephemeral container, use the ephemeralcontainers subresource of an existing
+// Pod. Ephemeral containers may not be removed or restarted.
+#EphemeralContainer: {
+	#EphemeralContainerCommon
+
+	// If set, the name of the container from PodSpec that this ephemeral container targets.
+	// The ephemeral container will be run in the namespaces (IPC, PID, etc) of this container.
+	// If not set then the ephemeral container uses the namespaces configured in the Pod spec.
+	//
+	// The container runtime must implement support for this feature. If the runtime does not
+	// support namespace targeting then the result of setting this field is undefined.
+	// +optional
+	targetContainerName?: string @go(TargetContainerName) @protobuf(2,bytes,opt)
+}
+
+// PodStatus represents information about the status of a pod. Status may trail the actual
+// state of a system, especially if the node that hosts the pod cannot contact the control
+// plane.
+#PodStatus: {
+	// If set, this represents the .metadata.generation that the pod status was set based upon.
+	// The PodObservedGenerationTracking feature gate must be enabled to use this field.
+	// +optional
+	observedGeneration?: int64 @go(ObservedGeneration) @protobuf(17,varint,opt)
+
+	// The phase of a Pod is a simple, high-level summary of where the Pod is in its lifecycle.
+	// The conditions array, the reason and message fields, and the individual container status
+	// arrays contain more detail about the pod's status.
+	// There are five possible phase values:
+	//
+	// Pending: The pod has been accepted by the Kubernetes system, but one or more of the
+	// container images has not been created. This includes time before being scheduled as
+	// well as time spent downloading images over the network, which could take a while.
+	// Running: The pod has been bound to a node, and all of the containers have been created.
+	// At least one container is still running, or is in the process of starting or restarting.
+	// Succeeded: All containers in the pod have terminated in success, and will not be restarted.
+	// Failed: All containers in the pod have terminated, and at least one container has
+	// terminated in failure. The container either exited with non-zero status or was terminated
+	// by the system.
+	// Unknown: For some reason the state of the pod could not be obtained, typically due to an
+	// error in communicating with the host of the pod.
+	//
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#pod-phase
+	// +optional
+	phase?: #PodPhase @go(Phase) @protobuf(1,bytes,opt,casttype=PodPhase)
+
+	// Current service state of pod.
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#pod-conditions
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	conditions?: [...#PodCondition] @go(Conditions,[]PodCondition) @protobuf(2,bytes,rep)
+
+	// A human readable message indicating details about why the pod is in this condition.
+	// +optional
+	message?: string @go(Message) @protobuf(3,bytes,opt)
+
+	// A brief CamelCase message indicating details about why the pod is in this state.
+	// e.g. 'Evicted'
+	// +optional
+	reason?: string @go(Reason) @protobuf(4,bytes,opt)
+
+	// nominatedNodeName is set only when this pod preempts other pods on the node, but it cannot be
+	// scheduled right away as preemption victims receive their graceful termination periods.
+	// This field does not guarantee that the pod will be scheduled on this node. Scheduler may decide
+	// to place the pod elsewhere if other nodes become available sooner. Scheduler may also decide to
+	// give the resources on this node to a higher priority pod that is created after preemption.
+	// As a result, this field may be different than PodSpec.nodeName when the pod is
+	// scheduled.
+	// +optional
+	nominatedNodeName?: string @go(NominatedNodeName) @protobuf(11,bytes,opt)
+
+	// hostIP holds the IP address of the host to which the pod is assigned. Empty if the pod has not started yet.
+	// A pod can be assigned to a node that has a problem in kubelet which in turns mean that HostIP will
+	// not be updated even if there is a node is assigned to pod
+	// +optional
+	hostIP?: string @go(HostIP) @protobuf(5,bytes,opt)
+
+	// hostIPs holds the IP addresses allocated to the host. If this field is specified, the first entry must
+	// match the hostIP field. This list is empty if the pod has not started yet.
+	// A pod can be assigned to a node that has a problem in kubelet which in turns means that HostIPs will
+	// not be updated even if there is a node is assigned to this pod.
+	// +optional
+	// +patchStrategy=merge
+	// +patchMergeKey=ip
+	// +listType=atomic
+	hostIPs?: [...#HostIP] @go(HostIPs,[]HostIP) @protobuf(16,bytes,rep)
+
+	// podIP address allocated to the pod. Routable at least within the cluster.
+	// Empty if not yet allocated.
+	// +optional
+	podIP?: string @go(PodIP) @protobuf(6,bytes,opt)
+
+	// podIPs holds the IP addresses allocated to the pod. If this field is specified, the 0th entry must
+	// match the podIP field. Pods may be allocated at most 1 value for each of IPv4 and IPv6. This list
+	// is empty if no IPs have been allocated yet.
+	// +optional
+	// +patchStrategy=merge
+	// +patchMergeKey=ip
+	// +listType=map
+	// +listMapKey=ip
+	podIPs?: [...#PodIP] @go(PodIPs,[]PodIP) @protobuf(12,bytes,rep)
+
+	// RFC 3339 date and time at which the object was acknowledged by the Kubelet.
+	// This is before the Kubelet pulled the container image(s) for the pod.
+	// +optional
+	startTime?: metav1.#Time @go(StartTime,*metav1.Time) @protobuf(7,bytes,opt)
+
+	// Statuses of init containers in this pod. The most recent successful non-restartable
+	// init container will have ready = true, the most recently started container will have
+	// startTime set.
+	// Each init container in the pod should have at most one status in this list,
+	// and all statuses should be for containers in the pod.
+	// However this is not enforced.
+	// If a status for a non-existent container is present in the list, or the list has duplicate names,
+	// the behavior of various Kubernetes components is not defined and those statuses might be
+	// ignored.
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#pod-and-container-status
+	// +listType=atomic
+	initContainerStatuses?: [...#ContainerStatus] @go(InitContainerStatuses,[]ContainerStatus) @protobuf(10,bytes,rep)
+
+	// Statuses of containers in this pod.
+	// Each container in the pod should have at most one status in this list,
+	// and all statuses should be for containers in the pod.
+	// However this is not enforced.
+	// If a status for a non-existent container is present in the list, or the list has duplicate names,
+	// the behavior of various Kubernetes components is not defined and those statuses might be
+	// ignored.
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#pod-and-container-status
+	// +optional
+	// +listType=atomic
+	containerStatuses?: [...#ContainerStatus] @go(ContainerStatuses,[]ContainerStatus) @protobuf(8,bytes,rep)
+
+	// The Quality of Service (QOS) classification assigned to the pod based on resource requirements
+	// See PodQOSClass type for available QOS classes
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-qos/#quality-of-service-classes
+	// +optional
+	qosClass?: #PodQOSClass @go(QOSClass) @protobuf(9,bytes,rep)
+
+	// Statuses for any ephemeral containers that have run in this pod.
+	// Each ephemeral container in the pod should have at most one status in this list,
+	// and all statuses should be for containers in the pod.
+	// However this is not enforced.
+	// If a status for a non-existent container is present in the list, or the list has duplicate names,
+	// the behavior of various Kubernetes components is not defined and those statuses might be
+	// ignored.
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#pod-and-container-status
+	// +optional
+	// +listType=atomic
+	ephemeralContainerStatuses?: [...#ContainerStatus] @go(EphemeralContainerStatuses,[]ContainerStatus) @protobuf(13,bytes,rep)
+
+	// Status of resources resize desired for pod's containers.
+	// It is empty if no resources resize is pending.
+	// Any changes to container resources will automatically set this to "Proposed"
+	// Deprecated: Resize status is moved to two pod conditions PodResizePending and PodResizeInProgress.
+	// PodResizePending will track states where the spec has been resized, but the Kubelet has not yet allocated the resources.
+	// PodResizeInProgress will track in-progress resizes, and should be present whenever allocated resources != acknowledged resources.
+	// +featureGate=InPlacePodVerticalScaling
+	// +optional
+	resize?: #PodResizeStatus @go(Resize) @protobuf(14,bytes,opt,casttype=PodResizeStatus)
+
+	// Status of resource claims.
+	// +patchMergeKey=name
+	// +patchStrategy=merge,retainKeys
+	// +listType=map
+	// +listMapKey=name
+	// +featureGate=DynamicResourceAllocation
+	// +optional
+	resourceClaimStatuses?: [...#PodResourceClaimStatus] @go(ResourceClaimStatuses,[]PodResourceClaimStatus) @protobuf(15,bytes,rep)
+
+	// Status of extended resource claim backed by DRA.
+	// +featureGate=DRAExtendedResource
+	// +optional
+	extendedResourceClaimStatus?: #PodExtendedResourceClaimStatus @go(ExtendedResourceClaimStatus,*PodExtendedResourceClaimStatus) @protobuf(18,bytes,opt)
+
+	// AllocatedResources is the total requests allocated for this pod by the node.
+	// If pod-level requests are not set, this will be the total requests aggregated
+	// across containers in the pod.
+	// +featureGate=InPlacePodLevelResourcesVerticalScaling
+	// +optional
+	allocatedResources?: #ResourceList @go(AllocatedResources) @protobuf(19,bytes,rep,casttype=ResourceList,castkey=ResourceName)
+
+	// Resources represents the compute resource requests and limits that have been
+	// applied at the pod level if pod-level requests or limits are set in
+	// PodSpec.Resources
+	// +featureGate=InPlacePodLevelResourcesVerticalScaling
+	// +optional
+	resources?: #ResourceRequirements @go(Resources,*ResourceRequirements) @protobuf(20,bytes,opt)
+
+	// NodeAllocatableResourceClaimStatuses contains the status of node-allocatable resources
+	// that were allocated for this pod through DRA claims. This includes resources currently
+	// reported in v1.Node `status.allocatable` that are not extended resources
+	// (see https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/#extended-resources).
+	// Examples include "cpu", "memory", "ephemeral-storage", and hugepages.
+	// +featureGate=DRANodeAllocatableResources
+	// +optional
+	// +listType=atomic
+	nodeAllocatableResourceClaimStatuses?: [...#NodeAllocatableResourceClaimStatus] @go(NodeAllocatableResourceClaimStatuses,[]NodeAllocatableResourceClaimStatus) @protobuf(21,bytes,rep)
+}
+
+// PodStatusResult is a wrapper for PodStatus returned by kubelet that can be encode/decoded
+#PodStatusResult: {
+	metav1.#TypeMeta
+
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metadata?: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// Most recently observed status of the pod.
+	// This data may not be up to date.
+	// Populated by the system.
+	// Read-only.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	status?: #PodStatus @go(Status) @protobuf(2,bytes,opt)
+}
+
+// Pod is a collection of containers that can run on a host. This resource is created
+// by clients and scheduled onto hosts.
+#Pod: {
+	metav1.#TypeMeta
+
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metadata?: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// Specification of the desired behavior of the pod.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	spec?: #PodSpec @go(Spec) @protobuf(2,bytes,opt)
+
+	// Most recently observed status of the pod.
+	// This data may not be up to date.
+	// Populated by the system.
+	// Read-only.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	status?: #PodStatus @go(Status) @protobuf(3,bytes,opt)
+}
+
+// PodList is a list of Pods.
+#PodList: {
+	metav1.#TypeMeta
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metadata?: metav1.#ListMeta @go(ListMeta) @protobuf(1,bytes,opt)
+
+	// List of pods.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md
+	items: [...#Pod] @go(Items,[]Pod) @protobuf(2,bytes,rep)
+}
+
+// PodTemplateSpec describes the data a pod should have when created from a template
+#PodTemplateSpec: {
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metadata?: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// Specification of the desired behavior of the pod.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	spec?: #PodSpec @go(Spec) @protobuf(2,bytes,opt)
+}
+
+// PodTemplate describes a template for creating copies of a predefined pod.
+#PodTemplate: {
+	metav1.#TypeMeta
+
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metadata?: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// Template defines the pods that will be created from this pod template.
+	// https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	template?: #PodTemplateSpec @go(Template) @protobuf(2,bytes,opt)
+}
+
+// PodTemplateList is a list of PodTemplates.
+#PodTemplateList: {
+	metav1.#TypeMeta
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metadata?: metav1.#ListMeta @go(ListMeta) @protobuf(1,bytes,opt)
+
+	// List of pod templates
+	items: [...#PodTemplate] @go(Items,[]PodTemplate) @protobuf(2,bytes,rep)
+}
+
+// ReplicationControllerSpec is the specification of a replication controller.
+#ReplicationControllerSpec: {
+	// Replicas is the number of desired replicas.
+	// This is a pointer to distinguish between explicit zero and unspecified.
+	// Defaults to 1.
+	// More info: https://kubernetes.io/docs/concepts/workloads/controllers/replicationcontroller#what-is-a-replicationcontroller
+	// +optional
+	// +k8s:alpha(since: "1.36")=+k8s:optional
+	// +default=1
+	// +k8s:alpha(since: "1.36")=+k8s:minimum=0
+	replicas?: int32 @go(Replicas,*int32) @protobuf(1,varint,opt)
+
+	// Minimum number of seconds for which a newly created pod should be ready
+	// without any of its container crashing, for it to be considered available.
+	// Defaults to 0 (pod will be considered available as soon as it is ready)
+	// +optional
+	// +k8s:alpha(since: "1.36")=+k8s:optional
+	// +default=0
+	// +k8s:alpha(since: "1.36")=+k8s:minimum=0
+	minReadySeconds?: int32 @go(MinReadySeconds) @protobuf(4,varint,opt)
+
+	// Selector is a label query over pods that should match the Replicas count.
+	// If Selector is empty, it is defaulted to the labels present on the Pod template.
+	// Label keys and values that must match in order to be controlled by this replication
+	// controller, if empty defaulted to labels on Pod template.
+	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors
+	// +optional
+	// +mapType=atomic
+	selector?: {[string]: string} @go(Selector,map[string]string) @protobuf(2,bytes,rep)
+
+	// Template is the object that describes the pod that will be created if
+	// insufficient replicas are detected. This takes precedence over a TemplateRef.
+	// The only allowed template.spec.restartPolicy value is "Always".
+	// More info: https://kubernetes.io/docs/concepts/workloads/controllers/replicationcontroller#pod-template
+	// +optional
+	template?: #PodTemplateSpec @go(Template,*PodTemplateSpec) @protobuf(3,bytes,opt)
+}
+
+// ReplicationControllerStatus represents the current status of a replication
+// controller.
+#ReplicationControllerStatus: {
+	// Replicas is the most recently observed number of replicas.
+	// More info: https://kubernetes.io/docs/concepts/workloads/controllers/replicationcontroller#what-is-a-replicationcontroller
+	replicas: int32 @go(Replicas) @protobuf(1,varint,opt)
+
+	// The number of pods that have labels matching the labels of the pod template of the replication controller.
+	// +optional
+	fullyLabeledReplicas?: int32 @go(FullyLabeledReplicas) @protobuf(2,varint,opt)
+
+	// The number of ready replicas for this replication controller.
+	// +optional
+	readyReplicas?: int32 @go(ReadyReplicas) @protobuf(4,varint,opt)
+
+	// The number of available replicas (ready for at least minReadySeconds) for this replication controller.
+	// +optional
+	availableReplicas?: int32 @go(AvailableReplicas) @protobuf(5,varint,opt)
+
+	// ObservedGeneration reflects the generation of the most recently observed replication controller.
+	// +optional
+	observedGeneration?: int64 @go(ObservedGeneration) @protobuf(3,varint,opt)
+
+	// Represents the latest available observations of a replication controller's current state.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	conditions?: [...#ReplicationControllerCondition] @go(Conditions,[]ReplicationControllerCondition) @protobuf(6,bytes,rep)
+}
+
+#ReplicationControllerConditionType: string // #enumReplicationControllerConditionType
+
+#enumReplicationControllerConditionType:
+	#ReplicationControllerReplicaFailure
+
+// ReplicationControllerReplicaFailure is added in a replication controller when one of its pods
+// fails to be created due to insufficient quota, limit ranges, pod security policy, node selectors,
+// etc. or deleted due to kubelet being down or finalizers are failing.
+#ReplicationControllerReplicaFailure: #ReplicationControllerConditionType & "ReplicaFailure"
+
+// ReplicationControllerCondition describes the state of a replication controller at a certain point.
+#ReplicationControllerCondition: {
+	// Type of replication controller condition.
+	type: #ReplicationControllerConditionType @go(Type) @protobuf(1,bytes,opt,casttype=ReplicationControllerConditionType)
+
+	// Status of the condition, one of True, False, Unknown.
+	status: #ConditionStatus @go(Status) @protobuf(2,bytes,opt,casttype=ConditionStatus)
+
+	// The last time the condition transitioned from one status to another.
+	// +optional
+	lastTransitionTime?: metav1.#Time @go(LastTransitionTime) @protobuf(3,bytes,opt)
+
+	// The reason for the condition's last transition.
+	// +optional
+	reason?: string @go(Reason) @protobuf(4,bytes,opt)
+
+	// A human readable message indicating details about the transition.
+	// +optional
+	message?: string @go(Message) @protobuf(5,bytes,opt)
+}
+
+// ReplicationController represents the configuration of a replication controller.
+#ReplicationController: {
+	metav1.#TypeMeta
+
+	// If the Labels of a ReplicationController are empty, they are defaulted to
+	// be the same as the Pod(s) that the replication controller manages.
+	// Standard object's metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	// +k8s:alpha(since: "1.36")=+k8s:subfield(name)=+k8s:optional
+	// +k8s:alpha(since: "1.36")=+k8s:subfield(name)=+k8s:format=k8s-long-name
+	metadata?: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// Spec defines the specification of the desired behavior of the replication controller.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	spec?: #ReplicationControllerSpec @go(Spec) @protobuf(2,bytes,opt)
+
+	// Status is the most recently observed status of the replication controller.
+	// This data may be out of date by some window of time.
+	// Populated by the system.
+	// Read-only.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	status?: #ReplicationControllerStatus @go(Status) @protobuf(3,bytes,opt)
+}
+
+// ReplicationControllerList is a collection of replication controllers.
+#ReplicationControllerList: {
+	metav1.#TypeMeta
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metadata?: metav1.#ListMeta @go(ListMeta) @protobuf(1,bytes,opt)
+
+	// List of replication controllers.
+	// More info: https://kubernetes.io/docs/concepts/workloads/controllers/replicationcontroller
+	items: [...#ReplicationController] @go(Items,[]ReplicationController) @protobuf(2,bytes,rep)
+}
+
+// Session Affinity Type string
+// +enum
+#ServiceAffinity: string // #enumServiceAffinity
+
+#enumServiceAffinity:
+	#ServiceAffinityClientIP |
+	#ServiceAffinityNone
+
+// ServiceAffinityClientIP is the Client IP based.
+#ServiceAffinityClientIP: #ServiceAffinity & "ClientIP"
+
+// ServiceAffinityNone - no session affinity.
+#ServiceAffinityNone: #ServiceAffinity & "None"
+
+#DefaultClientIPServiceAffinitySeconds: int32 & 10800
+
+// SessionAffinityConfig represents the configurations of session affinity.
+#SessionAffinityConfig: {
+	// clientIP contains the configurations of Client IP based session affinity.
+	// +optional
+	clientIP?: #ClientIPConfig @go(ClientIP,*ClientIPConfig) @protobuf(1,bytes,opt)
+}
+
+// ClientIPConfig represents the configurations of Client IP based session affinity.
+#ClientIPConfig: {
+	// timeoutSeconds specifies the seconds of ClientIP type session sticky time.
+	// The value must be >0 && <=86400(for 1 day) if ServiceAffinity == "ClientIP".
+	// Default value is 10800(for 3 hours).
+	// +optional
+	timeoutSeconds?: int32 @go(TimeoutSeconds,*int32) @protobuf(1,varint,opt)
+}
+
+// Service Type string describes ingress methods for a service
+// +enum
+#ServiceType: string // #enumServiceType
+
+#enumServiceType:
+	#ServiceTypeClusterIP |
+	#ServiceTypeNodePort |
+	#ServiceTypeLoadBalancer |
+	#ServiceTypeExternalName
+
+// ServiceTypeClusterIP means a service will only be accessible inside the
+// cluster, via the cluster IP.
+#ServiceTypeClusterIP: #ServiceType & "ClusterIP"
+
+// ServiceTypeNodePort means a service will be exposed on one port of
+// every node, in addition to 'ClusterIP' type.
+#ServiceTypeNodePort: #ServiceType & "NodePort"
+
+// ServiceTypeLoadBalancer means a service will be exposed via an
+// external load balancer (if the cloud provider supports it), in addition
+// to 'NodePort' type.
+#ServiceTypeLoadBalancer: #ServiceType & "LoadBalancer"
+
+// ServiceTypeExternalName means a service consists of only a reference to
+// an external name that kubedns or equivalent will return as a CNAME
+// record, with no exposing or proxying of any pods involved.
+#ServiceTypeExternalName: #ServiceType & "ExternalName"
+
+// ServiceInternalTrafficPolicy describes how nodes distribute service traffic they
+// receive on the ClusterIP.
+// +enum
+#ServiceInternalTrafficPolicy: string // #enumServiceInternalTrafficPolicy
+
+#enumServiceInternalTrafficPolicy:
+	#ServiceInternalTrafficPolicyCluster |
+	#ServiceInternalTrafficPolicyLocal
+
+// ServiceInternalTrafficPolicyCluster routes traffic to all endpoints.
+#ServiceInternalTrafficPolicyCluster: #ServiceInternalTrafficPolicy & "Cluster"
+
+// ServiceInternalTrafficPolicyLocal routes traffic only to endpoints on the same
+// node as the client pod (dropping the traffic if there are no local endpoints).
+#ServiceInternalTrafficPolicyLocal: #ServiceInternalTrafficPolicy & "Local"
+
+// ServiceInternalTrafficPolicy describes how nodes distribute service traffic they
+// receive on the ClusterIP.
+// +enum
+//
+// Deprecated: use ServiceInternalTrafficPolicy instead.
+#ServiceInternalTrafficPolicyType: #ServiceInternalTrafficPolicy
+
+// ServiceExternalTrafficPolicy describes how nodes distribute service traffic they
+// receive on one of the Service's "externally-facing" addresses (NodePorts, ExternalIPs,
+// and LoadBalancer IPs.
+// +enum
+#ServiceExternalTrafficPolicy: string // #enumServiceExternalTrafficPolicy
+
+#enumServiceExternalTrafficPolicy:
+	#ServiceExternalTrafficPolicyCluster |
+	#ServiceExternalTrafficPolicyLocal |
+	#ServiceExternalTrafficPolicyTypeLocal |
+	#ServiceExternalTrafficPolicyTypeCluster
+
+// ServiceExternalTrafficPolicyCluster routes traffic to all endpoints.
+#ServiceExternalTrafficPolicyCluster: #ServiceExternalTrafficPolicy & "Cluster"
+
+// ServiceExternalTrafficPolicyLocal preserves the source IP of the traffic by
+// routing only to endpoints on the same node as the traffic was received on
+// (dropping the traffic if there are no local endpoints).
+#ServiceExternalTrafficPolicyLocal: #ServiceExternalTrafficPolicy & "Local"
+
+// ServiceExternalTrafficPolicy describes how nodes distribute service traffic they
+// receive on one of the Service's "externally-facing" addresses (NodePorts, ExternalIPs,
+// and LoadBalancer IPs.
+// +enum
+//
+// Deprecated: use ServiceExternalTrafficPolicy instead.
+#ServiceExternalTrafficPolicyType: #ServiceExternalTrafficPolicy
+
+#ServiceExternalTrafficPolicyTypeLocal:   #ServiceExternalTrafficPolicy & "Local"
+#ServiceExternalTrafficPolicyTypeCluster: #ServiceExternalTrafficPolicy & "Cluster"
+
+// ServiceTrafficDistributionPreferSameZone indicates a preference for routing
+// traffic to endpoints that are in the same zone as the client. Users should only
+// set this value if they have ensured that clients and endpoints are distributed
+// in such a way that the "same zone" preference will not result in endpoints
+// getting overloaded.
+#ServiceTrafficDistributionPreferSameZone: "PreferSameZone"
+
+// ServiceTrafficDistributionPreferSameNode indicates a preference for routing
+// traffic to endpoints that are on the same node as the client. Users should only
+// set this value if they have ensured that clients and endpoints are distributed
+// in such a way that the "same node" preference will not result in endpoints
+// getting overloaded.
+#ServiceTrafficDistributionPreferSameNode: "PreferSameNode"
+
+// ServiceTrafficDistributionPreferClose is the original name of "PreferSameZone".
+// Despite the generic-sounding name, it has exactly the same meaning as
+// "PreferSameZone".
+// Deprecated: use "PreferSameZone" instead.
+#ServiceTrafficDistributionPreferClose: "PreferClose"
+
+// LoadBalancerPortsError represents the condition of the requested ports
+// on the cloud load balancer instance.
+#LoadBalancerPortsError: "LoadBalancerPortsError"
+
+// LoadBalancerPortsErrorReason reason in ServiceStatus condition LoadBalancerPortsError
+// means the LoadBalancer was not able to be configured correctly.
+#LoadBalancerPortsErrorReason: "LoadBalancerMixedProtocolNotSupported"
+
+// ServiceStatus represents the current status of a service.
+#ServiceStatus: {
+	// LoadBalancer contains the current status of the load-balancer,
+	// if one is present.
+	// +optional
+	loadBalancer?: #LoadBalancerStatus @go(LoadBalancer) @protobuf(1,bytes,opt)
+
+	// Current service state
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	conditions?: [...metav1.#Condition] @go(Conditions,[]metav1.Condition) @protobuf(2,bytes,rep)
+}
+
+// LoadBalancerStatus represents the status of a load-balancer.
+#LoadBalancerStatus: {
+	// Ingress is a list containing ingress points for the load-balancer.
+	// Traffic intended for the service should be sent to these ingress points.
+	// +optional
+	// +listType=atomic
+	ingress?: [...#LoadBalancerIngress] @go(Ingress,[]LoadBalancerIngress) @protobuf(1,bytes,rep)
+}
+
+// LoadBalancerIngress represents the status of a load-balancer ingress point:
+// traffic intended for the service should be sent to an ingress point.
+#LoadBalancerIngress: {
+	// IP is set for load-balancer ingress points that are IP based
+	// (typically GCE or OpenStack load-balancers)
+	// +optional
+	ip?: string @go(IP) @protobuf(1,bytes,opt)
+
+	// Hostname is set for load-balancer ingress points that are DNS based
+	// (typically AWS load-balancers)
+	// +optional
+	hostname?: string @go(Hostname) @protobuf(2,bytes,opt)
+
+	// IPMode specifies how the load-balancer IP behaves, and may only be specified when the ip field is specified.
+	// Setting this to "VIP" indicates that traffic is delivered to the node with
+	// the destination set to the load-balancer's IP and port.
+	// Setting this to "Proxy" indicates that traffic is delivered to the node or pod with
+	// the destination set to the node's IP and node port or the pod's IP and port.
+	// Service implementations may use this information to adjust traffic routing.
+	// +optional
+	ipMode?: #LoadBalancerIPMode @go(IPMode,*LoadBalancerIPMode) @protobuf(3,bytes,opt)
+
+	// Ports is a list of records of service ports
+	// If used, every port defined in the service should have an entry in it
+	// +listType=atomic
+	// +optional
+	ports?: [...#PortStatus] @go(Ports,[]PortStatus) @protobuf(4,bytes,rep)
+}
+
+// IPFamily represents the IP Family (IPv4 or IPv6). This type is used
+// to express the family of an IP expressed by a type (e.g. service.spec.ipFamilies).
+// +enum
+#IPFamily: string // #enumIPFamily
+
+#enumIPFamily:
+	#IPv4Protocol |
+	#IPv6Protocol |
+	#IPFamilyUnknown
+
+// IPv4Protocol indicates that this IP is IPv4 protocol
+#IPv4Protocol: #IPFamily & "IPv4"
+
+// IPv6Protocol indicates that this IP is IPv6 protocol
+#IPv6Protocol: #IPFamily & "IPv6"
+
+// IPFamilyUnknown indicates that this IP is unknown protocol
+#IPFamilyUnknown: #IPFamily & ""
+
+// IPFamilyPolicy represents the dual-stack-ness requested or required by a Service
+// +enum
+#IPFamilyPolicy: string // #enumIPFamilyPolicy
+
+#enumIPFamilyPolicy:
+	#IPFamilyPolicySingleStack |
+	#IPFamilyPolicyPreferDualStack |
+	#IPFamilyPolicyRequireDualStack
+
+// IPFamilyPolicySingleStack indicates that this service is required to have a single IPFamily.
+// The IPFamily assigned is based on the default IPFamily used by the cluster
+// or as identified by service.spec.ipFamilies field
+#IPFamilyPolicySingleStack: #IPFamilyPolicy & "SingleStack"
+
+// IPFamilyPolicyPreferDualStack indicates that this service prefers dual-stack when
+// the cluster is configured for dual-stack. If the cluster is not configured
+// for dual-stack the service will be assigned a single IPFamily. If the IPFamily is not
+// set in service.spec.ipFamilies then the service will be assigned the default IPFamily
+// configured on the cluster
+#IPFamilyPolicyPreferDualStack: #IPFamilyPolicy & "PreferDualStack"
+
+// IPFamilyPolicyRequireDualStack indicates that this service requires dual-stack. Using
+// IPFamilyPolicyRequireDualStack on a single stack cluster will result in validation errors. The
+// IPFamilies (and their order) assigned  to this service is based on service.spec.ipFamilies. If
+// service.spec.ipFamilies was not provided then it will be assigned according to how they are
+// configured on the cluster. If service.spec.ipFamilies has only one entry then the alternative
+// IPFamily will be added by apiserver
+#IPFamilyPolicyRequireDualStack: #IPFamilyPolicy & "RequireDualStack"
+
+// IPFamilyPolicy represents the dual-stack-ness requested or required by a Service
+// +enum
+//
+// Deprecated: use IPFamilyPolicy instead.
+#IPFamilyPolicyType: #IPFamilyPolicy
+
+// ServiceSpec describes the attributes that a user creates on a service.
+#ServiceSpec: {
+	// The list of ports that are exposed by this service.
+	// More info: https://kubernetes.io/docs/concepts/services-networking/service/#virtual-ips-and-service-proxies
+	// +patchMergeKey=port
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=port
+	// +listMapKey=protocol
+	ports?: [...#ServicePort] @go(Ports,[]ServicePort) @protobuf(1,bytes,rep)
+
+	// Route service traffic to pods with label keys and values matching this
+	// selector. If empty or not present, the service is assumed to have an
+	// external process managing its endpoints, which Kubernetes will not
+	// modify. Only applies to types ClusterIP, NodePort, and LoadBalancer.
+	// Ignored if type is ExternalName.
+	// More info: https://kubernetes.io/docs/concepts/services-networking/service/
+	// +optional
+	// +mapType=atomic
+	selector?: {[string]: string} @go(Selector,map[string]string) @protobuf(2,bytes,rep)
+
+	// clusterIP is the IP address of the service and is usually assigned
+	// randomly. If an address is specified manually, is in-range (as per
+	// system configuration), and is not in use, it will be allocated to the
+	// service; otherwise creation of the service will fail. This field may not
+	// be changed through updates unless the type field is also being changed
+	// to ExternalName (which requires this field to be blank) or the type
+	// field is being changed from ExternalName (in which case this field may
+	// optionally be specified, as describe above).  Valid values are "None",
+	// empty string (""), or a valid IP address. Setting this to "None" makes a
+	// "headless service" (no virtual IP), which is useful when direct endpoint
+	// connections are preferred and proxying is not required.  Only applies to
+	// types ClusterIP, NodePort, and LoadBalancer. If this field is specified
+	// when creating a Service of type ExternalName, creation will fail. This
+	// field will be wiped when updating a Service to type ExternalName.
+	// More info: https://kubernetes.io/docs/concepts/services-networking/service/#virtual-ips-and-service-proxies
+	// +optional
+	clusterIP?: string @go(ClusterIP) @protobuf(3,bytes,opt)
+
+	// ClusterIPs is a list of IP addresses assigned to this service, and are
+	// usually assigned randomly.  If an address is specified manually, is
+	// in-range (as per system configuration), and is not in use, it will be
+	// allocated to the service; otherwise creation of the service will fail.
+	// This field may not be changed through updates unless the type field is
+	// also being changed to ExternalName (which requires this field to be
+	// empty) or the type field is being changed from ExternalName (in which
+	// case this field may optionally be specified, as describe above).  Valid
+	// values are "None", empty string (""), or a valid IP address.  Setting
+	// this to "None" makes a "headless service" (no virtual IP), which is
+	// useful when direct endpoint connections are preferred and proxying is
+	// not required.  Only applies to types ClusterIP, NodePort, and
+	// LoadBalancer. If this field is specified when creating a Service of type
+	// ExternalName, creation will fail. This field will be wiped when updating
+	// a Service to type ExternalName.  If this field is not specified, it will
+	// be initialized from the clusterIP field.  If this field is specified,
+	// clients must ensure that clusterIPs[0] and clusterIP have the same
+	// value.
+	//
+	// This field may hold a maximum of two entries (dual-stack IPs, in either order).
+	// These IPs must correspond to the values of the ipFamilies field. Both
+	// clusterIPs and ipFamilies are governed by the ipFamilyPolicy field.
+	// More info: https://kubernetes.io/docs/concepts/services-networking/service/#virtual-ips-and-service-proxies
+	// +listType=atomic
+	// +optional
+	clusterIPs?: [...string] @go(ClusterIPs,[]string) @protobuf(18,bytes,opt)
+
+	// type determines how the Service is exposed. Defaults to ClusterIP. Valid
+	// options are ExternalName, ClusterIP, NodePort, and LoadBalancer.
+	// "ClusterIP" allocates a cluster-internal IP address for load-balancing
+	// to endpoints. Endpoints are determined by the selector or if that is not
+	// specified, by manual construction of an Endpoints object or
+	// EndpointSlice objects. If clusterIP is "None", no virtual IP is
+	// allocated and the endpoints are published as a set of endpoints rather
+	// than a virtual IP.
+	// "NodePort" builds on ClusterIP and allocates a port on every node which
+	// routes to the same endpoints as the clusterIP.
+	// "LoadBalancer" builds on NodePort and creates an external load-balancer
+	// (if supported in the current cloud) which routes to the same endpoints
+	// as the clusterIP.
+	// "ExternalName" aliases this service to the specified externalName.
+	// Several other fields do not apply to ExternalName services.
+	// More info: https://kubernetes.io/docs/concepts/services-networking/service/#publishing-services-service-types
+	// +optional
+	type?: #ServiceType @go(Type) @protobuf(4,bytes,opt,casttype=ServiceType)
+
+	// externalIPs is a list of IP addresses for which nodes in the cluster
+	// will also accept traffic for this service.  These IPs are not managed by
+	// Kubernetes.  The user is responsible for ensuring that traffic arrives
+	// at a node with this IP.  A common example is external load-balancers
+	// that are not part of the Kubernetes system.
+	// +optional
+	// +listType=atomic
+	externalIPs?: [...string] @go(ExternalIPs,[]string) @protobuf(5,bytes,rep)
+
+	// Supports "ClientIP" and "None". Used to maintain session affinity.
+	// Enable client IP based session affinity.
+	// Must be ClientIP or None.
+	// Defaults to None.
+	// More info: https://kubernetes.io/docs/concepts/services-networking/service/#virtual-ips-and-service-proxies
+	// +optional
+	sessionAffinity?: #ServiceAffinity @go(SessionAffinity) @protobuf(7,bytes,opt,casttype=ServiceAffinity)
+
+	// Only applies to Service Type: LoadBalancer.
+	// This feature depends on whether the underlying cloud-provider supports specifying
+	// the loadBalancerIP when a load balancer is created.
+	// This field will be ignored if the cloud-provider does not support the feature.
+	// Deprecated: This field was under-specified and its meaning varies across implementations.
+	// Using it is non-portable and it may not support dual-stack.
+	// Users are encouraged to use implementation-specific annotations when available.
+	// +optional
+	loadBalancerIP?: string @go(LoadBalancerIP) @protobuf(8,bytes,opt)
+
+	// If specified and supported by the platform, this will restrict traffic through the cloud-provider
+	// load-balancer will be restricted to the specified client IPs. This field will be ignored if the
+	// cloud-provider does not support the feature."
+	// More info: https://kubernetes.io/docs/tasks/access-application-cluster/create-external-load-balancer/
+	// +optional
+	// +listType=atomic
+	loadBalancerSourceRanges?: [...string] @go(LoadBalancerSourceRanges,[]string) @protobuf(9,bytes,opt)
+
+	// externalName is the external reference that discovery mechanisms will
+	// return as an alias for this service (e.g. a DNS CNAME record). No
+	// proxying will be involved.  Must be a lowercase RFC-1123 hostname
+	// (https://tools.ietf.org/html/rfc1123) and requires `type` to be "ExternalName".
+	// +optional
+	externalName?: string @go(ExternalName) @protobuf(10,bytes,opt)
+
+	// externalTrafficPolicy describes how nodes distribute service traffic they
+	// receive on one of the Service's "externally-facing" addresses (NodePorts,
+	// ExternalIPs, and LoadBalancer IPs). If set to "Local", the proxy will configure
+	// the service in a way that assumes that external load balancers will take care
+	// of balancing the service traffic between nodes, and so each node will deliver
+	// traffic only to the node-local endpoints of the service, without masquerading
+	// the client source IP. (Traffic mistakenly sent to a node with no endpoints will
+	// be dropped.) The default value, "Cluster", uses the standard behavior of
+	// routing to all endpoints evenly (possibly modified by topology and other
+	// features). Note that traffic sent to an External IP or LoadBalancer IP from
+	// within the cluster will always get "Cluster" semantics, but clients sending to
+	// a NodePort from within the cluster may need to take traffic policy into account
+	// when picking a node.
+	// +optional
+	externalTrafficPolicy?: #ServiceExternalTrafficPolicy @go(ExternalTrafficPolicy) @protobuf(11,bytes,opt)
+
+	// healthCheckNodePort specifies the healthcheck nodePort for the service.
+	// This only applies when type is set to LoadBalancer and
+	// externalTrafficPolicy is set to Local. If a value is specified, is
+	// in-range, and is not in use, it will be used.  If not specified, a value
+	// will be automatically allocated.  External systems (e.g. load-balancers)
+	// can use this port to determine if a given node holds endpoints for this
+	// service or not.  If this field is specified when creating a Service
+	// which does not need it, creation will fail. This field will be wiped
+	// when updating a Service to no longer need it (e.g. changing type).
+	// This field cannot be updated once set.
+	// +optional
+	healthCheckNodePort?: int32 @go(HealthCheckNodePort) @protobuf(12,bytes,opt)
+
+	// publishNotReadyAddresses indicates that any agent which deals with endpoints for this
+	// Service should disregard any indications of ready/not-ready.
+	// The primary use case for setting this field is for a StatefulSet's Headless Service to
+	// propagate SRV DNS records for its Pods for the purpose of peer discovery.
+	// The Kubernetes controllers that generate Endpoints and EndpointSlice resources for
+	// Services interpret this to mean that all endpoints are considered "ready" even if the
+	// Pods themselves are not. Agents which consume only Kubernetes generated endpoints
+	// through the Endpoints or EndpointSlice resources can safely assume this behavior.
+	// +optional
+	publishNotReadyAddresses?: bool @go(PublishNotReadyAddresses) @protobuf(13,varint,opt)
+
+	// sessionAffinityConfig contains the configurations of session affinity.
+	// +optional
+	sessionAffinityConfig?: #SessionAffinityConfig @go(SessionAffinityConfig,*SessionAffinityConfig) @protobuf(14,bytes,opt)
+
+	// IPFamilies is a list of IP families (e.g. IPv4, IPv6) assigned to this
+	// service. This field is usually assigned automatically based on cluster
+	// configuration and the ipFamilyPolicy field. If this field is specified
+	// manually, the requested family is available in the cluster,
+	// and ipFamilyPolicy allows it, it will be used; otherwise creation of
+	// the service will fail. This field is conditionally mutable: it allows
+	// for adding or removing a secondary IP family, but it does not allow
+	// changing the primary IP family of the Service. Valid values are "IPv4"
+	// and "IPv6".  This field only applies to Services of types ClusterIP,
+	// NodePort, and LoadBalancer, and does apply to "headless" services.
+	// This field will be wiped when updating a Service to type ExternalName.
+	//
+	// This field may hold a maximum of two entries (dual-stack families, in
+	// either order).  These families must correspond to the values of the
+	// clusterIPs field, if specified. Both clusterIPs and ipFamilies are
+	// governed by the ipFamilyPolicy field.
+	// +listType=atomic
+	// +optional
+	ipFamilies?: [...#IPFamily] @go(IPFamilies,[]IPFamily) @protobuf(19,bytes,opt,casttype=IPFamily)
+
+	// IPFamilyPolicy represents the dual-stack-ness requested or required by
+	// this Service. If there is no value provided, then this field will be set
+	// to SingleStack. Services can be "SingleStack" (a single IP family),
+	// "PreferDualStack" (two IP families on dual-stack configured clusters or
+	// a single IP family on single-stack clusters), or "RequireDualStack"
+	// (two IP families on dual-stack configured clusters, otherwise fail). The
+	// ipFamilies and clusterIPs fields depend on the value of this field. This
+	// field will be wiped when updating a service to type ExternalName.
+	// +optional
+	ipFamilyPolicy?: #IPFamilyPolicy @go(IPFamilyPolicy,*IPFamilyPolicy) @protobuf(17,bytes,opt,casttype=IPFamilyPolicy)
+
+	// allocateLoadBalancerNodePorts defines if NodePorts will be automatically
+	// allocated for services with type LoadBalancer.  Default is "true". It
+	// may be set to "false" if the cluster load-balancer does not rely on
+	// NodePorts.  If the caller requests specific NodePorts (by specifying a
+	// value), those requests will be respected, regardless of this field.
+	// This field may only be set for services with type LoadBalancer and will
+	// be cleared if the type is changed to any other type.
+	// +optional
+	allocateLoadBalancerNodePorts?: bool @go(AllocateLoadBalancerNodePorts,*bool) @protobuf(20,bytes,opt)
+
+	// loadBalancerClass is the class of the load balancer implementation this Service belongs to.
+	// If specified, the value of this field must be a label-style identifier, with an optional prefix,
+	// e.g. "internal-vip" or "example.com/internal-vip". Unprefixed names are reserved for end-users.
+	// This field can only be set when the Service type is 'LoadBalancer'. If not set, the default load
+	// balancer implementation is used, today this is typically done through the cloud provider integration,
+	// but should apply for any default implementation. If set, it is assumed that a load balancer
+	// implementation is watching for Services with a matching class. Any default load balancer
+	// implementation (e.g. cloud providers) should ignore Services that set this field.
+	// This field can only be set when creating or updating a Service to type 'LoadBalancer'.
+	// Once set, it can not be changed. This field will be wiped when a service is updated to a non 'LoadBalancer' type.
+	// +optional
+	loadBalancerClass?: string @go(LoadBalancerClass,*string) @protobuf(21,bytes,opt)
+
+	// InternalTrafficPolicy describes how nodes distribute service traffic they
+	// receive on the ClusterIP. If set to "Local", the proxy will assume that pods
+	// only want to talk to endpoints of the service on the same node as the pod,
+	// dropping the traffic if there are no local endpoints. The default value,
+	// "Cluster", uses the standard behavior of routing to all endpoints evenly
+	// (possibly modified by topology and other features).
+	// +optional
+	internalTrafficPolicy?: #ServiceInternalTrafficPolicy @go(InternalTrafficPolicy,*ServiceInternalTrafficPolicy) @protobuf(22,bytes,opt)
+
+	// TrafficDistribution offers a way to express preferences for how traffic
+	// is distributed to Service endpoints. Implementations can use this field
+	// as a hint, but are not required to guarantee strict adherence. If the
+	// field is not set, the implementation will apply its default routing
+	// strategy. If set to "PreferClose", implementations should prioritize
+	// endpoints that are in the same zone.
+	// +optional
+	trafficDistribution?: string @go(TrafficDistribution,*string) @protobuf(23,bytes,opt)
+}
+
+// ServicePort contains information on service's port.
+#ServicePort: {
+	// The name of this port within the service. This must be a DNS_LABEL.
+	// All ports within a ServiceSpec must have unique names. When considering
+	// the endpoints for a Service, this must match the 'name' field in the
+	// EndpointPort.
+	// Optional if only one ServicePort is defined on this service.
+	// +optional
+	name?: string @go(Name) @protobuf(1,bytes,opt)
+
+	// The IP protocol for this port. Supports "TCP", "UDP", and "SCTP".
+	// Default is TCP.
+	// +default="TCP"
+	// +optional
+	protocol?: #Protocol @go(Protocol) @protobuf(2,bytes,opt,casttype=Protocol)
+
+	// The application protocol for this port.
+	// This is used as a hint for implementations to offer richer behavior for protocols that they understand.
+	// This field follows standard Kubernetes label syntax.
+	// Valid values are either:
+	//
+	// * Un-prefixed protocol names - reserved for IANA standard service names (as per
+	// RFC-6335 and https://www.iana.org/assignments/service-names).
+	//
+	// * Kubernetes-defined prefixed names:
+	//   * 'kubernetes.io/h2c' - HTTP/2 prior knowledge over cleartext as described in https://www.rfc-editor.org/rfc/rfc9113.html#name-starting-http-2-with-prior-
+	//   * 'kubernetes.io/ws'  - WebSocket over cleartext as described in https://www.rfc-editor.org/rfc/rfc6455
+	//   * 'kubernetes.io/wss' - WebSocket over TLS as described in https://www.rfc-editor.org/rfc/rfc6455
+	//
+	// * Other protocols should use implementation-defined prefixed names such as
+	// mycompany.com/my-custom-protocol.
+	// +optional
+	appProtocol?: string @go(AppProtocol,*string) @protobuf(6,bytes,opt)
+
+	// The port that will be exposed by this service.
+	port: int32 @go(Port) @protobuf(3,varint,opt)
+
+	// Number or name of the port to access on the pods targeted by the service.
+	// Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
+	// If this is a string, it will be looked up as a named port in the
+	// target Pod's container ports. If this is not specified, the value
+	// of the 'port' field is used (an identity map).
+	// This field is ignored for services with clusterIP=None, and should be
+	// omitted or set equal to the 'port' field.
+	// More info: https://kubernetes.io/docs/concepts/services-networking/service/#defining-a-service
+	// +optional
+	targetPort?: intstr.#IntOrString @go(TargetPort) @protobuf(4,bytes,opt)
+
+	// The port on each node on which this service is exposed when type is
+	// NodePort or LoadBalancer.  Usually assigned by the system. If a value is
+	// specified, in-range, and not in use it will be used, otherwise the
+	// operation will fail.  If not specified, a port will be allocated if this
+	// Service requires one.  If this field is specified when creating a
+	// Service which does not need it, creation will fail. This field will be
+	// wiped when updating a Service to no longer need it (e.g. changing type
+	// from NodePort to ClusterIP).
+	// More info: https://kubernetes.io/docs/concepts/services-networking/service/#type-nodeport
+	// +optional
+	nodePort?: int32 @go(NodePort) @protobuf(5,varint,opt)
+}
+
+// Service is a named abstraction of software service (for example, mysql) consisting of local port
+// (for example 3306) that the proxy listens on, and the selector that determines which pods
+// will answer requests sent through the proxy.
+#Service: {
+	metav1.#TypeMeta
+
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metadata?: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// Spec defines the behavior of a service.
+	// https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	spec?: #ServiceSpec @go(Spec) @protobuf(2,bytes,opt)
+
+	// Most recently observed status of the service.
+	// Populated by the system.
+	// Read-only.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	status?: #ServiceStatus @go(Status) @protobuf(3,bytes,opt)
+}
+
+// ClusterIPNone - do not assign a cluster IP
+// no proxying required and no environment variables should be created for pods
+#ClusterIPNone: "None"
+
+// ServiceList holds a list of services.
+#ServiceList: {
+	metav1.#TypeMeta
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metadata?: metav1.#ListMeta @go(ListMeta) @protobuf(1,bytes,opt)
+
+	// List of services
+	items: [...#Service] @go(Items,[]Service) @protobuf(2,bytes,rep)
+}
+
+// ServiceAccount binds together:
+// * a name, understood by users, and perhaps by peripheral systems, for an identity
+// * a principal that can be authenticated and authorized
+// * a set of secrets
+#ServiceAccount: {
+	metav1.#TypeMeta
+
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metadata?: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// Secrets is a list of the secrets in the same namespace that pods running using this ServiceAccount are allowed to use.
+	// Pods are only limited to this list if this service account has a "kubernetes.io/enforce-mountable-secrets" annotation set to "true".
+	// The "kubernetes.io/enforce-mountable-secrets" annotation is deprecated since v1.32.
+	// Prefer separate namespaces to isolate access to mounted secrets.
+	// This field should not be used to find auto-generated service account token secrets for use outside of pods.
+	// Instead, tokens can be requested directly using the TokenRequest API, or service account token secrets can be manually created.
+	// More info: https://kubernetes.io/docs/concepts/configuration/secret
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=name
+	secrets?: [...#ObjectReference] @go(Secrets,[]ObjectReference) @protobuf(2,bytes,rep)
+
+	// ImagePullSecrets is a list of references to secrets in the same namespace to use for pulling any images
+	// in pods that reference this ServiceAccount. ImagePullSecrets are distinct from Secrets because Secrets
+	// can be mounted in the pod, but ImagePullSecrets are only accessed by the kubelet.
+	// More info: https://kubernetes.io/docs/concepts/containers/images/#specifying-imagepullsecrets-on-a-pod
+	// +optional
+	// +listType=atomic
+	imagePullSecrets?: [...#LocalObjectReference] @go(ImagePullSecrets,[]LocalObjectReference) @protobuf(3,bytes,rep)
+
+	// AutomountServiceAccountToken indicates whether pods running as this service account should have an API token automatically mounted.
+	// Can be overridden at the pod level.
+	// +optional
+	automountServiceAccountToken?: bool @go(AutomountServiceAccountToken,*bool) @protobuf(4,varint,opt)
+}
+
+// ServiceAccountList is a list of ServiceAccount objects
+#ServiceAccountList: {
+	metav1.#TypeMeta
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metadata?: metav1.#ListMeta @go(ListMeta) @protobuf(1,bytes,opt)
+
+	// List of ServiceAccounts.
+	// More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/
+	items: [...#ServiceAccount] @go(Items,[]ServiceAccount) @protobuf(2,bytes,rep)
+}
+
+// Endpoints is a collection of endpoints that implement the actual service. Example:
+//
+//	 Name: "mysvc",
+//	 Subsets: [
+//	   {
+//	     Addresses: [{"ip": "10.10.1.1"}, {"ip": "10.10.2.2"}],
+//	     Ports: [{"name": "a", "port": 8675}, {"name": "b", "port": 309}]
+//	   },
+//	   {
+//	     Addresses: [{"ip": "10.10.3.3"}],
+//	     Ports: [{"name": "a", "port": 93}, {"name": "b", "port": 76}]
+//	   },
+//	]
+//
+// Endpoints is a legacy API and does not contain information about all Service features.
+// Use discoveryv1.EndpointSlice for complete information about Service endpoints.
+//
+// Deprecated: This API is deprecated in v1.33+. Use discoveryv1.EndpointSlice.
+#Endpoints: {
+	metav1.#TypeMeta
+
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metadata?: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// The set of all endpoints is the union of all subsets. Addresses are placed into
+	// subsets according to the IPs they share. A single address with multiple ports,
+	// some of which are ready and some of which are not (because they come from
+	// different containers) will result in the address being displayed in different
+	// subsets for the different ports. No address will appear in both Addresses and
+	// NotReadyAddresses in the same subset.
+	// Sets of addresses and ports that comprise a service.
+	// +optional
+	// +listType=atomic
+	subsets?: [...#EndpointSubset] @go(Subsets,[]EndpointSubset) @protobuf(2,bytes,rep)
+}
+
+// EndpointSubset is a group of addresses with a common set of ports. The
+// expanded set of endpoints is the Cartesian product of Addresses x Ports.
+// For example, given:
+//
+//	{
+//	  Addresses: [{"ip": "10.10.1.1"}, {"ip": "10.10.2.2"}],
+//	  Ports:     [{"name": "a", "port": 8675}, {"name": "b", "port": 309}]
+//	}
+//
+// The resulting set of endpoints can be viewed as:
+//
+//	a: [ 10.10.1.1:8675, 10.10.2.2:8675 ],
+//	b: [ 10.10.1.1:309, 10.10.2.2:309 ]
+//
+// Deprecated: This API is deprecated in v1.33+.
+#EndpointSubset: {
+	// IP addresses which offer the related ports that are marked as ready. These endpoints
+	// should be considered safe for load balancers and clients to utilize.
+	// +optional
+	// +listType=atomic
+	addresses?: [...#EndpointAddress] @go(Addresses,[]EndpointAddress) @protobuf(1,bytes,rep)
+
+	// IP addresses which offer the related ports but are not currently marked as ready
+	// because they have not yet finished starting, have recently failed a readiness check,
+	// or have recently failed a liveness check.
+	// +optional
+	// +listType=atomic
+	notReadyAddresses?: [...#EndpointAddress] @go(NotReadyAddresses,[]EndpointAddress) @protobuf(2,bytes,rep)
+
+	// Port numbers available on the related IP addresses.
+	// +optional
+	// +listType=atomic
+	ports?: [...#EndpointPort] @go(Ports,[]EndpointPort) @protobuf(3,bytes,rep)
+}
+
+// EndpointAddress is a tuple that describes single IP address.
+// Deprecated: This API is deprecated in v1.33+.
+// +structType=atomic
+#EndpointAddress: {
+	// The IP of this endpoint.
+	// May not be loopback (127.0.0.0/8 or ::1), link-local (169.254.0.0/16 or fe80::/10),
+	// or link-local multicast (224.0.0.0/24 or ff02::/16).
+	ip: string @go(IP) @protobuf(1,bytes,opt)
+
+	// The Hostname of this endpoint
+	// +optional
+	hostname?: string @go(Hostname) @protobuf(3,bytes,opt)
+
+	// Optional: Node hosting this endpoint. This can be used to determine endpoints local to a node.
+	// +optional
+	nodeName?: string @go(NodeName,*string) @protobuf(4,bytes,opt)
+
+	// Reference to object providing the endpoint.
+	// +optional
+	targetRef?: #ObjectReference @go(TargetRef,*ObjectReference) @protobuf(2,bytes,opt)
+}
+
+// EndpointPort is a tuple that describes a single port.
+// Deprecated: This API is deprecated in v1.33+.
+// +structType=atomic
+#EndpointPort: {
+	// The name of this port.  This must match the 'name' field in the
+	// corresponding ServicePort.
+	// Must be a DNS_LABEL.
+	// Optional only if one port is defined.
+	// +optional
+	name?: string @go(Name) @protobuf(1,bytes,opt)
+
+	// The port number of the endpoint.
+	port: int32 @go(Port) @protobuf(2,varint,opt)
+
+	// The IP protocol for this port.
+	// Must be UDP, TCP, or SCTP.
+	// Default is TCP.
+	// +optional
+	protocol?: #Protocol @go(Protocol) @protobuf(3,bytes,opt,casttype=Protocol)
+
+	// The application protocol for this port.
+	// This is used as a hint for implementations to offer richer behavior for protocols that they understand.
+	// This field follows standard Kubernetes label syntax.
+	// Valid values are either:
+	//
+	// * Un-prefixed protocol names - reserved for IANA standard service names (as per
+	// RFC-6335 and https://www.iana.org/assignments/service-names).
+	//
+	// * Kubernetes-defined prefixed names:
+	//   * 'kubernetes.io/h2c' - HTTP/2 prior knowledge over cleartext as described in https://www.rfc-editor.org/rfc/rfc9113.html#name-starting-http-2-with-prior-
+	//   * 'kubernetes.io/ws'  - WebSocket over cleartext as described in https://www.rfc-editor.org/rfc/rfc6455
+	//   * 'kubernetes.io/wss' - WebSocket over TLS as described in https://www.rfc-editor.org/rfc/rfc6455
+	//
+	// * Other protocols should use implementation-defined prefixed names such as
+	// mycompany.com/my-custom-protocol.
+	// +optional
+	appProtocol?: string @go(AppProtocol,*string) @protobuf(4,bytes,opt)
+}
+
+// EndpointsList is a list of endpoints.
+// Deprecated: This API is deprecated in v1.33+.
+#EndpointsList: {
+	metav1.#TypeMeta
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metadata?: metav1.#ListMeta @go(ListMeta) @protobuf(1,bytes,opt)
+
+	// List of endpoints.
+	items: [...#Endpoints] @go(Items,[]Endpoints) @protobuf(2,bytes,rep)
+}
+
+// NodeSpec describes the attributes that a node is created with.
+#NodeSpec: {
+	// PodCIDR represents the pod IP range assigned to the node.
+	// +optional
+	podCIDR?: string @go(PodCIDR) @protobuf(1,bytes,opt)
+
+	// podCIDRs represents the IP ranges assigned to the node for usage by Pods on that node. If this
+	// field is specified, the 0th entry must match the podCIDR field. It may contain at most 1 value for
+	// each of IPv4 and IPv6.
+	// +optional
+	// +patchStrategy=merge
+	// +listType=set
+	podCIDRs?: [...string] @go(PodCIDRs,[]string) @protobuf(7,bytes,opt)
+
+	// ID of the node assigned by the cloud provider in the format: <ProviderName>://<ProviderSpecificNodeID>
+	// +optional
+	providerID?: string @go(ProviderID) @protobuf(3,bytes,opt)
+
+	// Unschedulable controls node schedulability of new pods. By default, node is schedulable.
+	// More info: https://kubernetes.io/docs/concepts/nodes/node/#manual-node-administration
+	// +optional
+	unschedulable?: bool @go(Unschedulable) @protobuf(4,varint,opt)
+
+	// If specified, the node's taints.
+	// +optional
+	// +listType=atomic
+	taints?: [...#Taint] @go(Taints,[]Taint) @protobuf(5,bytes,opt)
+
+	// Deprecated: Previously used to specify the source of the node's configuration for the DynamicKubeletConfig feature. This feature is removed.
+	// +optional
+	configSource?: #NodeConfigSource @go(ConfigSource,*NodeConfigSource) @protobuf(6,bytes,opt)
+
+	// Deprecated. Not all kubelets will set this field. Remove field after 1.13.
+	// see: https://issues.k8s.io/61966
+	// +optional
+	externalID?: string @go(DoNotUseExternalID) @protobuf(2,bytes,opt)
+}
+
+// NodeConfigSource specifies a source of node configuration. Exactly one subfield (excluding metadata) must be non-nil.
+// This API is deprecated since 1.22
+#NodeConfigSource: {
+	// ConfigMap is a reference to a Node's ConfigMap
+	configMap?: #ConfigMapNodeConfigSource @go(ConfigMap,*ConfigMapNodeConfigSource) @protobuf(2,bytes,opt)
+}
+
+// ConfigMapNodeConfigSource contains the information to reference a ConfigMap as a config source for the Node.
+// This API is deprecated since 1.22: https://git.k8s.io/enhancements/keps/sig-node/281-dynamic-kubelet-configuration
+#ConfigMapNodeConfigSource: {
+	// Namespace is the metadata.namespace of the referenced ConfigMap.
+	// This field is required in all cases.
+	namespace: string @go(Namespace) @protobuf(1,bytes,opt)
+
+	// Name is the metadata.name of the referenced ConfigMap.
+	// This field is required in all cases.
+	name: string @go(Name) @protobuf(2,bytes,opt)
+
+	// UID is the metadata.UID of the referenced ConfigMap.
+	// This field is forbidden in Node.Spec, and required in Node.Status.
+	// +optional
+	uid?: types.#UID @go(UID) @protobuf(3,bytes,opt)
+
+	// ResourceVersion is the metadata.ResourceVersion of the referenced ConfigMap.
+	// This field is forbidden in Node.Spec, and required in Node.Status.
+	// +optional
+	resourceVersion?: string @go(ResourceVersion) @protobuf(4,bytes,opt)
+
+	// KubeletConfigKey declares which key of the referenced ConfigMap corresponds to the KubeletConfiguration structure
+	// This field is required in all cases.
+	kubeletConfigKey: string @go(KubeletConfigKey) @protobuf(5,bytes,opt)
+}
+
+// DaemonEndpoint contains information about a single Daemon endpoint.
+#DaemonEndpoint: {
+	// Port number of the given endpoint.
+	Port: int32 @protobuf(1,varint,opt)
+}
+
+// NodeDaemonEndpoints lists ports opened by daemons running on the Node.
+#NodeDaemonEndpoints: {
+	// Endpoint on which Kubelet is listening.
+	// +optional
+	kubeletEndpoint?: #DaemonEndpoint @go(KubeletEndpoint) @protobuf(1,bytes,opt)
+}
+
+// NodeRuntimeHandlerFeatures is a set of features implemented by the runtime handler.
+#NodeRuntimeHandlerFeatures: {
+	// RecursiveReadOnlyMounts is set to true if the runtime handler supports RecursiveReadOnlyMounts.
+	// +optional
+	recursiveReadOnlyMounts?: bool @go(RecursiveReadOnlyMounts,*bool) @protobuf(1,varint,opt)
+
+	// UserNamespaces is set to true if the runtime handler supports UserNamespaces, including for volumes.
+	// +optional
+	userNamespaces?: bool @go(UserNamespaces,*bool) @protobuf(2,varint,opt)
+}
+
+// NodeRuntimeHandler is a set of runtime handler information.
+#NodeRuntimeHandler: {
+	// Runtime handler name.
+	// Empty for the default runtime handler.
+	// +optional
+	name?: string @go(Name) @protobuf(1,bytes,opt)
+
+	// Supported features.
+	// +optional
+	features?: #NodeRuntimeHandlerFeatures @go(Features,*NodeRuntimeHandlerFeatures) @protobuf(2,bytes,opt)
+}
+
+// NodeFeatures describes the set of features implemented by the CRI implementation.
+// The features contained in the NodeFeatures should depend only on the cri implementation
+// independent of runtime handlers.
+#NodeFeatures: {
+	// SupplementalGroupsPolicy is set to true if the runtime supports SupplementalGroupsPolicy and ContainerUser.
+	// +optional
+	supplementalGroupsPolicy?: bool @go(SupplementalGroupsPolicy,*bool) @protobuf(1,varint,opt)
+}
+
+// NodeSystemInfo is a set of ids/uuids to uniquely identify the node.
+#NodeSystemInfo: {
+	// MachineID reported by the node. For unique machine identification
+	// in the cluster this field is preferred. Learn more from man(5)
+	// machine-id: http://man7.org/linux/man-pages/man5/machine-id.5.html
+	machineID: string @go(MachineID) @protobuf(1,bytes,opt)
+
+	// SystemUUID reported by the node. For unique machine identification
+	// MachineID is preferred. This field is specific to Red Hat hosts
+	// https://access.redhat.com/documentation/en-us/red_hat_subscription_management/1/html/rhsm/uuid
+	systemUUID: string @go(SystemUUID) @protobuf(2,bytes,opt)
+
+	// Boot ID reported by the node.
+	bootID: string @go(BootID) @protobuf(3,bytes,opt)
+
+	// Kernel Version reported by the node from 'uname -r' (e.g. 3.16.0-0.bpo.4-amd64).
+	kernelVersion: string @go(KernelVersion) @protobuf(4,bytes,opt)
+
+	// OS Image reported by the node from /etc/os-release (e.g. Debian GNU/Linux 7 (wheezy)).
+	osImage: string @go(OSImage) @protobuf(5,bytes,opt)
+
+	// ContainerRuntime Version reported by the node through runtime remote API (e.g. containerd://1.4.2).
+	containerRuntimeVersion: string @go(ContainerRuntimeVersion) @protobuf(6,bytes,opt)
+
+	// Kubelet Version reported by the node.
+	kubeletVersion: string @go(KubeletVersion) @protobuf(7,bytes,opt)
+
+	// Deprecated: KubeProxy Version reported by the node.
+	kubeProxyVersion: string @go(KubeProxyVersion) @protobuf(8,bytes,opt)
+
+	// The Operating System reported by the node
+	operatingSystem: string @go(OperatingSystem) @protobuf(9,bytes,opt)
+
+	// The Architecture reported by the node
+	architecture: string @go(Architecture) @protobuf(10,bytes,opt)
+
+	// Swap Info reported by the node.
+	swap?: #NodeSwapStatus @go(Swap,*NodeSwapStatus) @protobuf(11,bytes,opt)
+}
+
+// NodeSwapStatus represents swap memory information.
+#NodeSwapStatus: {
+	// Total amount of swap memory in bytes.
+	// +optional
+	capacity?: int64 @go(Capacity,*int64) @protobuf(1,varint,opt)
+}
+
+// NodeConfigStatus describes the status of the config assigned by Node.Spec.ConfigSource.
+#NodeConfigStatus: {
+	// Assigned reports the checkpointed config the node will try to use.
+	// When Node.Spec.ConfigSource is updated, the node checkpoints the associated
+	// config payload to local disk, along with a record indicating intended
+	// config. The node refers to this record to choose its config checkpoint, and
+	// reports this record in Assigned. Assigned only updates in the status after
+	// the record has been checkpointed to disk. When the Kubelet is restarted,
+	// it tries to make the Assigned config the Active config by loading and
+	// validating the checkpointed payload identified by Assigned.
+	// +optional
+	assigned?: #NodeConfigSource @go(Assigned,*NodeConfigSource) @protobuf(1,bytes,opt)
+
+	// Active reports the checkpointed config the node is actively using.
+	// Active will represent either the current version of the Assigned config,
+	// or the current LastKnownGood config, depending on whether attempting to use the
+	// Assigned config results in an error.
+	// +optional
+	active?: #NodeConfigSource @go(Active,*NodeConfigSource) @protobuf(2,bytes,opt)
+
+	// LastKnownGood reports the checkpointed config the node will fall back to
+	// when it encounters an error attempting to use the Assigned config.
+	// The Assigned config becomes the LastKnownGood config when the node determines
+	// that the Assigned config is stable and correct.
+	// This is currently implemented as a 10-minute soak period starting when the local
+	// record of Assigned config is updated. If the Assigned config is Active at the end
+	// of this period, it becomes the LastKnownGood. Note that if Spec.ConfigSource is
+	// reset to nil (use local defaults), the LastKnownGood is also immediately reset to nil,
+	// because the local default config is always assumed good.
+	// You should not make assumptions about the node's method of determining config stability
+	// and correctness, as this may change or become configurable in the future.
+	// +optional
+	lastKnownGood?: #NodeConfigSource @go(LastKnownGood,*NodeConfigSource) @protobuf(3,bytes,opt)
+
+	// Error describes any problems reconciling the Spec.ConfigSource to the Active config.
+	// Errors may occur, for example, attempting to checkpoint Spec.ConfigSource to the local Assigned
+	// record, attempting to checkpoint the payload associated with Spec.ConfigSource, attempting
+	// to load or validate the Assigned config, etc.
+	// Errors may occur at different points while syncing config. Earlier errors (e.g. download or
+	// checkpointing errors) will not result in a rollback to LastKnownGood, and may resolve across
+	// Kubelet retries. Later errors (e.g. loading or validating a checkpointed config) will result in
+	// a rollback to LastKnownGood. In the latter case, it is usually possible to resolve the error
+	// by fixing the config assigned in Spec.ConfigSource.
+	// You can find additional information for debugging by searching the error message in the Kubelet log.
+	// Error is a human-readable description of the error state; machines can check whether or not Error
+	// is empty, but should not rely on the stability of the Error text across Kubelet versions.
+	// +optional
+	error?: string @go(Error) @protobuf(4,bytes,opt)
+}
+
+// NodeStatus is information about the current status of a node.
+#NodeStatus: {
+	// Capacity represents the total resources of a node.
+	// More info: https://kubernetes.io/docs/reference/node/node-status/#capacity
+	// +optional
+	capacity?: #ResourceList @go(Capacity) @protobuf(1,bytes,rep,casttype=ResourceList,castkey=ResourceName)
+
+	// Allocatable represents the resources of a node that are available for scheduling.
+	// Defaults to Capacity.
+	// +optional
+	allocatable?: #ResourceList @go(Allocatable) @protobuf(2,bytes,rep,casttype=ResourceList,castkey=ResourceName)
+
+	// NodePhase is the recently observed lifecycle phase of the node.
+	// More info: https://kubernetes.io/docs/concepts/nodes/node/#phase
+	// The field is never populated, and now is deprecated.
+	// +optional
+	phase?: #NodePhase @go(Phase) @protobuf(3,bytes,opt,casttype=NodePhase)
+
+	// Conditions is an array of current observed node conditions.
+	// More info: https://kubernetes.io/docs/reference/node/node-status/#condition
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	conditions?: [...#NodeCondition] @go(Conditions,[]NodeCondition) @protobuf(4,bytes,rep)
+
+	// List of addresses reachable to the node.
+	// Queried from cloud provider, if available.
+	// More info: https://kubernetes.io/docs/reference/node/node-status/#addresses
+	// Note: This field is declared as mergeable, but the merge key is not sufficiently
+	// unique, which can cause data corruption when it is merged. Callers should instead
+	// use a full-replacement patch. See https://pr.k8s.io/79391 for an example.
+	// Consumers should assume that addresses can change during the
+	// lifetime of a Node. However, there are some exceptions where this may not
+	// be possible, such as Pods that inherit a Node's address in its own status or
+	// consumers of the downward API (status.hostIP).
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	addresses?: [...#NodeAddress] @go(Addresses,[]NodeAddress) @protobuf(5,bytes,rep)
+
+	// Endpoints of daemons running on the Node.
+	// +optional
+	daemonEndpoints?: #NodeDaemonEndpoints @go(DaemonEndpoints) @protobuf(6,bytes,opt)
+
+	// Set of ids/uuids to uniquely identify the node.
+	// More info: https://kubernetes.io/docs/reference/node/node-status/#info
+	// +optional
+	nodeInfo?: #NodeSystemInfo @go(NodeInfo) @protobuf(7,bytes,opt)
+
+	// List of container images on this node
+	// +optional
+	// +listType=atomic
+	images?: [...#ContainerImage] @go(Images,[]ContainerImage) @protobuf(8,bytes,rep)
+
+	// List of attachable volumes in use (mounted) by the node.
+	// +optional
+	// +listType=atomic
+	volumesInUse?: [...#UniqueVolumeName] @go(VolumesInUse,[]UniqueVolumeName) @protobuf(9,bytes,rep)
+
+	// List of volumes that are attached to the node.
+	// +optional
+	// +listType=atomic
+	volumesAttached?: [...#AttachedVolume] @go(VolumesAttached,[]AttachedVolume) @protobuf(10,bytes,rep)
+
+	// Status of the config assigned to the node via the dynamic Kubelet config feature.
+	// +optional
+	config?: #NodeConfigStatus @go(Config,*NodeConfigStatus) @protobuf(11,bytes,opt)
+
+	// The available runtime handlers.
+	// +optional
+	// +listType=atomic
+	runtimeHandlers?: [...#NodeRuntimeHandler] @go(RuntimeHandlers,[]NodeRuntimeHandler) @protobuf(12,bytes,rep)
+
+	// Features describes the set of features implemented by the CRI implementation.
+	// +featureGate=SupplementalGroupsPolicy
+	// +optional
+	features?: #NodeFeatures @go(Features,*NodeFeatures) @protobuf(13,bytes,rep)
+
+	// DeclaredFeatures represents the features related to feature gates that are declared by the node.
+	// +featureGate=NodeDeclaredFeatures
+	// +optional
+	// +listType=atomic
+	declaredFeatures?: [...string] @go(DeclaredFeatures,[]string) @protobuf(14,bytes,rep)
+}
+
+#UniqueVolumeName: string
+
+// AttachedVolume describes a volume attached to a node
+#AttachedVolume: {
+	// Name of the attached volume
+	name: #UniqueVolumeName @go(Name) @protobuf(1,bytes,rep)
+
+	// DevicePath represents the device path where the volume should be available
+	devicePath: string @go(DevicePath) @protobuf(2,bytes,rep)
+}
+
+// AvoidPods describes pods that should avoid this node. This is the value for a
+// Node annotation with key scheduler.alpha.kubernetes.io/preferAvoidPods and
+// will eventually become a field of NodeStatus.
+#AvoidPods: {
+	// Bounded-sized list of signatures of pods that should avoid this node, sorted
+	// in timestamp order from oldest to newest. Size of the slice is unspecified.
+	// +optional
+	// +listType=atomic
+	preferAvoidPods?: [...#PreferAvoidPodsEntry] @go(PreferAvoidPods,[]PreferAvoidPodsEntry) @protobuf(1,bytes,rep)
+}
+
+// Describes a class of pods that should avoid this node.
+#PreferAvoidPodsEntry: {
+	// The class of pods.
+	podSignature: #PodSignature @go(PodSignature) @protobuf(1,bytes,opt)
+
+	// Time at which this entry was added to the list.
+	// +optional
+	evictionTime?: metav1.#Time @go(EvictionTime) @protobuf(2,bytes,opt)
+
+	// (brief) reason why this entry was added to the list.
+	// +optional
+	reason?: string @go(Reason) @protobuf(3,bytes,opt)
+
+	// Human readable message indicating why this entry was added to the list.
+	// +optional
+	message?: string @go(Message) @protobuf(4,bytes,opt)
+}
+
+// Describes the class of pods that should avoid this node.
+// Exactly one field should be set.
+#PodSignature: {
+	// Reference to controller whose pods should avoid this node.
+	// +optional
+	podController?: metav1.#OwnerReference @go(PodController,*metav1.OwnerReference) @protobuf(1,bytes,opt)
+}
+
+// Describe a container image
+#ContainerImage: {
+	// Names by which this image is known.
+	// e.g. ["kubernetes.example/hyperkube:v1.0.7", "cloud-vendor.registry.example/cloud-vendor/hyperkube:v1.0.7"]
+	// +optional
+	// +listType=atomic
+	names?: [...string] @go(Names,[]string) @protobuf(1,bytes,rep)
+
+	// The size of the image in bytes.
+	// +optional
+	sizeBytes?: int64 @go(SizeBytes) @protobuf(2,varint,opt)
+}
+
+// +enum
+#NodePhase: string // #enumNodePhase
+
+#enumNodePhase:
+	#NodePending |
+	#NodeRunning |
+	#NodeTerminated
+
+// NodePending means the node has been created/added by the system, but not configured.
+#NodePending: #NodePhase & "Pending"
+
+// NodeRunning means the node has been configured and has Kubernetes components running.
+#NodeRunning: #NodePhase & "Running"
+
+// NodeTerminated means the node has been removed from the cluster.
+#NodeTerminated: #NodePhase & "Terminated"
+
+#NodeConditionType: string // #enumNodeConditionType
+
+#enumNodeConditionType:
+	#NodeReady |
+	#NodeMemoryPressure |
+	#NodeDiskPressure |
+	#NodePIDPressure |
+	#NodeNetworkUnavailable
+
+// NodeReady means kubelet is healthy and ready to accept pods.
+#NodeReady: #NodeConditionType & "Ready"
+
+// NodeMemoryPressure means the kubelet is under pressure due to insufficient available memory.
+#NodeMemoryPressure: #NodeConditionType & "MemoryPressure"
+
+// NodeDiskPressure means the kubelet is under pressure due to insufficient available disk.
+#NodeDiskPressure: #NodeConditionType & "DiskPressure"
+
+// NodePIDPressure means the kubelet is under pressure due to insufficient available PID.
+#NodePIDPressure: #NodeConditionType & "PIDPressure"
+
+// NodeNetworkUnavailable means that network for the node is not correctly configured.
+#NodeNetworkUnavailable: #NodeConditionType & "NetworkUnavailable"
+
+// NodeCondition contains condition information for a node.
+#NodeCondition: {
+	// Type of node condition.
+	type: #NodeConditionType @go(Type) @protobuf(1,bytes,opt,casttype=NodeConditionType)
+
+	// Status of the condition, one of True, False, Unknown.
+	status: #ConditionStatus @go(Status) @protobuf(2,bytes,opt,casttype=ConditionStatus)
+
+	// Last time we got an update on a given condition.
+	// +optional
+	lastHeartbeatTime?: metav1.#Time @go(LastHeartbeatTime) @protobuf(3,bytes,opt)
+
+	// Last time the condition transit from one status to another.
+	// +optional
+	lastTransitionTime?: metav1.#Time @go(LastTransitionTime) @protobuf(4,bytes,opt)
+
+	// (brief) reason for the condition's last transition.
+	// +optional
+	reason?: string @go(Reason) @protobuf(5,bytes,opt)
+
+	// Human readable message indicating details about last transition.
+	// +optional
+	message?: string @go(Message) @protobuf(6,bytes,opt)
+}
+
+#NodeAddressType: string // #enumNodeAddressType
+
+#enumNodeAddressType:
+	#NodeHostName |
+	#NodeInternalIP |
+	#NodeExternalIP |
+	#NodeInternalDNS |
+	#NodeExternalDNS
+
+// NodeHostName identifies a name of the node. Although every node can be assumed
+// to have a NodeAddress of this type, its exact syntax and semantics are not
+// defined, and are not consistent between different clusters.
+#NodeHostName: #NodeAddressType & "Hostname"
+
+// NodeInternalIP identifies an IP address which is assigned to one of the node's
+// network interfaces. Every node should have at least one address of this type.
+//
+// An internal IP is normally expected to be reachable from every other node, but
+// may not be visible to hosts outside the cluster. By default it is assumed that
+// kube-apiserver can reach node internal IPs, though it is possible to configure
+// clusters where this is not the case.
+//
+// NodeInternalIP is the default type of node IP, and does not necessarily imply
+// that the IP is ONLY reachable internally. If a node has multiple internal IPs,
+// no specific semantics are assigned to the additional IPs.
+#NodeInternalIP: #NodeAddressType & "InternalIP"
+
+// NodeExternalIP identifies an IP address which is, in some way, intended to be
+// more usable from outside the cluster then an internal IP, though no specific
+// semantics are defined. It may be a globally routable IP, though it is not
+// required to be.
+//
+// External IPs may be assigned directly to an interface on the node, like a
+// NodeInternalIP, or alternatively, packets sent to the external IP may be NAT'ed
+// to an internal node IP rather than being delivered directly (making the IP less
+// efficient for node-to-node traffic than a NodeInternalIP).
+#NodeExternalIP: #NodeAddressType & "ExternalIP"
+
+// NodeInternalDNS identifies a DNS name which resolves to an IP address which has
+// the characteristics of a NodeInternalIP. The IP it resolves to may or may not
+// be a listed NodeInternalIP address.
+#NodeInternalDNS: #NodeAddressType & "InternalDNS"
+
+// NodeExternalDNS identifies a DNS name which resolves to an IP address which has
+// the characteristics of a NodeExternalIP. The IP it resolves to may or may not
+// be a listed NodeExternalIP address.
+#NodeExternalDNS: #NodeAddressType & "ExternalDNS"
+
+// NodeAddress contains information for the node's address.
+#NodeAddress: {
+	// Node address type, one of Hostname, ExternalIP or InternalIP.
+	type: #NodeAddressType @go(Type) @protobuf(1,bytes,opt,casttype=NodeAddressType)
+
+	// The node address.
+	address: string @go(Address) @protobuf(2,bytes,opt)
+}
+
+// ResourceName is the name identifying various resources in a ResourceList.
+#ResourceName: string // #enumResourceName
+
+#enumResourceName:
+	#ResourceCPU |
+	#ResourceMemory |
+	#ResourceStorage |
+	#ResourceEphemeralStorage |
+	#ResourcePods |
+	#ResourceServices |
+	#ResourceReplicationControllers |
+	#ResourceQuotas |
+	#ResourceSecrets |
+	#ResourceConfigMaps |
+	#ResourcePersistentVolumeClaims |
+	#ResourceServicesNodePorts |
+	#ResourceServicesLoadBalancers |
+	#ResourceRequestsCPU |
+	#ResourceRequestsMemory |
+	#ResourceRequestsStorage |
+	#ResourceRequestsEphemeralStorage |
+	#ResourceLimitsCPU |
+	#ResourceLimitsMemory |
+	#ResourceLimitsEphemeralStorage
+
+// CPU, in cores. (500m = .5 cores)
+#ResourceCPU: #ResourceName & "cpu"
+
+// Memory, in bytes. (500Gi = 500GiB = 500 * 1024 * 1024 * 1024)
+#ResourceMemory: #ResourceName & "memory"
+
+// Volume size, in bytes (e,g. 5Gi = 5GiB = 5 * 1024 * 1024 * 1024)
+#ResourceStorage: #ResourceName & "storage"
+
+// Local ephemeral storage, in bytes. (500Gi = 500GiB = 500 * 1024 * 1024 * 1024)
+#ResourceEphemeralStorage: #ResourceName & "ephemeral-storage"
+
+// Default namespace prefix.
+#ResourceDefaultNamespacePrefix: "kubernetes.io/"
+
+// Name prefix for huge page resources (alpha).
+#ResourceHugePagesPrefix: "hugepages-"
+
+// Name prefix for storage resource limits
+#ResourceAttachableVolumesPrefix: "attachable-volumes-"
+
+// ResourceList is a set of (resource name, quantity) pairs.
+#ResourceList: {[string]: resource.#Quantity}
+
+// Node is a worker node in Kubernetes.
+// Each node will have a unique identifier in the cache (i.e. in etcd).
+#Node: {
+	metav1.#TypeMeta
+
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metadata?: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// Spec defines the behavior of a node.
+	// https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	spec?: #NodeSpec @go(Spec) @protobuf(2,bytes,opt)
+
+	// Most recently observed status of the node.
+	// Populated by the system.
+	// Read-only.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	status?: #NodeStatus @go(Status) @protobuf(3,bytes,opt)
+}
+
+// NodeList is the whole list of all Nodes which have been registered with master.
+#NodeList: {
+	metav1.#TypeMeta
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metadata?: metav1.#ListMeta @go(ListMeta) @protobuf(1,bytes,opt)
+
+	// List of nodes
+	items: [...#Node] @go(Items,[]Node) @protobuf(2,bytes,rep)
+}
+
+// FinalizerName is the name identifying a finalizer during namespace lifecycle.
+#FinalizerName: string // #enumFinalizerName
+
+#enumFinalizerName:
+	#FinalizerKubernetes
+
+#FinalizerKubernetes: #FinalizerName & "kubernetes"
+
+// NamespaceSpec describes the attributes on a Namespace.
+#NamespaceSpec: {
+	// Finalizers is an opaque list of values that must be empty to permanently remove object from storage.
+	// More info: https://kubernetes.io/docs/tasks/administer-cluster/namespaces/
+	// +optional
+	// +listType=atomic
+	finalizers?: [...#FinalizerName] @go(Finalizers,[]FinalizerName) @protobuf(1,bytes,rep,casttype=FinalizerName)
+}
+
+// NamespaceStatus is information about the current status of a Namespace.
+#NamespaceStatus: {
+	// Phase is the current lifecycle phase of the namespace.
+	// More info: https://kubernetes.io/docs/tasks/administer-cluster/namespaces/
+	// +optional
+	phase?: #NamespacePhase @go(Phase) @protobuf(1,bytes,opt,casttype=NamespacePhase)
+
+	// Represents the latest available observations of a namespace's current state.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	conditions?: [...#NamespaceCondition] @go(Conditions,[]NamespaceCondition) @protobuf(2,bytes,rep)
+}
+
+// +enum
+#NamespacePhase: string // #enumNamespacePhase
+
+#enumNamespacePhase:
+	#NamespaceActive |
+	#NamespaceTerminating
+
+// NamespaceActive means the namespace is available for use in the system
+#NamespaceActive: #NamespacePhase & "Active"
+
+// NamespaceTerminating means the namespace is undergoing graceful termination
+#NamespaceTerminating: #NamespacePhase & "Terminating"
+
+// NamespaceTerminatingCause is returned as a defaults.cause item when a change is
+// forbidden due to the namespace being terminated.
+#NamespaceTerminatingCause: metav1.#CauseType & "NamespaceTerminating"
+
+#NamespaceConditionType: string // #enumNamespaceConditionType
+
+#enumNamespaceConditionType:
+	#NamespaceDeletionDiscoveryFailure |
+	#NamespaceDeletionContentFailure |
+	#NamespaceDeletionGVParsingFailure |
+	#NamespaceContentRemaining |
+	#NamespaceFinalizersRemaining
+
+// NamespaceDeletionDiscoveryFailure contains information about namespace deleter errors during resource discovery.
+#NamespaceDeletionDiscoveryFailure: #NamespaceConditionType & "NamespaceDeletionDiscoveryFailure"
+
+// NamespaceDeletionContentFailure contains information about namespace deleter errors during deletion of resources.
+#NamespaceDeletionContentFailure: #NamespaceConditionType & "NamespaceDeletionContentFailure"
+
+// NamespaceDeletionGVParsingFailure contains information about namespace deleter errors parsing GV for legacy types.
+#NamespaceDeletionGVParsingFailure: #NamespaceConditionType & "NamespaceDeletionGroupVersionParsingFailure"
+
+// NamespaceContentRemaining contains information about resources remaining in a namespace.
+#NamespaceContentRemaining: #NamespaceConditionType & "NamespaceContentRemaining"
+
+// NamespaceFinalizersRemaining contains information about which finalizers are on resources remaining in a namespace.
+#NamespaceFinalizersRemaining: #NamespaceConditionType & "NamespaceFinalizersRemaining"
+
+// NamespaceCondition contains details about state of namespace.
+#NamespaceCondition: {
+	// Type of namespace controller condition.
+	type: #NamespaceConditionType @go(Type) @protobuf(1,bytes,opt,casttype=NamespaceConditionType)
+
+	// Status of the condition, one of True, False, Unknown.
+	status: #ConditionStatus @go(Status) @protobuf(2,bytes,opt,casttype=ConditionStatus)
+
+	// Last time the condition transitioned from one status to another.
+	// +optional
+	lastTransitionTime?: metav1.#Time @go(LastTransitionTime) @protobuf(4,bytes,opt)
+
+	// Unique, one-word, CamelCase reason for the condition's last transition.
+	// +optional
+	reason?: string @go(Reason) @protobuf(5,bytes,opt)
+
+	// Human-readable message indicating details about last transition.
+	// +optional
+	message?: string @go(Message) @protobuf(6,bytes,opt)
+}
+
+// Namespace provides a scope for Names.
+// Use of multiple namespaces is optional.
+#Namespace: {
+	metav1.#TypeMeta
+
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metadata?: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// Spec defines the behavior of the Namespace.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	spec?: #NamespaceSpec @go(Spec) @protobuf(2,bytes,opt)
+
+	// Status describes the current status of a Namespace.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	status?: #NamespaceStatus @go(Status) @protobuf(3,bytes,opt)
+}
+
+// NamespaceList is a list of Namespaces.
+#NamespaceList: {
+	metav1.#TypeMeta
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metadata?: metav1.#ListMeta @go(ListMeta) @protobuf(1,bytes,opt)
+
+	// Items is the list of Namespace objects in the list.
+	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces/
+	items: [...#Namespace] @go(Items,[]Namespace) @protobuf(2,bytes,rep)
+}
+
+// Binding ties one object to another; for example, a pod is bound to a node by a scheduler.
+#Binding: {
+	metav1.#TypeMeta
+
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metadata?: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// The target object that you want to bind to the standard object.
+	target: #ObjectReference @go(Target) @protobuf(2,bytes,opt)
+}
+
+// Preconditions must be fulfilled before an operation (update, delete, etc.) is carried out.
+// +k8s:openapi-gen=false
+#Preconditions: {
+	// Specifies the target UID.
+	// +optional
+	uid?: types.#UID @go(UID,*types.UID) @protobuf(1,bytes,opt,casttype=k8s.io/apimachinery/pkg/types.UID)
+}
+
+// LogStreamStdout is the stream type for stdout.
+#LogStreamStdout: "Stdout"
+
+// LogStreamStderr is the stream type for stderr.
+#LogStreamStderr: "Stderr"
+
+// LogStreamAll represents the combined stdout and stderr.
+#LogStreamAll: "All"
+
+// PodLogOptions is the query options for a Pod's logs REST call.
+#PodLogOptions: {
+	metav1.#TypeMeta
+
+	// The container for which to stream logs. Defaults to only container if there is one container in the pod.
+	// +optional
+	container?: string @go(Container) @protobuf(1,bytes,opt)
+
+	// Follow the log stream of the pod. Defaults to false.
+	// +optional
+	follow?: bool @go(Follow) @protobuf(2,varint,opt)
+
+	// Return previous terminated container logs. Defaults to false.
+	// +optional
+	previous?: bool @go(Previous) @protobuf(3,varint,opt)
+
+	// A relative time in seconds before the current time from which to show logs. If this value
+	// precedes the time a pod was started, only logs since the pod start will be returned.
+	// If this value is in the future, no logs will be returned.
+	// Only one of sinceSeconds or sinceTime may be specified.
+	// +optional
+	sinceSeconds?: int64 @go(SinceSeconds,*int64) @protobuf(4,varint,opt)
+
+	// An RFC3339 timestamp from which to show logs. If this value
+	// precedes the time a pod was started, only logs since the pod start will be returned.
+	// If this value is in the future, no logs will be returned.
+	// Only one of sinceSeconds or sinceTime may be specified.
+	// +optional
+	sinceTime?: metav1.#Time @go(SinceTime,*metav1.Time) @protobuf(5,bytes,opt)
+
+	// If true, add an RFC3339 or RFC3339Nano timestamp at the beginning of every line
+	// of log output. Defaults to false.
+	// +optional
+	timestamps?: bool @go(Timestamps) @protobuf(6,varint,opt)
+
+	// If set, the number of lines from the end of the logs to show. If not specified,
+	// logs are shown from the creation of the container or sinceSeconds or sinceTime.
+	// Note that when "TailLines" is specified, "Stream" can only be set to nil or "All".
+	// +optional
+	tailLines?: int64 @go(TailLines,*int64) @protobuf(7,varint,opt)
+
+	// If set, the number of bytes to read from the server before terminating the
+	// log output. This may not display a complete final line of logging, and may return
+	// slightly more or slightly less than the specified limit.
+	// +optional
+	limitBytes?: int64 @go(LimitBytes,*int64) @protobuf(8,varint,opt)
+
+	// insecureSkipTLSVerifyBackend indicates that the apiserver should not confirm the validity of the
+	// serving certificate of the backend it is connecting to.  This will make the HTTPS connection between the apiserver
+	// and the backend insecure. This means the apiserver cannot verify the log data it is receiving came from the real
+	// kubelet.  If the kubelet is configured to verify the apiserver's TLS credentials, it does not mean the
+	// connection to the real kubelet is vulnerable to a man in the middle attack (e.g. an attacker could not intercept
+	// the actual log data coming from the real kubelet).
+	// +optional
+	insecureSkipTLSVerifyBackend?: bool @go(InsecureSkipTLSVerifyBackend) @protobuf(9,varint,opt)
+
+	// Specify which container log stream to return to the client.
+	// Acceptable values are "All", "Stdout" and "Stderr". If not specified, "All" is used, and both stdout and stderr
+	// are returned interleaved.
+	// Note that when "TailLines" is specified, "Stream" can only be set to nil or "All".
+	// +featureGate=PodLogsQuerySplitStreams
+	// +optional
+	stream?: string @go(Stream,*string) @protobuf(10,varint,opt)
+}
+
+// PodAttachOptions is the query options to a Pod's remote attach call.
+// ---
+// TODO: merge w/ PodExecOptions below for stdin, stdout, etc
+// and also when we cut V2, we should export a "StreamOptions" or somesuch that contains Stdin, Stdout, Stder and TTY
+#PodAttachOptions: {
+	metav1.#TypeMeta
+
+	// Stdin if true, redirects the standard input stream of the pod for this call.
+	// Defaults to false.
+	// +optional
+	stdin?: bool @go(Stdin) @protobuf(1,varint,opt)
+
+	// Stdout if true indicates that stdout is to be redirected for the attach call.
+	// Defaults to true.
+	// +optional
+	stdout?: bool @go(Stdout) @protobuf(2,varint,opt)
+
+	// Stderr if true indicates that stderr is to be redirected for the attach call.
+	// Defaults to true.
+	// +optional
+	stderr?: bool @go(Stderr) @protobuf(3,varint,opt)
+
+	// TTY if true indicates that a tty will be allocated for the attach call.
+	// This is passed through the container runtime so the tty
+	// is allocated on the worker node by the container runtime.
+	// Defaults to false.
+	// +optional
+	tty?: bool @go(TTY) @protobuf(4,varint,opt)
+
+	// The container in which to execute the command.
+	// Defaults to only container if there is only one container in the pod.
+	// +optional
+	container?: string @go(Container) @protobuf(5,bytes,opt)
+}
+
+// PodExecOptions is the query options to a Pod's remote exec call.
+// ---
+// TODO: This is largely identical to PodAttachOptions above, make sure they stay in sync and see about merging
+// and also when we cut V2, we should export a "StreamOptions" or somesuch that contains Stdin, Stdout, Stder and TTY
+#PodExecOptions: {
+	metav1.#TypeMeta
+
+	// Redirect the standard input stream of the pod for this call.
+	// Defaults to false.
+	// +optional
+	stdin?: bool @go(Stdin) @protobuf(1,varint,opt)
+
+	// Redirect the standard output stream of the pod for this call.
+	// +optional
+	stdout?: bool @go(Stdout) @protobuf(2,varint,opt)
+
+	// Redirect the standard error stream of the pod for this call.
+	// +optional
+	stderr?: bool @go(Stderr) @protobuf(3,varint,opt)
+
+	// TTY if true indicates that a tty will be allocated for the exec call.
+	// Defaults to false.
+	// +optional
+	tty?: bool @go(TTY) @protobuf(4,varint,opt)
+
+	// Container in which to execute the command.
+	// Defaults to only container if there is only one container in the pod.
+	// +optional
+	container?: string @go(Container) @protobuf(5,bytes,opt)
+
+	// Command is the remote command to execute. argv array. Not executed within a shell.
+	// +listType=atomic
+	command: [...string] @go(Command,[]string) @protobuf(6,bytes,rep)
+}
+
+// PodPortForwardOptions is the query options to a Pod's port forward call
+// when using WebSockets.
+// The `port` query parameter must specify the port or
+// ports (comma separated) to forward over.
+// Port forwarding over SPDY does not use these options. It requires the port
+// to be passed in the `port` header as part of request.
+#PodPortForwardOptions: {
+	metav1.#TypeMeta
+
+	// List of ports to forward
+	// Required when using WebSockets
+	// +optional
+	// +listType=atomic
+	ports?: [...int32] @go(Ports,[]int32) @protobuf(1,varint,rep)
+}
+
+// PodProxyOptions is the query options to a Pod's proxy call.
+#PodProxyOptions: {
+	metav1.#TypeMeta
+
+	// Path is the URL path to use for the current proxy request to pod.
+	// +optional
+	path?: string @go(Path) @protobuf(1,bytes,opt)
+}
+
+// NodeProxyOptions is the query options to a Node's proxy call.
+#NodeProxyOptions: {
+	metav1.#TypeMeta
+
+	// Path is the URL path to use for the current proxy request to node.
+	// +optional
+	path?: string @go(Path) @protobuf(1,bytes,opt)
+}
+
+// ServiceProxyOptions is the query options to a Service's proxy call.
+#ServiceProxyOptions: {
+	metav1.#TypeMeta
+
+	// Path is the part of URLs that include service endpoints, suffixes,
+	// and parameters to use for the current proxy request to service.
+	// For example, the whole request URL is
+	// http://localhost/api/v1/namespaces/kube-system/services/elasticsearch-logging/_search?q=user:kimchy.
+	// Path is _search?q=user:kimchy.
+	// +optional
+	path?: string @go(Path) @protobuf(1,bytes,opt)
+}
+
+// ObjectReference contains enough information to let you inspect or modify the referred object.
+// ---
+// New uses of this type are discouraged because of difficulty describing its usage when embedded in APIs.
+//  1. Ignored fields.  It includes many fields which are not generally honored.  For instance, ResourceVersion and FieldPath are both very rarely valid in actual usage.
+//  2. Invalid usage help.  It is impossible to add specific help for individual usage.  In most embedded usages, there are particular
+//     restrictions like, "must refer only to types A and B" or "UID not honored" or "name must be restricted".
+//     Those cannot be well described when embedded.
+//  3. Inconsistent validation.  Because the usages are different, the validation rules are different by usage, which makes it hard for users to predict what will happen.
+//  4. The fields are both imprecise and overly precise.  Kind is not a precise mapping to a URL. This can produce ambiguity
+//     during interpretation and require a REST mapping.  In most cases, the dependency is on the group,resource tuple
+//     and the version of the actual struct is irrelevant.
+//  5. We cannot easily change it.  Because this type is embedded in many locations, updates to this type
+//     will affect numerous schemas.  Don't make new APIs embed an underspecified API type they do not control.
+//
+// Instead of using this type, create a locally provided and used type that is well-focused on your reference.
+// For example, ServiceReferences for admission registration: https://github.com/kubernetes/api/blob/release-1.17/admissionregistration/v1/types.go#L533 .
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +structType=atomic
+#ObjectReference: {
+	// Kind of the referent.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	kind?: string @go(Kind) @protobuf(1,bytes,opt)
+
+	// Namespace of the referent.
+	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces/
+	// +optional
+	namespace?: string @go(Namespace) @protobuf(2,bytes,opt)
+
+	// Name of the referent.
+	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+	// +optional
+	name?: string @go(Name) @protobuf(3,bytes,opt)
+
+	// UID of the referent.
+	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#uids
+	// +optional
+	uid?: types.#UID @go(UID) @protobuf(4,bytes,opt,casttype=k8s.io/apimachinery/pkg/types.UID)
+
+	// API version of the referent.
+	// +optional
+	apiVersion?: string @go(APIVersion) @protobuf(5,bytes,opt)
+
+	// Specific resourceVersion to which this reference is made, if any.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency
+	// +optional
+	resourceVersion?: string @go(ResourceVersion) @protobuf(6,bytes,opt)
+
+	// If referring to a piece of an object instead of an entire object, this string
+	// should contain a valid JSON/Go field access statement, such as desiredState.manifest.containers[2].
+	// For example, if the object reference is to a container within a pod, this would take on a value like:
+	// "spec.containers{name}" (where "name" refers to the name of the container that triggered
+	// the event) or if no container name is specified "spec.containers[2]" (container with
+	// index 2 in this pod). This syntax is chosen only to have some well-defined way of
+	// referencing a part of an object.
+	// TODO: this design is not final and this field is subject to change in the future.
+	// +optional
+	fieldPath?: string @go(FieldPath) @protobuf(7,bytes,opt)
+}
+
+// LocalObjectReference contains enough information to let you locate the
+// referenced object inside the same namespace.
+// ---
+// New uses of this type are discouraged because of difficulty describing its usage when embedded in APIs.
+//  1. Invalid usage help.  It is impossible to add specific help for individual usage.  In most embedded usages, there are particular
+//     restrictions like, "must refer only to types A and B" or "UID not honored" or "name must be restricted".
+//     Those cannot be well described when embedded.
+//  2. Inconsistent validation.  Because the usages are different, the validation rules are different by usage, which makes it hard for users to predict what will happen.
+//  3. We cannot easily change it.  Because this type is embedded in many locations, updates to this type
+//     will affect numerous schemas.  Don't make new APIs embed an underspecified API type they do not control.
+//
+// Instead of using this type, create a locally provided and used type that is well-focused on your reference.
+// For example, ServiceReferences for admission registration: https://github.com/kubernetes/api/blob/release-1.17/admissionregistration/v1/types.go#L533 .
+// +structType=atomic
+#LocalObjectReference: {
+	// Name of the referent.
+	// This field is effectively required, but due to backwards compatibility is
+	// allowed to be empty. Instances of this type with an empty value here are
+	// almost certainly wrong.
+	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+	// +optional
+	// +default=""
+	// +kubebuilder:default=""
+	// TODO: Drop `kubebuilder:default` when controller-gen doesn't need it https://github.com/kubernetes-sigs/kubebuilder/issues/3896.
+	name?: string @go(Name) @protobuf(1,bytes,opt)
+}
+
+// TypedLocalObjectReference contains enough information to let you locate the
+// typed referenced object inside the same namespace.
+// ---
+// New uses of this type are discouraged because of difficulty describing its usage when embedded in APIs.
+//  1. Invalid usage help.  It is impossible to add specific help for individual usage.  In most embedded usages, there are particular
+//     restrictions like, "must refer only to types A and B" or "UID not honored" or "name must be restricted".
+//     Those cannot be well described when embedded.
+//  2. Inconsistent validation.  Because the usages are different, the validation rules are different by usage, which makes it hard for users to predict what will happen.
+//  3. The fields are both imprecise and overly precise.  Kind is not a precise mapping to a URL. This can produce ambiguity
+//     during interpretation and require a REST mapping.  In most cases, the dependency is on the group,resource tuple
+//     and the version of the actual struct is irrelevant.
+//  4. We cannot easily change it.  Because this type is embedded in many locations, updates to this type
+//     will affect numerous schemas.  Don't make new APIs embed an underspecified API type they do not control.
+//
+// Instead of using this type, create a locally provided and used type that is well-focused on your reference.
+// For example, ServiceReferences for admission registration: https://github.com/kubernetes/api/blob/release-1.17/admissionregistration/v1/types.go#L533 .
+// +structType=atomic
+#TypedLocalObjectReference: {
+	// APIGroup is the group for the resource being referenced.
+	// If APIGroup is not specified, the specified Kind must be in the core API group.
+	// For any other third-party types, APIGroup is required.
+	// +optional
+	apiGroup?: string @go(APIGroup,*string) @protobuf(1,bytes,opt)
+
+	// Kind is the type of resource being referenced
+	kind: string @go(Kind) @protobuf(2,bytes,opt)
+
+	// Name is the name of resource being referenced
+	name: string @go(Name) @protobuf(3,bytes,opt)
+}
+
+// SerializedReference is a reference to serialized object.
+#SerializedReference: {
+	metav1.#TypeMeta
+
+	// The reference to an object in the system.
+	// +optional
+	reference?: #ObjectReference @go(Reference) @protobuf(1,bytes,opt)
+}
+
+// EventSource contains information for an event.
+#EventSource: {
+	// Component from which the event is generated.
+	// +optional
+	component?: string @go(Component) @protobuf(1,bytes,opt)
+
+	// Node name on which the event is generated.
+	// +optional
+	host?: string @go(Host) @protobuf(2,bytes,opt)
+}
+
+// Information only and will not cause any problems
+#EventTypeNormal: "Normal"
+
+// These events are to warn that something might go wrong
+#EventTypeWarning: "Warning"
+
+// Event is a report of an event somewhere in the cluster.  Events
+// have a limited retention time and triggers and messages may evolve
+// with time.  Event consumers should not rely on the timing of an event
+// with a given Reason reflecting a consistent underlying trigger, or the
+// continued existence of events with that Reason.  Events should be
+// treated as informative, best-effort, supplemental data.
+#Event: {
+	metav1.#TypeMeta
+
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metadata: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// The object that this event is about.
+	involvedObject: #ObjectReference @go(InvolvedObject) @protobuf(2,bytes,opt)
+
+	// This should be a short, machine understandable string that gives the reason
+	// for the transition into the object's current status.
+	// TODO: provide exact specification for format.
+	// +optional
+	reason?: string @go(Reason) @protobuf(3,bytes,opt)
+
+	// A human-readable description of the status of this operation.
+	// TODO: decide on maximum length.
+	// +optional
+	message?: string @go(Message) @protobuf(4,bytes,opt)
+
+	// The component reporting this event. Should be a short machine understandable string.
+	// +optional
+	source?: #EventSource @go(Source) @protobuf(5,bytes,opt)
+
+	// The time at which the event was first recorded. (Time of server receipt is in TypeMeta.)
+	// +optional
+	firstTimestamp?: metav1.#Time @go(FirstTimestamp) @protobuf(6,bytes,opt)
+
+	// The time at which the most recent occurrence of this event was recorded.
+	// +optional
+	lastTimestamp?: metav1.#Time @go(LastTimestamp) @protobuf(7,bytes,opt)
+
+	// The number of times this event has occurred.
+	// +optional
+	count?: int32 @go(Count) @protobuf(8,varint,opt)
+
+	// Type of this event (Normal, Warning), new types could be added in the future
+	// +optional
+	type?: string @go(Type) @protobuf(9,bytes,opt)
+
+	// Time when this Event was first observed.
+	// +optional
+	eventTime?: metav1.#MicroTime @go(EventTime) @protobuf(10,bytes,opt)
+
+	// Data about the Event series this event represents or nil if it's a singleton Event.
+	// +optional
+	series?: #EventSeries @go(Series,*EventSeries) @protobuf(11,bytes,opt)
+
+	// What action was taken/failed regarding to the Regarding object.
+	// +optional
+	action?: string @go(Action) @protobuf(12,bytes,opt)
+
+	// Optional secondary object for more complex actions.
+	// +optional
+	related?: #ObjectReference @go(Related,*ObjectReference) @protobuf(13,bytes,opt)
+
+	// Name of the controller that emitted this Event, e.g. `kubernetes.io/kubelet`.
+	// +optional
+	reportingComponent?: string @go(ReportingController) @protobuf(14,bytes,opt)
+
+	// ID of the controller instance, e.g. `kubelet-xyzf`.
+	// +optional
+	reportingInstance?: string @go(ReportingInstance) @protobuf(15,bytes,opt)
+}
+
+// EventSeries contain information on series of events, i.e. thing that was/is happening
+// continuously for some time.
+#EventSeries: {
+	// Number of occurrences in this series up to the last heartbeat time
+	count?: int32 @go(Count) @protobuf(1,varint)
+
+	// Time of the last occurrence observed
+	lastObservedTime?: metav1.#MicroTime @go(LastObservedTime) @protobuf(2,bytes)
+}
+
+// EventList is a list of events.
+#EventList: {
+	metav1.#TypeMeta
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metadata?: metav1.#ListMeta @go(ListMeta) @protobuf(1,bytes,opt)
+
+	// List of events
+	items: [...#Event] @go(Items,[]Event) @protobuf(2,bytes,rep)
+}
+
+// List holds a list of objects, which may not be known by the server.
+#List: metav1.#List
+
+// LimitType is a type of object that is limited. It can be Pod, Container, PersistentVolumeClaim or
+// a fully qualified resource name.
+#LimitType: string // #enumLimitType
+
+#enumLimitType:
+	#LimitTypePod |
+	#LimitTypeContainer |
+	#LimitTypePersistentVolumeClaim
+
+// Limit that applies to all pods in a namespace
+#LimitTypePod: #LimitType & "Pod"
+
+// Limit that applies to all containers in a namespace
+#LimitTypeContainer: #LimitType & "Container"
+
+// Limit that applies to all persistent volume claims in a namespace
+#LimitTypePersistentVolumeClaim: #LimitType & "PersistentVolumeClaim"
+
+// LimitRangeItem defines a min/max usage limit for any resource that matches on kind.
+#LimitRangeItem: {
+	// Type of resource that this limit applies to.
+	type: #LimitType @go(Type) @protobuf(1,bytes,opt,casttype=LimitType)
+
+	// Max usage constraints on this kind by resource name.
+	// +optional
+	max?: #ResourceList @go(Max) @protobuf(2,bytes,rep,casttype=ResourceList,castkey=ResourceName)
+
+	// Min usage constraints on this kind by resource name.
+	// +optional
+	min?: #ResourceList @go(Min) @protobuf(3,bytes,rep,casttype=ResourceList,castkey=ResourceName)
+
+	// Default resource requirement limit value by resource name if resource limit is omitted.
+	// +optional
+	default?: #ResourceList @go(Default) @protobuf(4,bytes,rep,casttype=ResourceList,castkey=ResourceName)
+
+	// DefaultRequest is the default resource requirement request value by resource name if resource request is omitted.
+	// +optional
+	defaultRequest?: #ResourceList @go(DefaultRequest) @protobuf(5,bytes,rep,casttype=ResourceList,castkey=ResourceName)
+
+	// MaxLimitRequestRatio if specified, the named resource must have a request and limit that are both non-zero where limit divided by request is less than or equal to the enumerated value; this represents the max burst for the named resource.
+	// +optional
+	maxLimitRequestRatio?: #ResourceList @go(MaxLimitRequestRatio) @protobuf(6,bytes,rep,casttype=ResourceList,castkey=ResourceName)
+}
+
+// LimitRangeSpec defines a min/max usage limit for resources that match on kind.
+#LimitRangeSpec: {
+	// Limits is the list of LimitRangeItem objects that are enforced.
+	// +listType=atomic
+	limits: [...#LimitRangeItem] @go(Limits,[]LimitRangeItem) @protobuf(1,bytes,rep)
+}
+
+// LimitRange sets resource usage limits for each kind of resource in a Namespace.
+#LimitRange: {
+	metav1.#TypeMeta
+
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metadata?: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// Spec defines the limits enforced.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	spec?: #LimitRangeSpec @go(Spec) @protobuf(2,bytes,opt)
+}
+
+// LimitRangeList is a list of LimitRange items.
+#LimitRangeList: {
+	metav1.#TypeMeta
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metadata?: metav1.#ListMeta @go(ListMeta) @protobuf(1,bytes,opt)
+
+	// Items is a list of LimitRange objects.
+	// More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+	items: [...#LimitRange] @go(Items,[]LimitRange) @protobuf(2,bytes,rep)
+}
+
+// Pods, number
+#ResourcePods: #ResourceName & "pods"
+
+// Services, number
+#ResourceServices: #ResourceName & "services"
+
+// ReplicationControllers, number
+#ResourceReplicationControllers: #ResourceName & "replicationcontrollers"
+
+// ResourceQuotas, number
+#ResourceQuotas: #ResourceName & "resourcequotas"
+
+// ResourceSecrets, number
+#ResourceSecrets: #ResourceName & "secrets"
+
+// ResourceConfigMaps, number
+#ResourceConfigMaps: #ResourceName & "configmaps"
+
+// ResourcePersistentVolumeClaims, number
+#ResourcePersistentVolumeClaims: #ResourceName & "persistentvolumeclaims"
+
+// ResourceServicesNodePorts, number
+#ResourceServicesNodePorts: #ResourceName & "services.nodeports"
+
+// ResourceServicesLoadBalancers, number
+#ResourceServicesLoadBalancers: #ResourceName & "services.loadbalancers"
+
+// CPU request, in cores. (500m = .5 cores)
+#ResourceRequestsCPU: #ResourceName & "requests.cpu"
+
+// Memory request, in bytes. (500Gi = 500GiB = 500 * 1024 * 1024 * 1024)
+#ResourceRequestsMemory: #ResourceName & "requests.memory"
+
+// Storage request, in bytes
+#ResourceRequestsStorage: #ResourceName & "requests.storage"
+
+// Local ephemeral storage request, in bytes. (500Gi = 500GiB = 500 * 1024 * 1024 * 1024)
+#ResourceRequestsEphemeralStorage: #ResourceName & "requests.ephemeral-storage"
+
+// CPU limit, in cores. (500m = .5 cores)
+#ResourceLimitsCPU: #ResourceName & "limits.cpu"
+
+// Memory limit, in bytes. (500Gi = 500GiB = 500 * 1024 * 1024 * 1024)
+#ResourceLimitsMemory: #ResourceName & "limits.memory"
+
+// Local ephemeral storage limit, in bytes. (500Gi = 500GiB = 500 * 1024 * 1024 * 1024)
+#ResourceLimitsEphemeralStorage: #ResourceName & "limits.ephemeral-storage"
+
+// resource.k8s.io devices requested with a certain DeviceClass, number
+#ResourceClaimsPerClass: ".deviceclass.resource.k8s.io/devices"
+
+// resource.k8s.io devices requested with a certain DeviceClass by implicit extended resource name, number
+#ResourceImplicitExtendedClaimsPerClass: "requests.deviceclass.resource.kubernetes.io/"
+
+// HugePages request, in bytes. (500Gi = 500GiB = 500 * 1024 * 1024 * 1024)
+// As burst is not supported for HugePages, we would only quota its request, and ignore the limit.
+#ResourceRequestsHugePagesPrefix: "requests.hugepages-"
+
+// Default resource requests prefix
+#DefaultResourceRequestsPrefix: "requests."
+
+// A ResourceQuotaScope defines a filter that must match each object tracked by a quota
+// +enum
+#ResourceQuotaScope: string // #enumResourceQuotaScope
+
+#enumResourceQuotaScope:
+	#ResourceQuotaScopeTerminating |
+	#ResourceQuotaScopeNotTerminating |
+	#ResourceQuotaScopeBestEffort |
+	#ResourceQuotaScopeNotBestEffort |
+	#ResourceQuotaScopePriorityClass |
+	#ResourceQuotaScopeCrossNamespacePodAffinity |
+	#ResourceQuotaScopeVolumeAttributesClass
+
+// Match all pod objects where spec.activeDeadlineSeconds >=0
+#ResourceQuotaScopeTerminating: #ResourceQuotaScope & "Terminating"
+
+// Match all pod objects where spec.activeDeadlineSeconds is nil
+#ResourceQuotaScopeNotTerminating: #ResourceQuotaScope & "NotTerminating"
+
+// Match all pod objects that have best effort quality of service
+#ResourceQuotaScopeBestEffort: #ResourceQuotaScope & "BestEffort"
+
+// Match all pod objects that do not have best effort quality of service
+#ResourceQuotaScopeNotBestEffort: #ResourceQuotaScope & "NotBestEffort"
+
+// Match all pod objects that have priority class mentioned
+#ResourceQuotaScopePriorityClass: #ResourceQuotaScope & "PriorityClass"
+
+// Match all pod objects that have cross-namespace pod (anti)affinity mentioned.
+#ResourceQuotaScopeCrossNamespacePodAffinity: #ResourceQuotaScope & "CrossNamespacePodAffinity"
+
+// Match all pvc objects that have volume attributes class mentioned.
+#ResourceQuotaScopeVolumeAttributesClass: #ResourceQuotaScope & "VolumeAttributesClass"
+
+// ResourceQuotaSpec defines the desired hard limits to enforce for Quota.
+#ResourceQuotaSpec: {
+	// hard is the set of desired hard limits for each named resource.
+	// More info: https://kubernetes.io/docs/concepts/policy/resource-quotas/
+	// +optional
+	hard?: #ResourceList @go(Hard) @protobuf(1,bytes,rep,casttype=ResourceList,castkey=ResourceName)
+
+	// A collection of filters that must match each object tracked by a quota.
+	// If not specified, the quota matches all objects.
+	// +optional
+	// +listType=atomic
+	scopes?: [...#ResourceQuotaScope] @go(Scopes,[]ResourceQuotaScope) @protobuf(2,bytes,rep,casttype=ResourceQuotaScope)
+
+	// scopeSelector is also a collection of filters like scopes that must match each object tracked by a quota
+	// but expressed using ScopeSelectorOperator in combination with possible values.
+	// For a resource to match, both scopes AND scopeSelector (if specified in spec), must be matched.
+	// +optional
+	scopeSelector?: #ScopeSelector @go(ScopeSelector,*ScopeSelector) @protobuf(3,bytes,opt)
+}
+
+// A scope selector represents the AND of the selectors represented
+// by the scoped-resource selector requirements.
+// +structType=atomic
+#ScopeSelector: {
+	// A list of scope selector requirements by scope of the resources.
+	// +optional
+	// +listType=atomic
+	matchExpressions?: [...#ScopedResourceSelectorRequirement] @go(MatchExpressions,[]ScopedResourceSelectorRequirement) @protobuf(1,bytes,rep)
+}
+
+// A scoped-resource selector requirement is a selector that contains values, a scope name, and an operator
+// that relates the scope name and values.
+#ScopedResourceSelectorRequirement: {
+	// The name of the scope that the selector applies to.
+	scopeName: #ResourceQuotaScope @go(ScopeName) @protobuf(1,bytes,opt)
+
+	// Represents a scope's relationship to a set of values.
+	// Valid operators are In, NotIn, Exists, DoesNotExist.
+	operator: #ScopeSelectorOperator @go(Operator) @protobuf(2,bytes,opt,casttype=ScopedResourceSelectorOperator)
+
+	// An array of string values. If the operator is In or NotIn,
+	// the values array must be non-empty. If the operator is Exists or DoesNotExist,
+	// the values array must be empty.
+	// This array is replaced during a strategic merge patch.
+	// +optional
+	// +listType=atomic
+	values?: [...string] @go(Values,[]string) @protobuf(3,bytes,rep)
+}
+
+// A scope selector operator is the set of operators that can be used in
+// a scope selector requirement.
+// +enum
+#ScopeSelectorOperator: string // #enumScopeSelectorOperator
+
+#enumScopeSelectorOperator:
+	#ScopeSelectorOpIn |
+	#ScopeSelectorOpNotIn |
+	#ScopeSelectorOpExists |
+	#ScopeSelectorOpDoesNotExist
+
+#ScopeSelectorOpIn:           #ScopeSelectorOperator & "In"
+#ScopeSelectorOpNotIn:        #ScopeSelectorOperator & "NotIn"
+#ScopeSelectorOpExists:       #ScopeSelectorOperator & "Exists"
+#ScopeSelectorOpDoesNotExist: #ScopeSelectorOperator & "DoesNotExist"
+
+// ResourceQuotaStatus defines the enforced hard limits and observed use.
+#ResourceQuotaStatus: {
+	// Hard is the set of enforced hard limits for each named resource.
+	// More info: https://kubernetes.io/docs/concepts/policy/resource-quotas/
+	// +optional
+	hard?: #ResourceList @go(Hard) @protobuf(1,bytes,rep,casttype=ResourceList,castkey=ResourceName)
+
+	// Used is the current observed total usage of the resource in the namespace.
+	// +optional
+	used?: #ResourceList @go(Used) @protobuf(2,bytes,rep,casttype=ResourceList,castkey=ResourceName)
+}
+
+// ResourceQuota sets aggregate quota restrictions enforced per namespace
+#ResourceQuota: {
+	metav1.#TypeMeta
+
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metadata?: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// Spec defines the desired quota.
+	// https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	spec?: #ResourceQuotaSpec @go(Spec) @protobuf(2,bytes,opt)
+
+	// Status defines the actual enforced quota and its current usage.
+	// https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	status?: #ResourceQuotaStatus @go(Status) @protobuf(3,bytes,opt)
+}
+
+// ResourceQuotaList is a list of ResourceQuota items.
+#ResourceQuotaList: {
+	metav1.#TypeMeta
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metadata?: metav1.#ListMeta @go(ListMeta) @protobuf(1,bytes,opt)
+
+	// Items is a list of ResourceQuota objects.
+	// More info: https://kubernetes.io/docs/concepts/policy/resource-quotas/
+	items: [...#ResourceQuota] @go(Items,[]ResourceQuota) @protobuf(2,bytes,rep)
+}
+
+// Secret holds secret data of a certain type. The total bytes of the values in
+// the Data field must be less than MaxSecretSize bytes.
+#Secret: {
+	metav1.#TypeMeta
+
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metadata?: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// Immutable, if set to true, ensures that data stored in the Secret cannot
+	// be updated (only object metadata can be modified).
+	// If not set to true, the field can be modified at any time.
+	// Defaulted to nil.
+	// +optional
+	immutable?: bool @go(Immutable,*bool) @protobuf(5,varint,opt)
+
+	// Data contains the secret data. Each key must consist of alphanumeric
+	// characters, '-', '_' or '.'. The serialized form of the secret data is a
+	// base64 encoded string, representing the arbitrary (possibly non-string)
+	// data value here. Described in https://tools.ietf.org/html/rfc4648#section-4
+	// +optional
+	data?: {[string]: bytes} @go(Data,map[string][]byte) @protobuf(2,bytes,rep)
+
+	// stringData allows specifying non-binary secret data in string form.
+	// It is provided as a write-only input field for convenience.
+	// All keys and values are merged into the data field on write, overwriting any existing values.
+	// The stringData field is never output when reading from the API.
+	// +k8s:conversion-gen=false
+	// +optional
+	stringData?: {[string]: string} @go(StringData,map[string]string) @protobuf(4,bytes,rep)
+
+	// Used to facilitate programmatic handling of secret data.
+	// More info: https://kubernetes.io/docs/concepts/configuration/secret/#secret-types
+	// +optional
+	type?: #SecretType @go(Type) @protobuf(3,bytes,opt,casttype=SecretType)
+}
+
+#MaxSecretSize: 1048576
+
+#SecretType: string // #enumSecretType
+
+#enumSecretType:
+	#SecretTypeOpaque |
+	#SecretTypeServiceAccountToken |
+	#SecretTypeDockercfg |
+	#SecretTypeDockerConfigJson |
+	#SecretTypeBasicAuth |
+	#SecretTypeSSHAuth |
+	#SecretTypeTLS |
+	#SecretTypeBootstrapToken
+
+// SecretTypeOpaque is the default. Arbitrary user-defined data
+#SecretTypeOpaque: #SecretType & "Opaque"
+
+// SecretTypeServiceAccountToken contains a token that identifies a service account to the API
+//
+// Required fields:
+// - Secret.Annotations["kubernetes.io/service-account.name"] - the name of the ServiceAccount the token identifies
+// - Secret.Annotations["kubernetes.io/service-account.uid"] - the UID of the ServiceAccount the token identifies
+// - Secret.Data["token"] - a token that identifies the service account to the API
+#SecretTypeServiceAccountToken: #SecretType & "kubernetes.io/service-account-token"
+
+// ServiceAccountNameKey is the key of the required annotation for SecretTypeServiceAccountToken secrets
+#ServiceAccountNameKey: "kubernetes.io/service-account.name"
+
+// ServiceAccountUIDKey is the key of the required annotation for SecretTypeServiceAccountToken secrets
+#ServiceAccountUIDKey: "kubernetes.io/service-account.uid"
+
+// ServiceAccountTokenKey is the key of the required data for SecretTypeServiceAccountToken secrets
+#ServiceAccountTokenKey: "token"
+
+// ServiceAccountKubeconfigKey is the key of the optional kubeconfig data for SecretTypeServiceAccountToken secrets
+#ServiceAccountKubeconfigKey: "kubernetes.kubeconfig"
+
+// ServiceAccountRootCAKey is the key of the optional root certificate authority for SecretTypeServiceAccountToken secrets
+#ServiceAccountRootCAKey: "ca.crt"
+
+// ServiceAccountNamespaceKey is the key of the optional namespace to use as the default for namespaced API calls
+#ServiceAccountNamespaceKey: "namespace"
+
+// SecretTypeDockercfg contains a dockercfg file that follows the same format rules as ~/.dockercfg
+//
+// Required fields:
+// - Secret.Data[".dockercfg"] - a serialized ~/.dockercfg file
+#SecretTypeDockercfg: #SecretType & "kubernetes.io/dockercfg"
+
+// DockerConfigKey is the key of the required data for SecretTypeDockercfg secrets
+#DockerConfigKey: ".dockercfg"
+
+// SecretTypeDockerConfigJson contains a dockercfg file that follows the same format rules as ~/.docker/config.json
+//
+// Required fields:
+// - Secret.Data[".dockerconfigjson"] - a serialized ~/.docker/config.json file
+#SecretTypeDockerConfigJson: #SecretType & "kubernetes.io/dockerconfigjson"
+
+// DockerConfigJsonKey is the key of the required data for SecretTypeDockerConfigJson secrets
+#DockerConfigJsonKey: ".dockerconfigjson"
+
+// SecretTypeBasicAuth contains data needed for basic authentication.
+//
+// Required at least one of fields:
+// - Secret.Data["username"] - username used for authentication
+// - Secret.Data["password"] - password or token needed for authentication
+#SecretTypeBasicAuth: #SecretType & "kubernetes.io/basic-auth"
+
+// BasicAuthUsernameKey is the key of the username for SecretTypeBasicAuth secrets
+#BasicAuthUsernameKey: "username"
+
+// BasicAuthPasswordKey is the key of the password or token for SecretTypeBasicAuth secrets
+#BasicAuthPasswordKey: "password"
+
+// SecretTypeSSHAuth contains data needed for SSH authetication.
+//
+// Required field:
+// - Secret.Data["ssh-privatekey"] - private SSH key needed for authentication
+#SecretTypeSSHAuth: #SecretType & "kubernetes.io/ssh-auth"
+
+// SSHAuthPrivateKey is the key of the required SSH private key for SecretTypeSSHAuth secrets
+#SSHAuthPrivateKey: "ssh-privatekey"
+
+// SecretTypeTLS contains information about a TLS client or server secret. It
+// is primarily used with TLS termination of the Ingress resource, but may be
+// used in other types.
+//
+// Required fields:
+// - Secret.Data["tls.key"] - TLS private key.
+//   Secret.Data["tls.crt"] - TLS certificate.
+// TODO: Consider supporting different formats, specifying CA/destinationCA.
+#SecretTypeTLS: #SecretType & "kubernetes.io/tls"
+
+// TLSCertKey is the key for tls certificates in a TLS secret.
+#TLSCertKey: "tls.crt"
+
+// TLSPrivateKeyKey is the key for the private key field in a TLS secret.
+#TLSPrivateKeyKey: "tls.key"
+
+// SecretTypeBootstrapToken is used during the automated bootstrap process (first
+// implemented by kubeadm). It stores tokens that are used to sign well known
+// ConfigMaps. They are used for authn.
+#SecretTypeBootstrapToken: #SecretType & "bootstrap.kubernetes.io/token"
+
+// SecretList is a list of Secret.
+#SecretList: {
+	metav1.#TypeMeta
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metadata?: metav1.#ListMeta @go(ListMeta) @protobuf(1,bytes,opt)
+
+	// Items is a list of secret objects.
+	// More info: https://kubernetes.io/docs/concepts/configuration/secret
+	items: [...#Secret] @go(Items,[]Secret) @protobuf(2,bytes,rep)
+}
+
+// ConfigMap holds configuration data for pods to consume.
+#ConfigMap: {
+	metav1.#TypeMeta
+
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metadata?: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// Immutable, if set to true, ensures that data stored in the ConfigMap cannot
+	// be updated (only object metadata can be modified).
+	// If not set to true, the field can be modified at any time.
+	// Defaulted to nil.
+	// +optional
+	immutable?: bool @go(Immutable,*bool) @protobuf(4,varint,opt)
+
+	// Data contains the configuration data.
+	// Each key must consist of alphanumeric characters, '-', '_' or '.'.
+	// Values with non-UTF-8 byte sequences must use the BinaryData field.
+	// The keys stored in Data must not overlap with the keys in
+	// the BinaryData field, this is enforced during validation process.
+	// +optional
+	data?: {[string]: string} @go(Data,map[string]string) @protobuf(2,bytes,rep)
+
+	// BinaryData contains the binary data.
+	// Each key must consist of alphanumeric characters, '-', '_' or '.'.
+	// BinaryData can contain byte sequences that are not in the UTF-8 range.
+	// The keys stored in BinaryData must not overlap with the ones in
+	// the Data field, this is enforced during validation process.
+	// Using this field will require 1.10+ apiserver and
+	// kubelet.
+	// +optional
+	binaryData?: {[string]: bytes} @go(BinaryData,map[string][]byte) @protobuf(3,bytes,rep)
+}
+
+// ConfigMapList is a resource containing a list of ConfigMap objects.
+#ConfigMapList: {
+	metav1.#TypeMeta
+
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metadata?: metav1.#ListMeta @go(ListMeta) @protobuf(1,bytes,opt)
+
+	// Items is the list of ConfigMaps.
+	items: [...#ConfigMap] @go(Items,[]ConfigMap) @protobuf(2,bytes,rep)
+}
+
+// Type and constants for component health validation.
+#ComponentConditionType: string // #enumComponentConditionType
+
+#enumComponentConditionType:
+	#ComponentHealthy
+
+#ComponentHealthy: #ComponentConditionType & "Healthy"
+
+// Information about the condition of a component.
+#ComponentCondition: {
+	// Type of condition for a component.
+	// Valid value: "Healthy"
+	type: #ComponentConditionType @go(Type) @protobuf(1,bytes,opt,casttype=ComponentConditionType)
+
+	// Status of the condition for a component.
+	// Valid values for "Healthy": "True", "False", or "Unknown".
+	status: #ConditionStatus @go(Status) @protobuf(2,bytes,opt,casttype=ConditionStatus)
+
+	// Message about the condition for a component.
+	// For example, information about a health check.
+	// +optional
+	message?: string @go(Message) @protobuf(3,bytes,opt)
+
+	// Condition error code for a component.
+	// For example, a health check error code.
+	// +optional
+	error?: string @go(Error) @protobuf(4,bytes,opt)
+}
+
+// ComponentStatus (and ComponentStatusList) holds the cluster validation info.
+// Deprecated: This API is deprecated in v1.19+
+#ComponentStatus: {
+	metav1.#TypeMeta
+
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metadata?: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// List of component conditions observed
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	conditions?: [...#ComponentCondition] @go(Conditions,[]ComponentCondition) @protobuf(2,bytes,rep)
+}
+
+// Status of all the conditions for the component as a list of ComponentStatus objects.
+// Deprecated: This API is deprecated in v1.19+
+#ComponentStatusList: {
+	metav1.#TypeMeta
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metadata?: metav1.#ListMeta @go(ListMeta) @protobuf(1,bytes,opt)
+
+	// List of ComponentStatus objects.
+	items: [...#ComponentStatus] @go(Items,[]ComponentStatus) @protobuf(2,bytes,rep)
+}
+
+// DownwardAPIVolumeSource represents a volume containing downward API info.
+// Downward API volumes support ownership management and SELinux relabeling.
+#DownwardAPIVolumeSource: {
+	// Items is a list of downward API volume file
+	// +optional
+	// +listType=atomic
+	items?: [...#DownwardAPIVolumeFile] @go(Items,[]DownwardAPIVolumeFile) @protobuf(1,bytes,rep)
+
+	// Optional: mode bits to use on created files by default. Must be a
+	// Optional: mode bits used to set permissions on created files by default.
+	// Must be an octal value between 0000 and 0777 or a decimal value between 0 and 511.
+	// YAML accepts both octal and decimal values, JSON requires decimal values for mode bits.
+	// Defaults to 0644.
+	// Directories within the path are not affected by this setting.
+	// This might be in conflict with other options that affect the file
+	// mode, like fsGroup, and the result can be other mode bits set.
+	// +optional
+	defaultMode?: int32 @go(DefaultMode,*int32) @protobuf(2,varint,opt)
+}
+
+#DownwardAPIVolumeSourceDefaultMode: int32 & 0o644
+
+// DownwardAPIVolumeFile represents information to create the file containing the pod field
+#DownwardAPIVolumeFile: {
+	// Required: Path is  the relative path name of the file to be created. Must not be absolute or contain the '..' path. Must be utf-8 encoded. The first item of the relative path must not start with '..'
+	path: string @go(Path) @protobuf(1,bytes,opt)
+
+	// Required: Selects a field of the pod: only annotations, labels, name, namespace and uid are supported.
+	// +optional
+	fieldRef?: #ObjectFieldSelector @go(FieldRef,*ObjectFieldSelector) @protobuf(2,bytes,opt)
+
+	// Selects a resource of the container: only resources limits and requests
+	// (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported.
+	// +optional
+	resourceFieldRef?: #ResourceFieldSelector @go(ResourceFieldRef,*ResourceFieldSelector) @protobuf(3,bytes,opt)
+
+	// Optional: mode bits used to set permissions on this file, must be an octal value
+	// between 0000 and 0777 or a decimal value between 0 and 511.
+	// YAML accepts both octal and decimal values, JSON requires decimal values for mode bits.
+	// If not specified, the volume defaultMode will be used.
+	// This might be in conflict with other options that affect the file
+	// mode, like fsGroup, and the result can be other mode bits set.
+	// +optional
+	mode?: int32 @go(Mode,*int32) @protobuf(4,varint,opt)
+}
+
+// Represents downward API info for projecting into a projected volume.
+// Note that this is identical to a downwardAPI volume source without the default
+// mode.
+#DownwardAPIProjection: {
+	// Items is a list of DownwardAPIVolume file
+	// +optional
+	// +listType=atomic
+	items?: [...#DownwardAPIVolumeFile] @go(Items,[]DownwardAPIVolumeFile) @protobuf(1,bytes,rep)
+}
+
+// SecurityContext holds security configuration that will be applied to a container.
+// Some fields are present in both SecurityContext and PodSecurityContext.  When both
+// are set, the values in SecurityContext take precedence.
+#SecurityContext: {
+	// The capabilities to add/drop when running containers.
+	// Defaults to the default set of capabilities granted by the container runtime.
+	// Note that this field cannot be set when spec.os.name is windows.
+	// +optional
+	capabilities?: #Capabilities @go(Capabilities,*Capabilities) @protobuf(1,bytes,opt)
+
+	// Run container in privileged mode.
+	// Processes in privileged containers are essentially equivalent to root on the host.
+	// Defaults to false.
+	// Note that this field cannot be set when spec.os.name is windows.
+	// +optional
+	privileged?: bool @go(Privileged,*bool) @protobuf(2,varint,opt)
+
+	// The SELinux context to be applied to the container.
+	// If unspecified, the container runtime will allocate a random SELinux context for each
+	// container.  May also be set in PodSecurityContext.  If set in both SecurityContext and
+	// PodSecurityContext, the value specified in SecurityContext takes precedence.
+	// Note that this field cannot be set when spec.os.name is windows.
+	// +optional
+	seLinuxOptions?: #SELinuxOptions @go(SELinuxOptions,*SELinuxOptions) @protobuf(3,bytes,opt)
+
+	// The Windows specific settings applied to all containers.
+	// If unspecified, the options from the PodSecurityContext will be used.
+	// If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence.
+	// Note that this field cannot be set when spec.os.name is linux.
+	// +optional
+	windowsOptions?: #WindowsSecurityContextOptions @go(WindowsOptions,*WindowsSecurityContextOptions) @protobuf(10,bytes,opt)
+
+	// The UID to run the entrypoint of the container process.
+	// Defaults to user specified in image metadata if unspecified.
+	// May also be set in PodSecurityContext.  If set in both SecurityContext and
+	// PodSecurityContext, the value specified in SecurityContext takes precedence.
+	// Note that this field cannot be set when spec.os.name is windows.
+	// +optional
+	runAsUser?: int64 @go(RunAsUser,*int64) @protobuf(4,varint,opt)
+
+	// The GID to run the entrypoint of the container process.
+	// Uses runtime default if unset.
+	// May also be set in PodSecurityContext.  If set in both SecurityContext and
+	// PodSecurityContext, the value specified in SecurityContext takes precedence.
+	// Note that this field cannot be set when spec.os.name is windows.
+	// +optional
+	runAsGroup?: int64 @go(RunAsGroup,*int64) @protobuf(8,varint,opt)
+
+	// Indicates that the container must run as a non-root user.
+	// If true, the Kubelet will validate the image at runtime to ensure that it
+	// does not run as UID 0 (root) and fail to start the container if it does.
+	// If unset or false, no such validation will be performed.
+	// May also be set in PodSecurityContext.  If set in both SecurityContext and
+	// PodSecurityContext, the value specified in SecurityContext takes precedence.
+	// +optional
+	runAsNonRoot?: bool @go(RunAsNonRoot,*bool) @protobuf(5,varint,opt)
+
+	// Whether this container has a read-only root filesystem.
+	// Default is false.
+	// Note that this field cannot be set when spec.os.name is windows.
+	// +optional
+	readOnlyRootFilesystem?: bool @go(ReadOnlyRootFilesystem,*bool) @protobuf(6,varint,opt)
+
+	// AllowPrivilegeEscalation controls whether a process can gain more
+	// privileges than its parent process. This bool directly controls if
+	// the no_new_privs flag will be set on the container process.
+	// AllowPrivilegeEscalation is true always when the container is:
+	// 1) run as Privileged
+	// 2) has CAP_SYS_ADMIN
+	// Note that this field cannot be set when spec.os.name is windows.
+	// +optional
+	allowPrivilegeEscalation?: bool @go(AllowPrivilegeEscalation,*bool) @protobuf(7,varint,opt)
+
+	// procMount denotes the type of proc mount to use for the containers.
+	// The default value is Default which uses the container runtime defaults for
+	// readonly paths and masked paths.
+	// Note that this field cannot be set when spec.os.name is windows.
+	// +optional
+	procMount?: #ProcMountType @go(ProcMount,*ProcMountType) @protobuf(9,bytes,opt)
+
+	// The seccomp options to use by this container. If seccomp options are
+	// provided at both the pod & container level, the container options
+	// override the pod options.
+	// Note that this field cannot be set when spec.os.name is windows.
+	// +optional
+	seccompProfile?: #SeccompProfile @go(SeccompProfile,*SeccompProfile) @protobuf(11,bytes,opt)
+
+	// appArmorProfile is the AppArmor options to use by this container. If set, this profile
+	// overrides the pod's appArmorProfile.
+	// Note that this field cannot be set when spec.os.name is windows.
+	// +optional
+	appArmorProfile?: #AppArmorProfile @go(AppArmorProfile,*AppArmorProfile) @protobuf(12,bytes,opt)
+}
+
+// +enum
+#ProcMountType: string // #enumProcMountType
+
+#enumProcMountType:
+	#DefaultProcMount |
+	#UnmaskedProcMount
+
+// DefaultProcMount uses the container runtime defaults for readonly and masked
+// paths for /proc.  Most container runtimes mask certain paths in /proc to avoid
+// accidental security exposure of special devices or information.
+#DefaultProcMount: #ProcMountType & "Default"
+
+// UnmaskedProcMount bypasses the default masking behavior of the container
+// runtime and ensures the newly created /proc the container stays in tact with
+// no modifications.
+#UnmaskedProcMount: #ProcMountType & "Unmasked"
+
+// SELinuxOptions are the labels to be applied to the container
+#SELinuxOptions: {
+	// User is a SELinux user label that applies to the container.
+	// +optional
+	user?: string @go(User) @protobuf(1,bytes,opt)
+
+	// Role is a SELinux role label that applies to the container.
+	// +optional
+	role?: string @go(Role) @protobuf(2,bytes,opt)
+
+	// Type is a SELinux type label that applies to the container.
+	// +optional
+	type?: string @go(Type) @protobuf(3,bytes,opt)
+
+	// Level is SELinux level label that applies to the container.
+	// +optional
+	level?: string @go(Level) @protobuf(4,bytes,opt)
+}
+
+// WindowsSecurityContextOptions contain Windows-specific options and credentials.
+#WindowsSecurityContextOptions: {
+	// GMSACredentialSpecName is the name of the GMSA credential spec to use.
+	// +optional
+	gmsaCredentialSpecName?: string @go(GMSACredentialSpecName,*string) @protobuf(1,bytes,opt)
+
+	// GMSACredentialSpec is where the GMSA admission webhook
+	// (https://github.com/kubernetes-sigs/windows-gmsa) inlines the contents of the
+	// GMSA credential spec named by the GMSACredentialSpecName field.
+	// +optional
+	gmsaCredentialSpec?: string @go(GMSACredentialSpec,*string) @protobuf(2,bytes,opt)
+
+	// The UserName in Windows to run the entrypoint of the container process.
+	// Defaults to the user specified in image metadata if unspecified.
+	// May also be set in PodSecurityContext. If set in both SecurityContext and
+	// PodSecurityContext, the value specified in SecurityContext takes precedence.
+	// +optional
+	runAsUserName?: string @go(RunAsUserName,*string) @protobuf(3,bytes,opt)
+
+	// HostProcess determines if a container should be run as a 'Host Process' container.
+	// All of a Pod's containers must have the same effective HostProcess value
+	// (it is not allowed to have a mix of HostProcess containers and non-HostProcess containers).
+	// In addition, if HostProcess is true then HostNetwork must also be set to true.
+	// +optional
+	hostProcess?: bool @go(HostProcess,*bool) @protobuf(4,bytes,opt)
+}
+
+// RangeAllocation is not a public type.
+#RangeAllocation: {
+	metav1.#TypeMeta
+
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metadata?: metav1.#ObjectMeta @go(ObjectMeta) @protobuf(1,bytes,opt)
+
+	// Range is string that identifies the range represented by 'data'.
+	range: string @go(Range) @protobuf(2,bytes,opt)
+
+	// Data is a bit array containing all allocated addresses in the previous segment.
+	data: bytes @go(Data,[]byte) @protobuf(3,bytes,opt)
+}
+
+// DefaultSchedulerName defines the name of default scheduler.
+#DefaultSchedulerName: "default-scheduler"
+
+// RequiredDuringScheduling affinity is not symmetric, but there is an implicit PreferredDuringScheduling affinity rule
+// corresponding to every RequiredDuringScheduling affinity rule.
+// When the --hard-pod-affinity-weight scheduler flag is not specified,
+// DefaultHardPodAffinityWeight defines the weight of the implicit PreferredDuringScheduling affinity rule.
+#DefaultHardPodAffinitySymmetricWeight: int32 & 1
+
+// Sysctl defines a kernel parameter to be set
+#Sysctl: {
+	// Name of a property to set
+	name: string @go(Name) @protobuf(1,bytes,opt)
+
+	// Value of a property to set
+	value: string @go(Value) @protobuf(2,bytes,opt)
+}
+
+// Enable stdin for remote command execution
+#ExecStdinParam: "input"
+
+// Enable stdout for remote command execution
+#ExecStdoutParam: "output"
+
+// Enable stderr for remote command execution
+#ExecStderrParam: "error"
+
+// Enable TTY for remote command execution
+#ExecTTYParam: "tty"
+
+// Command to run for remote command execution
+#ExecCommandParam: "command"
+
+// Name of header that specifies stream type
+#StreamType: "streamType"
+
+// Value for streamType header for stdin stream
+#StreamTypeStdin: "stdin"
+
+// Value for streamType header for stdout stream
+#StreamTypeStdout: "stdout"
+
+// Value for streamType header for stderr stream
+#StreamTypeStderr: "stderr"
+
+// Value for streamType header for data stream
+#StreamTypeData: "data"
+
+// Value for streamType header for error stream
+#StreamTypeError: "error"
+
+// Value for streamType header for terminal resize stream
+#StreamTypeResize: "resize"
+
+// Name of header that specifies the port being forwarded
+#PortHeader: "port"
+
+// Name of header that specifies a request ID used to associate the error
+// and data streams for a single forwarded connection
+#PortForwardRequestIDHeader: "requestID"
+
+// MixedProtocolNotSupported error in PortStatus means that the cloud provider
+// can't publish the port on the load balancer because mixed values of protocols
+// on the same LoadBalancer type of Service are not supported by the cloud provider.
+#MixedProtocolNotSupported: "MixedProtocolNotSupported"
+
+// PortStatus represents the error condition of a service port
+#PortStatus: {
+	// Port is the port number of the service port of which status is recorded here
+	port: int32 @go(Port) @protobuf(1,varint,opt)
+
+	// Protocol is the protocol of the service port of which status is recorded here
+	// The supported values are: "TCP", "UDP", "SCTP"
+	protocol: #Protocol @go(Protocol) @protobuf(2,bytes,opt,casttype=Protocol)
+
+	// Error is to record the problem with the service port
+	// The format of the error shall comply with the following rules:
+	// - built-in error values shall be specified in this file and those shall use
+	//   CamelCase names
+	// - cloud provider specific error values must have names that comply with the
+	//   format foo.example.com/CamelCase.
+	// ---
+	// The regex it matches is (dns1123SubdomainFmt/)?(qualifiedNameFmt)
+	// +optional
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])$`
+	// +kubebuilder:validation:MaxLength=316
+	error?: string @go(Error,*string) @protobuf(3,bytes,opt)
+}
+
+// LoadBalancerIPMode represents the mode of the LoadBalancer ingress IP
+#LoadBalancerIPMode: string // #enumLoadBalancerIPMode
+
+#enumLoadBalancerIPMode:
+	#LoadBalancerIPModeVIP |
+	#LoadBalancerIPModeProxy
+
+// LoadBalancerIPModeVIP indicates that traffic is delivered to the node with
+// the destination set to the load-balancer's IP and port.
+#LoadBalancerIPModeVIP: #LoadBalancerIPMode & "VIP"
+
+// LoadBalancerIPModeProxy indicates that traffic is delivered to the node or pod with
+// the destination set to the node's IP and port or the pod's IP and port.
+#LoadBalancerIPModeProxy: #LoadBalancerIPMode & "Proxy"
+
+// ImageVolumeSource represents a image volume resource.
+#ImageVolumeSource: {
+	// Required: Image or artifact reference to be used.
+	// Behaves in the same way as pod.spec.containers[*].image.
+	// Pull secrets will be assembled in the same way as for the container image by looking up node credentials, SA image pull secrets, and pod spec image pull secrets.
+	// More info: https://kubernetes.io/docs/concepts/containers/images
+	// This field is optional to allow higher level config management to default or override
+	// container images in workload controllers like Deployments and StatefulSets.
+	// +optional
+	reference?: string @go(Reference) @protobuf(1,bytes,opt)
+
+	// Policy for pulling OCI objects. Possible values are:
+	// Always: the kubelet always attempts to pull the reference. Container creation will fail If the pull fails.
+	// Never: the kubelet never pulls the reference and only uses a local image or artifact. Container creation will fail if the reference isn't present.
+	// IfNotPresent: the kubelet pulls if the reference isn't already present on disk. Container creation will fail if the reference isn't present and the pull fails.
+	// Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
+	// +optional
+	pullPolicy?: #PullPolicy @go(PullPolicy) @protobuf(2,bytes,opt,casttype=PullPolicy)
+}
+
+// NodeAllocatableResourceClaimStatus describes the status of node allocatable resources allocated via DRA.
+#NodeAllocatableResourceClaimStatus: {
+	// ResourceClaimName is the resource claim referenced by the pod that resulted in this node allocatable resource allocation.
+	// +required
+	resourceClaimName: string @go(ResourceClaimName) @protobuf(1,bytes,opt)
+
+	// Containers lists the names of all containers in this pod that reference the claim.
+	// +optional
+	// +listType=set
+	containers?: [...string] @go(Containers,[]string) @protobuf(2,bytes,rep)
+
+	// Resources is a map of the node-allocatable resource name to the aggregate quantity allocated to the claim.
+	// +required
+	resources: {[string]: resource.#Quantity} @go(Resources,map[ResourceName]resource.Quantity) @protobuf(3,bytes,rep)
+}
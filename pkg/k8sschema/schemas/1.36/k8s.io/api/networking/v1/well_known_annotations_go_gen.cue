@@ -0,0 +1,11 @@
+// Code generated by cue get go. DO NOT EDIT.
+
+//cue:generate cue get go k8s.io/api/networking/v1
+
+package v1
+
+// AnnotationIsDefaultIngressClass can be used to indicate that an
+// IngressClass should be considered default. When a single IngressClass
+// resource has this annotation set to true, new Ingress resources without a
+// class specified will be assigned this default class.
+#AnnotationIsDefaultIngressClass: "ingressclass.kubernetes.io/is-default-class"
@@ -0,0 +1,19 @@
+// Code generated by cue get go. DO NOT EDIT.
+
+//cue:generate cue get go k8s.io/apimachinery/pkg/apis/meta/v1
+
+package v1
+
+// fieldsV1InvalidOrEmpty indicates that a FieldsV1 either contains no raw bytes or its raw
+// bytes don't represent an allowable value in any supported encoding.
+_#fieldsV1InvalidOrEmpty: 0
+
+// fieldsV1InvalidOrValidJSONObject indicates that a FieldV1 either contains raw bytes that
+// are a valid JSON encoding of an allowable value or don't represent an allowable value in
+// any supported encoding.
+_#fieldsV1InvalidOrValidJSONObject: 1
+
+// fieldsV1InvalidOrValidCBORObject indicates that a FieldV1 either contains raw bytes that
+// are a valid CBOR encoding of an allowable value or don't represent an allowable value in
+// any supported encoding.
+_#fieldsV1InvalidOrValidCBORObject: 2
@@ -0,0 +1,32 @@
+// Code generated by cue get go. DO NOT EDIT.
+
+//cue:generate cue get go k8s.io/apimachinery/pkg/util/intstr
+
+package intstr
+
+// IntOrString is a type that can hold an int32 or a string.  When used in
+// JSON or YAML marshalling and unmarshalling, it produces or consumes the
+// inner type.  This allows you to have, for example, a JSON field that can
+// accept a name or number.
+// TODO: Rename to Int32OrString
+//
+// +protobuf=true
+// +protobuf.options.(gogoproto.goproto_stringer)=false
+// +k8s:openapi-gen=true
+// +k8s:openapi-model-package=io.k8s.apimachinery.pkg.util.intstr
+#IntOrString: _
+
+// Type represents the stored type of IntOrString.
+#Type: int64 // #enumType
+
+#enumType:
+	#Int |
+	#String
+
+#values_Type: {
+	Int:    #Int
+	String: #String
+}
+
+#Int:    #Type & 0
+#String: #Type & 1
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/go-logr/logr"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"oras.land/oras-go/v2"
 	"oras.land/oras-go/v2/content/file"
 	"oras.land/oras-go/v2/registry"
@@ -14,16 +15,23 @@ import (
 
 // FetchFromOCIRegistry fetches an artifact from an OCI registry and stores it in the specified working directory.
 func FetchFromOCIRegistry(ctx context.Context, client remote.Client, workingDir string, ref registry.Reference, plainHTTP bool) error {
+	_, err := FetchDescriptorFromOCIRegistry(ctx, client, workingDir, ref, plainHTTP)
+	return err
+}
+
+// FetchDescriptorFromOCIRegistry fetches an artifact from an OCI registry and stores it in the specified working directory.
+// It returns the descriptor of the fetched artifact, whose digest identifies the exact content that was fetched.
+func FetchDescriptorFromOCIRegistry(ctx context.Context, client remote.Client, workingDir string, ref registry.Reference, plainHTTP bool) (ocispec.Descriptor, error) {
 	log := logr.FromContextOrDiscard(ctx).V(4)
 
 	fs, err := file.New(workingDir)
 	if err != nil {
-		return fmt.Errorf("failed to create file store: %w", err)
+		return ocispec.Descriptor{}, fmt.Errorf("failed to create file store: %w", err)
 	}
 
 	repository, err := remote.NewRepository(ref.String())
 	if err != nil {
-		return err
+		return ocispec.Descriptor{}, err
 	}
 
 	if client != nil {
@@ -33,7 +41,7 @@ func FetchFromOCIRegistry(ctx context.Context, client remote.Client, workingDir
 
 	desc, err := oras.Copy(ctx, repository, ref.Reference, fs, ref.Reference, oras.DefaultCopyOptions)
 	if err != nil {
-		return err
+		return ocispec.Descriptor{}, err
 	}
 
 	log.Info("fetched artifact from OCI registry",
@@ -45,5 +53,5 @@ func FetchFromOCIRegistry(ctx context.Context, client remote.Client, workingDir
 		"mediaType", desc.MediaType,
 	)
 
-	return nil
+	return desc, nil
 }
@@ -82,9 +82,10 @@ func Test_FetchFromRegistry(t *testing.T) {
 			require.NoError(t, err, "failed to parse OCI reference")
 
 			// Fetch the module from the registry
-			err = FetchFromOCIRegistry(ctx, tc.client, tempDir, ref, tc.plainHTTP)
+			desc, err := FetchDescriptorFromOCIRegistry(ctx, tc.client, tempDir, ref, tc.plainHTTP)
 			if !tc.shouldError {
 				require.NoError(t, err, "failed to fetch module from OCI registry")
+				require.NotEmpty(t, desc.Digest, "fetched artifact should have a digest")
 				// verify that tempDir contains the expected files
 				for _, fileName := range tc.expectedFiles {
 					filePath := filepath.Join(tempDir, fileName)
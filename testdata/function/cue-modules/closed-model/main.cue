@@ -0,0 +1,24 @@
+package main
+
+// #Model closes the root of the model: the function must only fill the fields it declares.
+#Model: {
+	apiVersion: "cuestomize.dev/v1alpha1"
+	kind:       "Closed"
+	metadata: {...}
+	input: {
+		configMapName!: string
+	}
+	includes: _
+
+	outputs: [{
+		apiVersion: "v1"
+		kind:       "ConfigMap"
+		metadata: {
+			name:      input.configMapName
+			namespace: "default"
+		}
+		data: deployments: "\(len(includes["apps/v1"].Deployment["example-namespace"]))"
+	}]
+}
+
+#Model
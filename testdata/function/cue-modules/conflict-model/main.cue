@@ -0,0 +1,29 @@
+package main
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "ServiceLabeler"
+
+input: {
+	serviceName!:  string
+	namespace!:    string
+	outputPolicy?: "error" | "replace" | "deep-merge" | "keep"
+}
+
+includes: {} | null
+
+outService: {
+	apiVersion: "v1"
+	kind:       "Service"
+	metadata: {
+		name:      input.serviceName
+		namespace: input.namespace
+		labels: "managed-by": "cuestomize"
+		if input.outputPolicy != _|_ {
+			annotations: "config.cuestomize.io/conflict-policy": input.outputPolicy
+		}
+	}
+}
+
+outputs: [
+	outService,
+]
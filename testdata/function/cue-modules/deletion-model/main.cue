@@ -0,0 +1,39 @@
+package main
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "ServiceReplacer"
+
+input: {
+	serviceName!: string
+	namespace!:   string
+}
+
+includes: {} | null
+
+deletions: [
+	{
+		id: {
+			version:   "v1"
+			kind:      "Service"
+			name:      input.serviceName
+			namespace: input.namespace
+		}
+	},
+]
+
+outputs: [{
+	apiVersion: "v1"
+	kind:       "Service"
+	metadata: {
+		name:      "\(input.serviceName)-v2"
+		namespace: input.namespace
+	}
+	spec: {
+		selector: app: "example-app"
+		ports: [{
+			protocol:   "TCP"
+			port:       80
+			targetPort: "http"
+		}]
+	}
+}]
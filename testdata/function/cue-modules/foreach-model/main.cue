@@ -0,0 +1,31 @@
+package main
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "DisruptionBudgets"
+
+input: {
+	minAvailable: string | *"50%"
+}
+
+includes: {} | null
+
+item: {
+	metadata: {
+		name!:      string
+		namespace!: string
+	}
+	spec: selector: matchLabels!: [string]: string
+}
+
+outputs: [{
+	apiVersion: "policy/v1"
+	kind:       "PodDisruptionBudget"
+	metadata: {
+		name:      item.metadata.name
+		namespace: item.metadata.namespace
+	}
+	spec: {
+		minAvailable: input.minAvailable
+		selector: matchLabels: item.spec.selector.matchLabels
+	}
+}]
@@ -0,0 +1,55 @@
+package main
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "AppConfig"
+
+input: {
+	namespace: string
+	logLevel:  string | *"info"
+}
+
+outputs: [
+	{
+		apiVersion: "v1"
+		kind:       "ConfigMap"
+		metadata: {
+			name:      "app-config"
+			namespace: input.namespace
+		}
+		data: LOG_LEVEL: input.logLevel
+	},
+	{
+		apiVersion: "v1"
+		kind:       "Secret"
+		metadata: {
+			name:      "app-secret"
+			namespace: input.namespace
+			annotations: "config.cuestomize.io/hash-suffix": "false"
+		}
+		stringData: TOKEN: "example-token"
+	},
+	{
+		apiVersion: "apps/v1"
+		kind:       "Deployment"
+		metadata: {
+			name:      "app"
+			namespace: input.namespace
+		}
+		spec: {
+			selector: matchLabels: app: "app"
+			template: {
+				metadata: labels: app: "app"
+				spec: {
+					containers: [{
+						name:  "main"
+						image: "example-image:latest"
+						envFrom: [
+							{configMapRef: name: "app-config"},
+							{secretRef: name: "app-secret"},
+						]
+					}]
+				}
+			}
+		}
+	},
+]
@@ -0,0 +1,67 @@
+package main
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "TeamIndex"
+
+#Resource: {
+	kind: string
+	metadata: {
+		name: string
+		labels: team: =~"^[a-z-]+$"
+		...
+	}
+	...
+}
+
+// filled by the function with the layout configured in the function config
+includesLayout: string
+includes:       _
+
+if includesLayout == "nested" {
+	includes: [string]: [string]: [string]: [string]: #Resource
+}
+if includesLayout == "list" {
+	includes: [...#Resource]
+}
+if includesLayout == "flat" {
+	includes: [string]: #Resource
+}
+if includesLayout == "by-kind" {
+	includes: [string]: [...#Resource]
+}
+
+_teams: {
+	if includesLayout == "nested" {
+		for _, kinds in includes for _, namespaces in kinds for _, names in namespaces for _, r in names {
+			"\(r.kind).\(r.metadata.name)": r.metadata.labels.team
+		}
+	}
+	if includesLayout == "list" {
+		for r in includes {
+			"\(r.kind).\(r.metadata.name)": r.metadata.labels.team
+		}
+	}
+	if includesLayout == "flat" {
+		for _, r in includes {
+			"\(r.kind).\(r.metadata.name)": r.metadata.labels.team
+		}
+	}
+	if includesLayout == "by-kind" {
+		for _, resources in includes for r in resources {
+			"\(r.kind).\(r.metadata.name)": r.metadata.labels.team
+		}
+	}
+}
+
+outputs: [{
+	apiVersion: "v1"
+	kind:       "ConfigMap"
+	metadata: {
+		name:      "team-index"
+		namespace: "example-namespace"
+	}
+	data: {
+		layout: includesLayout
+		_teams
+	}
+}]
@@ -0,0 +1,4 @@
+module: "inputcueexample.cuestomize.dev"
+language: {
+	version: "v0.12.0"
+}
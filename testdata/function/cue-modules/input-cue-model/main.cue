@@ -0,0 +1,23 @@
+package main
+
+apiVersion: "cuestomize.dev/v1beta1"
+kind:       "Cuestomization"
+
+input: {
+	name!:     string
+	namespace: string | *"default"
+	replicas!: number
+	labels: [string]: string
+}
+
+includes: _
+
+outputs: cm: {
+	apiVersion: "v1"
+	kind:       "ConfigMap"
+	metadata: {
+		name:      input.name
+		namespace: input.namespace
+		labels:    input.labels
+	}
+}
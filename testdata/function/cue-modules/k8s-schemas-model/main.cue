@@ -0,0 +1,26 @@
+package main
+
+import (
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+)
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "ReplicasReport"
+
+includes: "apps/v1": Deployment: [_]: [_]: apps.#Deployment
+
+outputs: [core.#ConfigMap & {
+	apiVersion: "v1"
+	kind:       "ConfigMap"
+	metadata: {
+		name:      "replicas-report"
+		namespace: "example-namespace"
+	}
+	data: {
+		for namespace, deployments in includes."apps/v1".Deployment
+		for name, deployment in deployments {
+			"\(namespace).\(name)": "\(deployment.spec.replicas)"
+		}
+	}
+}]
@@ -0,0 +1,11 @@
+package main
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "DeploymentValidator"
+
+includes: {
+	"apps/v1": Deployment: [_]: [_]: {
+		metadata: labels: team: string
+		spec: replicas?: <=2
+	}
+}
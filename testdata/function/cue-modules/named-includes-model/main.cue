@@ -0,0 +1,30 @@
+package main
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "ServiceIndex"
+
+includes: named: {
+	// the function config requires exactly one Namespace
+	namespace: [{
+		metadata: labels: team: =~"^[a-z-]+$"
+		...
+	}]
+	services: [...]
+}
+
+let ns = includes.named.namespace[0]
+
+outputs: [{
+	apiVersion: "v1"
+	kind:       "ConfigMap"
+	metadata: {
+		name:      "service-index"
+		namespace: ns.metadata.name
+	}
+	data: {
+		team: ns.metadata.labels.team
+		for service in includes.named.services {
+			"\(service.metadata.name)": "\(service.spec.type)"
+		}
+	}
+}]
@@ -0,0 +1,54 @@
+package main
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "Bundle"
+
+outputs: {
+	webhook: {
+		apiVersion: "admissionregistration.k8s.io/v1"
+		kind:       "ValidatingWebhookConfiguration"
+		metadata: name: "example-webhook"
+	}
+	widget: {
+		apiVersion: "example.com/v1"
+		kind:       "Widget"
+		metadata: {
+			name:      "example-widget"
+			namespace: "example-namespace"
+		}
+	}
+	deployment: {
+		apiVersion: "apps/v1"
+		kind:       "Deployment"
+		metadata: {
+			name:      "example-deployment"
+			namespace: "example-namespace"
+		}
+	}
+	crd: {
+		apiVersion: "apiextensions.k8s.io/v1"
+		kind:       "CustomResourceDefinition"
+		metadata: name: "widgets.example.com"
+	}
+	configMapB: {
+		apiVersion: "v1"
+		kind:       "ConfigMap"
+		metadata: {
+			name:      "config-b"
+			namespace: "example-namespace"
+		}
+	}
+	configMapA: {
+		apiVersion: "v1"
+		kind:       "ConfigMap"
+		metadata: {
+			name:      "config-a"
+			namespace: "example-namespace"
+		}
+	}
+	namespace: {
+		apiVersion: "v1"
+		kind:       "Namespace"
+		metadata: name: "example-namespace"
+	}
+}
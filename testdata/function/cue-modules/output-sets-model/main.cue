@@ -0,0 +1,51 @@
+package main
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "Bundle"
+
+outputs: {
+	crds: widgets: {
+		apiVersion: "apiextensions.k8s.io/v1"
+		kind:       "CustomResourceDefinition"
+		metadata: name: "widgets.example.com"
+	}
+	workloads: {
+		app: {
+			deployment: {
+				apiVersion: "apps/v1"
+				kind:       "Deployment"
+				metadata: {
+					name:      "example-deployment"
+					namespace: "example-namespace"
+				}
+			}
+			service: {
+				apiVersion: "v1"
+				kind:       "Service"
+				metadata: {
+					name:      "example-service"
+					namespace: "example-namespace"
+				}
+			}
+		}
+		config: {
+			apiVersion: "v1"
+			kind:       "List"
+			items: [{
+				apiVersion: "v1"
+				kind:       "ConfigMap"
+				metadata: {
+					name:      "config-a"
+					namespace: "example-namespace"
+				}
+			}, {
+				apiVersion: "v1"
+				kind:       "ConfigMap"
+				metadata: {
+					name:      "config-b"
+					namespace: "example-namespace"
+				}
+			}]
+		}
+	}
+}
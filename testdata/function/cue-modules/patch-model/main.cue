@@ -0,0 +1,40 @@
+package main
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "SidecarInjector"
+
+input: {
+	sidecarImage!: string
+	team!:         string
+}
+
+includes: {} | null
+
+patches: [
+	{
+		target: selector: {
+			group:   "apps"
+			version: "v1"
+			kind:    "Deployment"
+		}
+		patch: spec: template: spec: containers: [{
+			name:  "sidecar"
+			image: input.sidecarImage
+		}]
+	},
+	{
+		target: id: {
+			version:   "v1"
+			kind:      "Service"
+			name:      "example-service"
+			namespace: "example-namespace"
+		}
+		type: "json-merge"
+		patch: metadata: labels: {
+			team: input.team
+			app:  null
+		}
+	},
+]
+
+outputs: []
@@ -0,0 +1,33 @@
+package main
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "TierLabeler"
+
+input: {
+	tier!:          string
+	configMapName!: string
+}
+
+includes: {} | null
+
+// a module that only patches resources does not need to define outputs
+patches: [
+	{
+		target: selector: {
+			group:   "apps"
+			version: "v1"
+			kind:    "Deployment"
+		}
+		type: "json-merge"
+		patch: metadata: labels: tier: input.tier
+	},
+	{
+		target: id: {
+			version:   "v1"
+			kind:      "ConfigMap"
+			name:      input.configMapName
+			namespace: "example-namespace"
+		}
+		patch: data: patched: "true"
+	},
+]
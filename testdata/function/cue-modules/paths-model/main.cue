@@ -0,0 +1,23 @@
+package main
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "Legacy"
+
+context: {
+	metadata: name!: string
+	includes: "v1": Namespace: "": [string]: metadata: labels: team!: "platform"
+}
+
+parameter: image: string
+
+let ns = [for name, _ in context.includes."v1".Namespace[""] {name}][0]
+
+objects: [{
+	apiVersion: "apps/v1"
+	kind:       "Deployment"
+	metadata: {
+		name:      context.metadata.name
+		namespace: ns
+	}
+	spec: template: spec: containers: [{name: "app", image: parameter.image}]
+}]
@@ -0,0 +1,26 @@
+package main
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "IncludesReport"
+
+includes: _
+
+_secret:     includes.v1.Secret."example-namespace".credentials
+_deployment: includes."apps/v1".Deployment."example-namespace"."example-deployment"
+
+outputs: [{
+	apiVersion: "v1"
+	kind:       "ConfigMap"
+	metadata: {
+		name:      "includes-report"
+		namespace: "example-namespace"
+	}
+	data: {
+		for field, _ in _secret {
+			"secret.\(field)": "forwarded"
+		}
+		for field, _ in _deployment.spec {
+			"deployment.spec.\(field)": "forwarded"
+		}
+	}
+}]
@@ -0,0 +1,4 @@
+module: "secretdataexample.cuestomize.dev"
+language: {
+	version: "v0.12.0"
+}
@@ -0,0 +1,36 @@
+package main
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "SecretData"
+
+includes: _
+
+stream: [...{
+	kind: string
+	metadata: name: string
+	...
+}]
+
+item: {
+	metadata: {
+		name!:      string
+		namespace!: string
+	}
+	...
+}
+
+// _withData lists the names of the Secrets of the stream whose data is forwarded
+_withData: [for r in stream if r.kind == "Secret" && (r.data != _|_ || r.stringData != _|_) {r.metadata.name}]
+
+outputs: [{
+	apiVersion: "v1"
+	kind:       "ConfigMap"
+	metadata: {
+		name:      "\(item.metadata.name)-secret-data"
+		namespace: item.metadata.namespace
+	}
+	data: {
+		itemData:   "\(item.data != _|_ || item.stringData != _|_)"
+		streamData: "\(len(_withData) > 0)"
+	}
+}]
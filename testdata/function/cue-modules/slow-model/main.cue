@@ -0,0 +1,19 @@
+package main
+
+import "list"
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "Slow"
+
+// products takes seconds to evaluate
+_products: [for i in list.Range(0, 200, 1) for j in list.Range(0, 200, 1) {i * j}]
+
+outputs: [{
+	apiVersion: "v1"
+	kind:       "ConfigMap"
+	metadata: {
+		name:      "products"
+		namespace: "example-namespace"
+	}
+	data: count: "\(len(_products))"
+}]
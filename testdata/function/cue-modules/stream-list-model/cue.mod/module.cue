@@ -0,0 +1,4 @@
+module: "cue.k8s.example"
+language: {
+	version: "v0.13.0"
+}
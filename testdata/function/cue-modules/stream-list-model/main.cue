@@ -0,0 +1,20 @@
+package main
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "Inventory"
+
+stream: [...{
+	kind: string
+	metadata: name: string
+	...
+}]
+
+outputs: [{
+	apiVersion: "v1"
+	kind:       "ConfigMap"
+	metadata: {
+		name:      "inventory"
+		namespace: "example-namespace"
+	}
+	data: {for i, resource in stream {"\(i)": "\(resource.kind)/\(resource.metadata.name)"}}
+}]
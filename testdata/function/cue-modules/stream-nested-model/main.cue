@@ -0,0 +1,7 @@
+package main
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "Policy"
+
+// every Deployment must have a team label
+stream: "apps/v1": Deployment: [_]: [_]: metadata: labels: team!: string
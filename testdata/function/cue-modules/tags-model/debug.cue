@@ -0,0 +1,13 @@
+@if(debug)
+
+package main
+
+outputs: debug: {
+	apiVersion: "v1"
+	kind:       "ConfigMap"
+	metadata: {
+		name:      "debug-settings"
+		namespace: "example-namespace"
+	}
+	data: LOG_LEVEL: "debug"
+}
@@ -0,0 +1,20 @@
+package main
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "Settings"
+
+env:    *"dev" | "prod"   @tag(env)
+region: *"local" | string @tag(region, var=region)
+
+outputs: config: {
+	apiVersion: "v1"
+	kind:       "ConfigMap"
+	metadata: {
+		name:      "settings"
+		namespace: "example-namespace"
+	}
+	data: {
+		ENV:    env
+		REGION: region
+	}
+}
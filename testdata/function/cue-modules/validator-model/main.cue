@@ -0,0 +1,8 @@
+package main
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "ReplicasValidator"
+
+includes: {
+	"apps/v1": Deployment: [_]: [_]: spec: replicas: <=2
+}
@@ -0,0 +1,4 @@
+module: "valuefromexample.cuestomize.dev"
+language: {
+	version: "v0.12.0"
+}
@@ -0,0 +1,27 @@
+package main
+
+apiVersion: "cuestomize.dev/v1beta1"
+kind:       "Cuestomization"
+
+input: {
+	configMapName!: string
+	namespace!:     string
+	service!: {
+		name!: string
+		port!: int
+	}
+}
+
+includes: _
+
+outputs: cm: {
+	apiVersion: "v1"
+	kind:       "ConfigMap"
+	metadata: {
+		name:      input.configMapName
+		namespace: input.namespace
+	}
+	data: {
+		serviceURL: "http://\(input.service.name).\(input.namespace).svc:\(input.service.port)"
+	}
+}
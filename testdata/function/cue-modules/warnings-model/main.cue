@@ -0,0 +1,35 @@
+package main
+
+apiVersion: "cuestomize.dev/v1alpha1"
+kind:       "DeploymentLinter"
+
+includes: _
+
+_deployments: [
+	for ns, deployments in includes["apps/v1"].Deployment
+	for name, deployment in deployments {
+		deployment
+	},
+]
+
+// replicas holds the replicas of each Deployment, which should not exceed 2.
+replicas: {
+	[_]: <=2 @cuestomize(severity=warning)
+	for deployment in _deployments if deployment.spec.replicas != _|_ {
+		"\(deployment.metadata.namespace)/\(deployment.metadata.name)": deployment.spec.replicas
+	}
+}
+
+warnings: [
+	for deployment in _deployments
+	for container in deployment.spec.template.spec.containers
+	if container.resources.limits == _|_ {
+		message: "container \(container.name) has no resource limits"
+		resourceRef: {
+			apiVersion: deployment.apiVersion
+			kind:       deployment.kind
+			name:       deployment.metadata.name
+			namespace:  deployment.metadata.namespace
+		}
+	},
+]